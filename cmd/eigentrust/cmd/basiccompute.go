@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,9 +30,14 @@ var (
 		Run:   runBasicCompute,
 	}
 	localTrustURI         string
+	localTrustWeight      float64
+	localTrustLayers      []string
+	decay                 string
+	decayReference        int64
 	preTrustURI           string
 	initialTrustURI       string
 	alpha                 float64
+	peerAlphaURI          string
 	epsilon               float64
 	flatTail              int
 	numLeaders            int
@@ -40,18 +46,33 @@ var (
 	maxIterations         int
 	minIterations         int
 	checkFreq             int
-	csvHasHeader          bool
+	dangling              string
+	preprocess            []string
+	discountMode          string
+	distrustWeight        float64
+	distrustHops          int
+	preDistrustURI        string
+	antiTrustWeight       float64
 	rawPeerIds            bool
 	peerMap               *peer.Map
 	printRequest          bool
 )
 
-func pathIntoFileRef(path string, ref *openapi.TrustRef) error {
+func pathIntoFileRef(
+	path string, format *openapi.DelimitedTextFormat,
+	jsonLines *openapi.JSONLinesFormat, ref *openapi.TrustRef,
+) error {
 	path, err := filepath.Abs(path)
 	if err != nil {
 		return err
 	}
-	err = ref.FromObjectStorageTrustRef(openapi.ObjectStorageTrustRef{Url: "file://" + path})
+	objectStorageRef := openapi.ObjectStorageTrustRef{Url: "file://" + path}
+	if openapi.IsJSONLines(path) {
+		objectStorageRef.JsonLines = jsonLines
+	} else {
+		objectStorageRef.Format = format
+	}
+	err = ref.FromObjectStorageTrustRef(objectStorageRef)
 	if err != nil {
 		return err
 	}
@@ -71,7 +92,8 @@ func trustMatrixURIToRef(uri string, ref *openapi.TrustRef) error {
 			path = parsed.Opaque
 		}
 		if useFileURI {
-			return pathIntoFileRef(path, ref)
+			return pathIntoFileRef(path, textFormat(localTrustColumns),
+				jsonLinesFormat(localTrustFields), ref)
 		}
 		return loadInlineTrustMatrix(path, ref)
 	default:
@@ -81,46 +103,110 @@ func trustMatrixURIToRef(uri string, ref *openapi.TrustRef) error {
 
 func loadInlineTrustMatrix(filename string, ref *openapi.TrustRef) error {
 	logger.Trace().Str("filename", filename).Msg("loading inline local trust")
+	ctx := context.TODO()
+	// Negative local trust is distrust, which compute handles separately.
+	m, edgeTimes, err := readTrustMatrixFileWithTimes(ctx, filename,
+		spopt.AllowNegative)
+	if err != nil {
+		return err
+	}
+	// Peers only ever trusted (never trusting) have no rows, and vice versa.
+	rows, cols := m.Dims()
+	size := max(rows, cols)
+	m.SetDim(size, size)
+	inline, err := openapi.InlineFromMatrix(ctx, m)
+	if err != nil {
+		return err
+	}
+	if edgeTimes != nil {
+		if err = openapi.SetInlineEntryTimes(inline, edgeTimes); err != nil {
+			return err
+		}
+	}
+	err = ref.FromInlineTrustRef(*inline)
+	if err != nil {
+		return fmt.Errorf("cannot wrap inline trust matrix: %w", err)
+	}
+	ref.Scheme = openapi.Inline
+	return nil
+}
+
+// readTrustMatrixFile reads a trust matrix from the given local file,
+// mapping peer IDs into peerMap if not nil.
+// opts are passed to the matrix constructor.
+func readTrustMatrixFile(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (*sparse.Matrix, error) {
+	// Do not read entry times.
+	opts = append(opts, spopt.TimeNamed(""))
+	m, _, err := readTrustMatrixFileWithTimes(ctx, filename, opts...)
+	return m, err
+}
+
+// readTrustMatrixFileWithTimes is readTrustMatrixFile
+// that also reads entry times (Unix seconds) from the "t" field, if any,
+// located by name in the CSV header or JSON Lines objects.
+// edgeTimes is nil if no entry has time.
+func readTrustMatrixFileWithTimes(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (m, edgeTimes *sparse.Matrix, err error) {
 	ext := strings.ToLower(filepath.Ext(filename))
 	switch ext {
-	case ".csv":
-		return loadInlineTrustMatrixCSV(filename, ref)
+	case ".csv", ".tsv":
+		return readTrustMatrixCSV(ctx, filename, opts...)
+	case ".jsonl", ".ndjson":
+		return readTrustMatrixJSONL(ctx, filename, opts...)
 	default:
-		return fmt.Errorf("invalid local trust file type %#v", ext)
+		return nil, nil, fmt.Errorf("invalid local trust file type %#v", ext)
 	}
 }
 
-func loadInlineTrustMatrixCSV(
-	filename string, ref *openapi.TrustRef,
-) error {
+func readTrustMatrixCSV(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (m, edgeTimes *sparse.Matrix, err error) {
+	textOpts, err := localTrustTextOptions(filename)
+	if err != nil {
+		return nil, nil, err
+	}
 	f, err := os.Open(filename)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	defer util.Close(f)
-	reader := csv.NewReader(f)
-	ctx := context.TODO()
 	peerMapOption := spopt.LiteralIndices
 	if peerMap != nil {
 		peerMapOption = spopt.IndicesInto(peerMap)
 	}
-	m, err := sparse.NewCSRMatrixFromCSV(ctx, reader, peerMapOption)
+	opts = append(append(textOpts, peerMapOption), opts...)
+	reader := sparse.NewCSVReader(f, opts...)
+	return sparse.NewCSRMatrixWithTimesFromCSV(ctx, reader, opts...)
+}
+
+func readTrustMatrixJSONL(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (m, edgeTimes *sparse.Matrix, err error) {
+	fieldOpts, err := localTrustJSONLinesOptions()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	inline, err := openapi.InlineFromMatrix(ctx, m)
+	f, err := os.Open(filename)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	err = ref.FromInlineTrustRef(*inline)
-	if err != nil {
-		return fmt.Errorf("cannot wrap inline trust matrix: %w", err)
+	defer util.Close(f)
+	peerMapOption := spopt.LiteralIndices
+	if peerMap != nil {
+		peerMapOption = spopt.IndicesInto(peerMap)
 	}
-	ref.Scheme = openapi.Inline
-	return nil
+	opts = append(append(fieldOpts, peerMapOption), opts...)
+	return sparse.NewCSRMatrixWithTimesFromJSONL(ctx, f, opts...)
 }
 
-func trustVectorURIToRef(uri string, ref *openapi.TrustRef) error {
+// trustVectorURIToRef parses the given trust vector URI into ref.
+// opts are passed to the vector constructor for inline vectors.
+func trustVectorURIToRef(
+	uri string, ref *openapi.TrustRef, opts ...spopt.Option,
+) error {
 	parsed, err := url.Parse(uri)
 	if err != nil {
 		return err
@@ -132,55 +218,97 @@ func trustVectorURIToRef(uri string, ref *openapi.TrustRef) error {
 			path = parsed.Opaque
 		}
 		if useFileURI {
-			return pathIntoFileRef(path, ref)
+			return pathIntoFileRef(path, textFormat(preTrustColumns),
+				jsonLinesFormat(preTrustFields), ref)
 		}
-		return loadInlineTrustVector(path, ref)
+		return loadInlineTrustVector(path, ref, opts...)
 	default:
 		return fmt.Errorf("invalid trust vector URI scheme %#v", parsed.Scheme)
 	}
 }
 
-func loadInlineTrustVector(filename string, ref *openapi.TrustRef) error {
+func loadInlineTrustVector(
+	filename string, ref *openapi.TrustRef, opts ...spopt.Option,
+) error {
 	logger.Trace().Str("filename", filename).Msg("loading inline trust vector")
+	ctx := context.TODO()
+	v, err := readTrustVectorFile(ctx, filename, opts...)
+	if err != nil {
+		return err
+	}
+	inline, err := openapi.InlineFromVector(ctx, v, opts...)
+	if err != nil {
+		return err
+	}
+	if err = ref.FromInlineTrustRef(*inline); err != nil {
+		return fmt.Errorf("cannot wrap inline trust vector: %w", err)
+	}
+	ref.Scheme = openapi.Inline
+	return nil
+}
+
+// readTrustVectorFile reads a trust vector from the given local file,
+// mapping peer IDs into peerMap if not nil.
+// opts are passed to the vector constructor.
+func readTrustVectorFile(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (*sparse.Vector, error) {
 	ext := strings.ToLower(filepath.Ext(filename))
 	switch ext {
-	case ".csv":
-		return loadInlineTrustVectorFromCSV(filename, ref)
+	case ".csv", ".tsv":
+		return readTrustVectorCSV(ctx, filename, opts...)
+	case ".jsonl", ".ndjson":
+		return readTrustVectorJSONL(ctx, filename, opts...)
 	default:
-		return fmt.Errorf("invalid trust vector file type %#v", ext)
+		return nil, fmt.Errorf("invalid trust vector file type %#v", ext)
 	}
 }
 
-func loadInlineTrustVectorFromCSV(
-	filename string, ref *openapi.TrustRef,
-) error {
+func readTrustVectorCSV(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (*sparse.Vector, error) {
+	textOpts, err := preTrustTextOptions(filename)
+	if err != nil {
+		return nil, err
+	}
 	f, err := os.Open(filename)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer util.Close(f)
-	reader := csv.NewReader(f)
-	ctx := context.TODO()
 	peerMapOption := spopt.LiteralIndices
 	if peerMap != nil {
 		peerMapOption = spopt.IndicesInto(peerMap)
 	}
-	v, err := sparse.NewVectorFromCSV(ctx, reader, peerMapOption)
+	opts = append(append(textOpts, peerMapOption), opts...)
+	reader := sparse.NewCSVReader(f, opts...)
+	return sparse.NewVectorFromCSV(ctx, reader, opts...)
+}
+
+func readTrustVectorJSONL(
+	ctx context.Context, filename string, opts ...spopt.Option,
+) (*sparse.Vector, error) {
+	fieldOpts, err := preTrustJSONLinesOptions()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	inline, err := openapi.InlineFromVector(ctx, v)
+	f, err := os.Open(filename)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err = ref.FromInlineTrustRef(*inline); err != nil {
-		return fmt.Errorf("cannot wrap inline trust vector: %w", err)
+	defer util.Close(f)
+	peerMapOption := spopt.LiteralIndices
+	if peerMap != nil {
+		peerMapOption = spopt.IndicesInto(peerMap)
 	}
-	ref.Scheme = openapi.Inline
-	return nil
+	opts = append(append(fieldOpts, peerMapOption), opts...)
+	return sparse.NewVectorFromJSONL(ctx, f, opts...)
 }
 
-func writeInlineTrustVectorIntoCSV(
+// writeInlineTrustVector writes the given inline trust vector
+// into the given file, in JSON Lines if the file name ends in .jsonl/.ndjson
+// or in CSV otherwise.
+func writeInlineTrustVector(
 	ctx context.Context, itv *openapi.InlineTrustRef, filename string,
 ) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -195,11 +323,18 @@ func writeInlineTrustVectorIntoCSV(
 		return fmt.Errorf("cannot open output file: %w", err)
 	}
 	defer util.Close(file)
-	w := csv.NewWriter(file)
 	indexOption := spopt.LiteralIndices
 	if peerMap != nil {
 		indexOption = spopt.IndicesIn(peerMap)
 	}
+	if openapi.IsJSONLines(filename) {
+		err = v.WriteIntoJSONL(ctx, file, spopt.IncludeZero, indexOption)
+		if err != nil {
+			return fmt.Errorf("cannot write into JSON Lines: %w", err)
+		}
+		return nil
+	}
+	w := csv.NewWriter(file)
 	err = v.WriteIntoCSV(ctx, w, spopt.IncludeZero, indexOption)
 	if err != nil {
 		return fmt.Errorf("cannot write into CSV: %w", err)
@@ -225,27 +360,15 @@ func writeFlatTailStats(stats basic.FlatTailStats, filename string) error {
 	return nil
 }
 
-func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
-	basicSetupEndpoint()
+// newComputeRequestBody returns a compute request body
+// built from the command line flags.
+func newComputeRequestBody() (*openapi.ComputeRequestBody, error) {
 	var err error
-	if useFileURI {
-		rawPeerIds = true
-	}
-	if !rawPeerIds {
-		peerMap = peer.NewMap()
-	}
-	client, err := openapi.NewClientWithResponses(endpoint)
-	if err != nil {
-		logger.Err(err).Msg("cannot create an API client")
-		return
-	}
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 	epsilonP := &epsilon
 	if epsilon == 0 {
 		epsilonP = nil
 	}
-	requestBody := openapi.ComputeWithStatsJSONRequestBody{
+	requestBody := &openapi.ComputeRequestBody{
 		Alpha:        &alpha,
 		Epsilon:      epsilonP,
 		PreTrust:     nil,
@@ -253,15 +376,46 @@ func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
 	}
 	err = trustMatrixURIToRef(localTrustURI, &requestBody.LocalTrust)
 	if err != nil {
-		logger.Err(err).Msg("cannot parse/load local trust reference")
-		return
+		return nil, fmt.Errorf("cannot parse/load local trust reference: %w",
+			err)
+	}
+	if len(localTrustLayers) != 0 {
+		layers := make([]openapi.LocalTrustLayer, 0, len(localTrustLayers))
+		for _, spec := range localTrustLayers {
+			var layer openapi.LocalTrustLayer
+			uri := spec
+			if w, rest, found := strings.Cut(spec, ":"); found {
+				if weight, err := strconv.ParseFloat(w, 64); err == nil {
+					layer.Weight = &weight
+					uri = rest
+				}
+			}
+			err = trustMatrixURIToRef(uri, &layer.LocalTrust)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"cannot parse/load local trust layer %#v reference: %w",
+					spec, err)
+			}
+			layers = append(layers, layer)
+		}
+		requestBody.LocalTrustLayers = &layers
+		requestBody.LocalTrustWeight = &localTrustWeight
+	}
+	if decay != "" {
+		if _, err = basic.ParseDecay(decay); err != nil {
+			return nil, fmt.Errorf("invalid --decay: %w", err)
+		}
+		requestBody.Decay = &decay
+		if decayReference != 0 {
+			requestBody.DecayReference = &decayReference
+		}
 	}
 	if preTrustURI != "" {
 		var preTrustRef openapi.TrustRef
 		err = trustVectorURIToRef(preTrustURI, &preTrustRef)
 		if err != nil {
-			logger.Err(err).Msg("cannot parse/load pre-trust reference")
-			return
+			return nil, fmt.Errorf("cannot parse/load pre-trust reference: %w",
+				err)
 		}
 		requestBody.PreTrust = &preTrustRef
 	}
@@ -269,11 +423,22 @@ func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
 		var initialTrustRef openapi.TrustRef
 		err = trustVectorURIToRef(initialTrustURI, &initialTrustRef)
 		if err != nil {
-			logger.Err(err).Msg("cannot parse/load initial trust reference")
-			return
+			return nil, fmt.Errorf("cannot parse/load initial trust reference: %w",
+				err)
 		}
 		requestBody.InitialTrust = &initialTrustRef
 	}
+	if peerAlphaURI != "" {
+		var peerAlphaRef openapi.TrustRef
+		// Zero alpha is valid, unlike zero pre-trust.
+		err = trustVectorURIToRef(peerAlphaURI, &peerAlphaRef,
+			spopt.IncludeZero)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse/load peer alpha reference: %w",
+				err)
+		}
+		requestBody.PeerAlpha = &peerAlphaRef
+	}
 	requestBody.FlatTail = &flatTail
 	requestBody.NumLeaders = &numLeaders
 	if maxIterations > 0 {
@@ -286,18 +451,82 @@ func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
 	if checkFreq > 1 {
 		requestBody.CheckFreq = &checkFreq
 	}
+	if dangling != "" {
+		if _, err = basic.ParseDanglingStrategy(dangling); err != nil {
+			return nil, fmt.Errorf("invalid --dangling: %w", err)
+		}
+		danglingStrategy := openapi.DanglingStrategy(dangling)
+		requestBody.Dangling = &danglingStrategy
+	}
+	if len(preprocess) != 0 {
+		if _, err = basic.ParsePreprocessSteps(preprocess...); err != nil {
+			return nil, fmt.Errorf("invalid --preprocess: %w", err)
+		}
+		requestBody.Preprocess = &preprocess
+	}
+	if discountMode != "" {
+		if _, err = basic.ParseDiscountMode(discountMode); err != nil {
+			return nil, fmt.Errorf("invalid --discount-mode: %w", err)
+		}
+		mode := openapi.DiscountMode(discountMode)
+		requestBody.DiscountMode = &mode
+	}
+	if distrustWeight != 1 {
+		requestBody.DistrustWeight = &distrustWeight
+	}
+	if distrustHops != 1 {
+		requestBody.DistrustHops = &distrustHops
+	}
+	if preDistrustURI != "" {
+		var preDistrustRef openapi.TrustRef
+		err = trustVectorURIToRef(preDistrustURI, &preDistrustRef)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse/load pre-distrust reference: %w",
+				err)
+		}
+		requestBody.PreDistrust = &preDistrustRef
+	}
+	if antiTrustWeight != 1 {
+		requestBody.AntiTrustWeight = &antiTrustWeight
+	}
+	return requestBody, nil
+}
+
+func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
+	basicSetupEndpoint()
+	var err error
+	if useFileURI {
+		rawPeerIds = true
+	}
+	if err := openPeerMap(true); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	client, err := openapi.NewClientWithResponses(endpoint)
+	if err != nil {
+		logger.Err(err).Msg("cannot create an API client")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	requestBody, err := newComputeRequestBody()
+	if err != nil {
+		logger.Err(err).Msg("invalid compute request")
+		return
+	}
 	if printRequest {
 		req := struct {
 			Body    *openapi.ComputeWithStatsJSONRequestBody `json:"body"`
 			PeerIds []string                                 `json:"peerIds"`
-		}{&requestBody, peerMap.Ids()}
+		}{requestBody, peerMap.Ids()}
 		err = json.NewEncoder(os.Stdout).Encode(req)
 		if err != nil {
 			logger.Err(err).Msg("cannot encode/print the request body")
 		}
 		return
 	}
-	resp, err := client.ComputeWithStatsWithResponse(ctx, requestBody)
+	resp, err := client.ComputeWithStatsWithResponse(ctx, *requestBody)
 	if err != nil {
 		logger.Err(err).Msg("request failed")
 		return
@@ -309,7 +538,7 @@ func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
 		} else if inlineEigenTrust, err := resp.JSON200.EigenTrust.AsInlineTrustRef(); err != nil {
 			logger.Error().Msg("cannot parse response")
 		} else {
-			if err = writeInlineTrustVectorIntoCSV(
+			if err = writeInlineTrustVector(
 				ctx, &inlineEigenTrust, outputFilename,
 			); err != nil {
 				logger.Err(err).Msg("cannot write output file")
@@ -331,56 +560,109 @@ func runBasicCompute( /*cmd*/ *cobra.Command /*args*/, []string) {
 	}
 }
 
-func init() {
-	basicCmd.AddCommand(basicComputeCmd)
-	basicComputeCmd.Flags().StringVarP(&localTrustURI, "local-trust", "l",
+// addComputeFlags adds compute request flags to cmd;
+// see newComputeRequestBody.
+func addComputeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&localTrustURI, "local-trust", "l",
 		"file:localtrust.csv",
 		`Local trust reference URI.
 file URIs are parsed and transmitted as inline;
 schemaless URIs are assumed to be file URIs.`)
-	basicComputeCmd.Flags().StringVarP(&preTrustURI, "pre-trust", "p",
+	cmd.Flags().Float64Var(&localTrustWeight, "local-trust-weight",
+		1,
+		`Weight of --local-trust when blending it with --local-trust-layer`)
+	cmd.Flags().StringArrayVar(&localTrustLayers,
+		"local-trust-layer", nil,
+		`Additional local trust layer as [WEIGHT:]URI (weight defaults to 1),
+e.g. 0.5:payments.csv; may be repeated.
+Layers are blended with --local-trust into their weighted sum,
+after scaling each truster's values in each layer to sum to one.`)
+	cmd.Flags().StringVar(&decay, "decay", "",
+		`Decay of local trust by the age of each entry ("t" CSV column,
+in Unix seconds): exp:HALF-LIFE, linear:WINDOW, or step:CUTOFF,
+with durations such as 72h or 30d (default: none)`)
+	cmd.Flags().Int64Var(&decayReference, "decay-reference", 0,
+		`Unix time as of which to compute entry ages for --decay
+(default: now)`)
+	cmd.Flags().StringVarP(&preTrustURI, "pre-trust", "p",
 		"",
 		`Pre-trust reference URI;
 file URIs are parsed and transmitted as inline.
 If not given, server uses uniform trust vector by default.`)
-	basicComputeCmd.Flags().StringVarP(&initialTrustURI, "initial-trust", "i",
+	cmd.Flags().StringVarP(&initialTrustURI, "initial-trust", "i",
 		"",
 		`Initial trust reference URI;
 file URIs are parsed and transmitted as inline.
 If not given, server uses pre-trust vector by default.`)
-	basicComputeCmd.Flags().Float64VarP(&alpha, "alpha", "a", 0.5,
+	cmd.Flags().Float64VarP(&alpha, "alpha", "a", 0.5,
 		`Alpha value, between 0.0 and 1.0 inclusive.
 Higher value biases the computation toward pre-trust.`)
-	basicComputeCmd.Flags().Float64VarP(&epsilon, "epsilon", "e", 0.0,
+	cmd.Flags().StringVar(&peerAlphaURI, "peer-alpha", "",
+		`Per-peer alpha reference URI, same format as pre-trust;
+file URIs are parsed and transmitted as inline.
+Overrides --alpha for listed peers, e.g. to bias newer peers
+toward pre-trust more strongly.`)
+	cmd.Flags().Float64VarP(&epsilon, "epsilon", "e", 0.0,
 		`Epsilon (error max).  0 (default) uses server default.`)
-	basicComputeCmd.Flags().IntVar(&flatTail, "flat-tail", 0,
+	cmd.Flags().IntVar(&flatTail, "flat-tail", 0,
 		`Flat-tail threshold length. 0 (default) disables flat-tail algorithm.`)
-	basicComputeCmd.Flags().IntVar(&numLeaders, "num-leaders", 0,
+	cmd.Flags().IntVar(&numLeaders, "num-leaders", 0,
 		`Number of top-ranking peers (leaders) to consider
 for flat-tail algorithm and stats.
 0 (default) includes all peers.`)
+	cmd.Flags().IntVar(&maxIterations, "max-iterations", 0,
+		`Maximum number of iterations. 0 (default) means unlimited`)
+	cmd.Flags().IntVar(&minIterations, "min-iterations", -1,
+		`Minimum number of iterations (default: same as --check-freq)`)
+	cmd.Flags().IntVar(&checkFreq, "check-freq", 1,
+		`Exit criteria check frequency, in number of iterations (default: 1)`)
+	cmd.Flags().StringVar(&dangling, "dangling", "",
+		`How to handle trust of peers without outbound local trust:
+pretrust (distribute according to pre-trust), uniform (distribute equally),
+selfloop (keep it), redistribute (onto all peers in proportion to their trust)
+(default: server default, pretrust)`)
+	cmd.Flags().StringSliceVar(&preprocess, "preprocess", nil,
+		`Local trust preprocessing steps to apply in order:
+log, sqrt, cap:MAX, threshold:MIN, top-k:K, rank, no-self-loops,
+reciprocity:FACTOR (default: none)`)
+	cmd.Flags().StringVar(&discountMode, "discount-mode", "",
+		`How distrust (negative local trust) adjusts global trust:
+onestep (subtract once), clamped (onestep, then clamp negative scores at 0),
+propagated (also distrust whom trusted peers distrust)
+(default: server default, onestep)`)
+	cmd.Flags().Float64Var(&distrustWeight, "distrust-weight", 1,
+		`Factor by which to scale all distrust`)
+	cmd.Flags().IntVar(&distrustHops, "distrust-hops", 1,
+		`Number of hops to propagate distrust through trust
+(for --discount-mode=propagated)`)
+	cmd.Flags().StringVar(&preDistrustURI, "pre-distrust", "",
+		`Pre-distrust (known-bad peers) reference URI;
+file URIs are parsed and transmitted as inline.
+If given, distrust is propagated from these peers to their trusters
+(Anti-TrustRank) and subtracted from global trust.`)
+	cmd.Flags().Float64Var(&antiTrustWeight, "anti-trust-weight", 1,
+		`Factor by which to scale Anti-TrustRank scores (for --pre-distrust)`)
+	cmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
+		`Whether to use truster/trustee in input CSV directly as peer indices
+(default: false)`)
+	cmd.Flags().BoolVarP(&useFileURI, "use-file-uri", "F", false,
+		`Use objectstorage scheme with file:// URI for local file;
+implies --raw-peer-ids (default: false)`)
+	addTextFormatFlags(cmd)
+	addPeerMapFlags(cmd)
+}
+
+func init() {
+	basicCmd.AddCommand(basicComputeCmd)
+	addComputeFlags(basicComputeCmd)
 	basicComputeCmd.Flags().StringVarP(&outputFilename, "output", "o",
 		"-",
-		`Output file name.
+		`Output file name; .jsonl or .ndjson writes JSON Lines, others CSV.
 "" suppresses output; "-" (default) uses standard output`)
 	basicComputeCmd.Flags().StringVar(&flatTailStatsFilename, "flat-tail-stats",
 		"",
 		`Flat tail stats output file name.
 "" (default) suppresses output; "-" uses standard output`)
-	basicComputeCmd.Flags().IntVar(&maxIterations, "max-iterations", 0,
-		`Maximum number of iterations. 0 (default) means unlimited`)
-	basicComputeCmd.Flags().IntVar(&minIterations, "min-iterations", -1,
-		`Minimum number of iterations (default: same as --check-freq)`)
-	basicComputeCmd.Flags().IntVar(&checkFreq, "check-freq", 1,
-		`Exit criteria check frequency, in number of iterations (default: 1)`)
-	basicComputeCmd.Flags().BoolVar(&csvHasHeader, "csv-header", true,
-		`Whether input CSV has a header line (default: true)`)
-	basicComputeCmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
-		`Whether to use truster/trustee in input CSV directly as peer indices
-(default: false)`)
 	basicComputeCmd.Flags().BoolVar(&printRequest, "print-request", false,
 		`Print the compute request JSON body and exit`)
-	basicComputeCmd.Flags().BoolVarP(&useFileURI, "use-file-uri", "F", false,
-		`Use objectstorage scheme with file:// URI for local file;
-implies --raw-peer-ids (default: false)`)
 }
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/api/openapi"
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// explainCmd represents the explain command
+	explainCmd = &cobra.Command{
+		Use:   "explain",
+		Short: "Explain a peer's EigenTrust score.",
+		Long: `Submit a compute request (see "basic compute" for its flags)
+and explain the resulting score of the given peer (--peer):
+the top inbound contributors, the share from pre-trust,
+the distrust deductions, and the strongest trust paths
+from pre-trusted peers.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runExplain,
+	}
+	explainPeer            string
+	explainTopContributors int
+	explainTopPaths        int
+	explainMaxPathLength   int
+	explainOutputFilename  string
+)
+
+// contribution is a score contribution, with the peer identifier.
+type contribution struct {
+	Peer  string  `json:"peer"`
+	Value float64 `json:"value"`
+}
+
+// trustPath is a trust path, with peer identifiers.
+type trustPath struct {
+	Peers    []string `json:"peers"`
+	Strength float64  `json:"strength"`
+}
+
+// explanation is an explain response, with peer identifiers.
+type explanation struct {
+	Peer            string         `json:"peer"`
+	Score           float64        `json:"score"`
+	DiscountedScore float64        `json:"discountedScore"`
+	PreTrust        float64        `json:"preTrust"`
+	InboundTotal    float64        `json:"inboundTotal"`
+	Inbound         []contribution `json:"inbound"`
+	DistrustTotal   float64        `json:"distrustTotal"`
+	Distrust        []contribution `json:"distrust"`
+	Paths           []trustPath    `json:"paths"`
+}
+
+func runExplain( /*cmd*/ *cobra.Command /*args*/, []string) {
+	basicSetupEndpoint()
+	if useFileURI {
+		rawPeerIds = true
+	}
+	if err := openPeerMap(true); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	client, err := openapi.NewClientWithResponses(endpoint)
+	if err != nil {
+		logger.Err(err).Msg("cannot create an API client")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	computeBody, err := newComputeRequestBody()
+	if err != nil {
+		logger.Err(err).Msg("invalid compute request")
+		return
+	}
+	// Peers are known once local trust is loaded (inline).
+	index, err := peer.ParseId(explainPeer, peerMap, false)
+	if err != nil {
+		logger.Err(err).Msg("invalid --peer")
+		return
+	}
+	requestBody := openapi.ExplainJSONRequestBody{
+		Compute:         *computeBody,
+		Peer:            index,
+		TopContributors: &explainTopContributors,
+		TopPaths:        &explainTopPaths,
+		MaxPathLength:   &explainMaxPathLength,
+	}
+	resp, err := client.ExplainWithResponse(ctx, requestBody)
+	if err != nil {
+		logger.Err(err).Msg("request failed")
+		return
+	}
+	switch resp.StatusCode() {
+	case 200:
+		if resp.JSON200 == nil {
+			logger.Error().Msg("cannot recover HTTP 200 response")
+		} else if err = writeExplanation(
+			resp.JSON200, explainOutputFilename,
+		); err != nil {
+			logger.Err(err).Msg("cannot write explanation")
+		}
+	case 400:
+		if resp.JSON400 != nil {
+			logger.Error().Str("error", resp.JSON400.Message).
+				Msg("invalid request")
+		}
+	default:
+		logger.Error().Str("status", resp.HTTPResponse.Status).
+			Msg("server returned unknown status code")
+	}
+}
+
+// writeExplanation writes the given explanation into the given file
+// as JSON, with peer indices turned back into peer identifiers.
+func writeExplanation(x *openapi.Explanation, filename string) error {
+	contributions := func(
+		contribs []openapi.Contribution,
+	) ([]contribution, error) {
+		return util.MapWithErr(contribs,
+			func(c openapi.Contribution) (contribution, error) {
+				id, err := peer.GetId(c.Peer, peerMap)
+				return contribution{Peer: id, Value: c.Value}, err
+			})
+	}
+	var err error
+	out := explanation{
+		Score:           x.Score,
+		DiscountedScore: x.DiscountedScore,
+		PreTrust:        x.PreTrust,
+		InboundTotal:    x.InboundTotal,
+		DistrustTotal:   x.DistrustTotal,
+		Paths:           make([]trustPath, 0, len(x.Paths)),
+	}
+	if out.Peer, err = peer.GetId(x.Peer, peerMap); err != nil {
+		return err
+	}
+	if out.Inbound, err = contributions(x.Inbound); err != nil {
+		return err
+	}
+	if out.Distrust, err = contributions(x.Distrust); err != nil {
+		return err
+	}
+	for _, path := range x.Paths {
+		ids, err := util.MapWithErr(path.Peers,
+			func(index int) (string, error) {
+				return peer.GetId(index, peerMap)
+			})
+		if err != nil {
+			return err
+		}
+		out.Paths = append(out.Paths,
+			trustPath{Peers: ids, Strength: path.Strength})
+	}
+	file, err := util.OpenOutputFile(filename)
+	if err != nil {
+		return fmt.Errorf("cannot open output file: %w", err)
+	}
+	defer util.Close(file)
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+	explainCmd.Flags().StringVarP(&endpoint, "endpoint", "H",
+		"https://api.k3l.io/basic/v1",
+		`API endpoint address`)
+	explainCmd.Flags().BoolVarP(&useLocalEndpoint, "local", "L", false,
+		`use local API endpoint at http://localhost:8080 (ignores --endpoint)`)
+	addComputeFlags(explainCmd)
+	explainCmd.Flags().StringVar(&explainPeer, "peer", "",
+		`Peer whose score to explain`)
+	explainCmd.Flags().IntVar(&explainTopContributors, "top-contributors", 10,
+		`Number of top contributors (and distrusters) to list; 0 lists all`)
+	explainCmd.Flags().IntVar(&explainTopPaths, "top-paths", 3,
+		`Number of strongest trust paths from pre-trusted peers to find`)
+	explainCmd.Flags().IntVar(&explainMaxPathLength, "max-path-length", 6,
+		`Maximum number of hops in a trust path`)
+	explainCmd.Flags().StringVarP(&explainOutputFilename, "output", "o", "-",
+		`Explanation output file name; "-" (default) uses standard output`)
+	_ = explainCmd.MarkFlagRequired("peer")
+}
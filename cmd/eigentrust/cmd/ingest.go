@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/api/openapi"
+	"k3l.io/go-eigentrust/pkg/ingest"
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// ingestCmd represents the ingest command
+	ingestCmd = &cobra.Command{
+		Use:   "ingest",
+		Short: "Build local trust from interaction events.",
+		Long: `Build local trust from interaction (e.g. transaction or rating)
+events and write it as a local trust CSV.
+
+Events are read from CSV/TSV with a header, or JSON Lines (.jsonl/.ndjson),
+with the fields: from, to, outcome (optional), weight (optional, default 1),
+and time (optional, Unix seconds or RFC 3339).
+
+Events between the same peers aggregate by mode:
+satunsat (EigenTrust paper: satisfactory minus unsatisfactory, clipped at 0),
+count (number of events), or weighted (sum of outcome weight times weight).
+Outcome weights come from the JSON config file (--config), e.g.:
+
+  {"mode": "satunsat",
+   "outcomeWeights": {"success": 1, "failure": -1},
+   "defaultOutcomeWeight": 0}
+
+If any event has a time, the output has a "t" column
+with the latest event time of each entry, for use with --decay.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runIngest,
+	}
+	ingestEventsFilenames []string
+	ingestConfigFilename  string
+	ingestMode            string
+	ingestOutputFilename  string
+)
+
+func runIngest( /*cmd*/ *cobra.Command /*args*/, []string) {
+	if err := openPeerMap(true); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, edgeTimes, err := ingestEvents(ctx)
+	if err != nil {
+		logger.Err(err).Msg("cannot ingest events")
+		return
+	}
+	file, err := util.OpenOutputFile(ingestOutputFilename)
+	if err != nil {
+		logger.Err(err).Msg("cannot open output file")
+		return
+	}
+	defer util.Close(file)
+	if err = writeIngestedLocalTrust(c, edgeTimes, file); err != nil {
+		logger.Err(err).Msg("cannot write local trust")
+	}
+}
+
+func loadIngestConfig() (*ingest.Config, error) {
+	config := &ingest.Config{}
+	if ingestConfigFilename != "" {
+		f, err := os.Open(ingestConfigFilename)
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(f)
+		if config, err = ingest.LoadConfig(f); err != nil {
+			return nil, err
+		}
+	}
+	if ingestMode != "" {
+		mode, err := ingest.ParseMode(ingestMode)
+		if err != nil {
+			return nil, err
+		}
+		config.Mode = mode
+	}
+	return config, nil
+}
+
+func ingestEvents(ctx context.Context) (
+	c *sparse.Matrix, edgeTimes *sparse.Matrix, err error,
+) {
+	config, err := loadIngestConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot load config: %w", err)
+	}
+	a, err := ingest.NewAggregator(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid config: %w", err)
+	}
+	for _, filename := range ingestEventsFilenames {
+		if err = ingestEventsFile(ctx, a, filename); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+	if c, err = a.LocalTrust(ctx); err != nil {
+		return nil, nil, err
+	}
+	if edgeTimes, err = a.EdgeTimes(ctx); err != nil {
+		return nil, nil, err
+	}
+	return c, edgeTimes, nil
+}
+
+func ingestEventsFile(
+	ctx context.Context, a *ingest.Aggregator, filename string,
+) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer util.Close(f)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(chan ingest.Event)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- sendEventsFromFile(ctx, f, filename, ch)
+	}()
+	if err = a.AddFromChannel(ctx, ch); err != nil {
+		return err
+	}
+	return <-sendErr
+}
+
+func sendEventsFromFile(
+	ctx context.Context, r io.Reader, filename string, ch chan<- ingest.Event,
+) error {
+	if openapi.IsJSONLines(filename) {
+		return ingest.SendEventsFromJSONL(ctx, r, ch, peerMap)
+	}
+	reader := csv.NewReader(r)
+	if strings.ToLower(filepath.Ext(filename)) == ".tsv" {
+		reader.Comma = '\t'
+	}
+	return ingest.SendEventsFromCSV(ctx, reader, ch, peerMap)
+}
+
+// writeIngestedLocalTrust writes the given local trust into w as CSV,
+// with the "t" column of edge times if any.
+func writeIngestedLocalTrust(
+	c *sparse.Matrix, edgeTimes *sparse.Matrix, w io.Writer,
+) error {
+	hasTimes := false
+	for _, span := range edgeTimes.Entries {
+		for _, e := range span {
+			hasTimes = hasTimes || e.Value != 0
+		}
+	}
+	header := []string{"i", "j", "v"}
+	if hasTimes {
+		header = append(header, "t")
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i, span := range c.Entries {
+		from, err := peer.GetId(i, peerMap)
+		if err != nil {
+			return err
+		}
+		times := edgeTimes.Entries[i]
+		for _, e := range span {
+			to, err := peer.GetId(e.Index, peerMap)
+			if err != nil {
+				return err
+			}
+			record := []string{
+				from, to, strconv.FormatFloat(e.Value, 'f', -1, 64),
+			}
+			if hasTimes {
+				for len(times) > 0 && times[0].Index < e.Index {
+					times = times[1:]
+				}
+				t := "0"
+				if len(times) > 0 && times[0].Index == e.Index {
+					t = strconv.FormatFloat(times[0].Value, 'f', -1, 64)
+				}
+				record = append(record, t)
+			}
+			if err = cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func init() {
+	rootCmd.AddCommand(ingestCmd)
+	ingestCmd.Flags().StringSliceVarP(&ingestEventsFilenames, "events", "e",
+		[]string{"events.csv"}, `Event file name(s).`)
+	ingestCmd.Flags().StringVarP(&ingestConfigFilename, "config", "c", "",
+		`Aggregation config (JSON) file name
+(default: none; satunsat mode, every outcome weighs 1)`)
+	ingestCmd.Flags().StringVarP(&ingestMode, "mode", "m", "",
+		`Aggregation mode, overriding the config:
+satunsat, count, or weighted`)
+	ingestCmd.Flags().StringVarP(&ingestOutputFilename, "output", "o", "-",
+		`Local trust output file name; "-" (default) uses standard output`)
+	ingestCmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
+		`Whether to use from/to in input events directly as peer indices
+(default: false)`)
+	addPeerMapFlags(ingestCmd)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/graph"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// inspectCmd represents the inspect command
+	inspectCmd = &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect a local trust graph.",
+		Long: `Inspect a local trust graph and print a JSON report:
+degree distributions, dangling peers, self-loops, negative edges,
+strongly connected components, and peers unreachable from pre-trusted peers.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runInspect,
+	}
+	inspectLocalTrustFilename string
+	inspectPreTrustFilename   string
+	inspectOutputFilename     string
+)
+
+func runInspect( /*cmd*/ *cobra.Command /*args*/, []string) {
+	if err := openPeerMap(false); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	report, err := inspect(ctx)
+	if err != nil {
+		logger.Err(err).Msg("cannot inspect local trust")
+		return
+	}
+	file, err := util.OpenOutputFile(inspectOutputFilename)
+	if err != nil {
+		logger.Err(err).Msg("cannot open output file")
+		return
+	}
+	defer util.Close(file)
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		logger.Err(err).Msg("cannot write report")
+	}
+}
+
+func inspect(ctx context.Context) (*graph.Report, error) {
+	// Keep zero and negative entries; they are what we are looking for.
+	c, err := readTrustMatrixFile(ctx, inspectLocalTrustFilename,
+		spopt.IncludeZero, spopt.AllowNegative)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load local trust: %w", err)
+	}
+	var p *sparse.Vector
+	if inspectPreTrustFilename != "" {
+		p, err = readTrustVectorFile(ctx, inspectPreTrustFilename)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load pre-trust: %w", err)
+		}
+	}
+	// align dimensions
+	rows, cols := c.Dims()
+	n := max(rows, cols)
+	if p != nil {
+		n = max(n, p.Dim)
+		p.SetDim(n)
+	}
+	c.SetDim(n, n)
+	return graph.Analyze(ctx, c, p)
+}
+
+func init() {
+	rootCmd.AddCommand(inspectCmd)
+	inspectCmd.Flags().StringVarP(&inspectLocalTrustFilename, "local-trust",
+		"l", "localtrust.csv", `Local trust file name.`)
+	inspectCmd.Flags().StringVarP(&inspectPreTrustFilename, "pre-trust", "p",
+		"",
+		`Pre-trust file name.
+If not given, every peer is considered pre-trusted.`)
+	inspectCmd.Flags().StringVarP(&inspectOutputFilename, "output", "o", "-",
+		`Report output file name; "-" (default) uses standard output`)
+	inspectCmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
+		`Whether to use truster/trustee in input CSV directly as peer indices
+(default: false)`)
+	addTextFormatFlags(inspectCmd)
+	addPeerMapFlags(inspectCmd)
+}
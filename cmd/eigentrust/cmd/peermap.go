@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// peerMapCmd represents the peer-map command
+	peerMapCmd = &cobra.Command{
+		Use:   "peer-map",
+		Short: "Manage a persistent peer map file.",
+		Long: `Manage a persistent peer map file,
+which assigns stable peer indices to peer identifiers across runs.
+
+The file has one peer identifier per line, in peer index order.
+Commands given --peer-map append newly seen peers to it.`,
+	}
+	// peerMapCompactCmd represents the peer-map compact command
+	peerMapCompactCmd = &cobra.Command{
+		Use:   "compact",
+		Short: "Drop peers unused by the given trust files.",
+		Long: `Drop peers from the peer map file
+that do not appear in any of the given local trust and pre-trust files,
+renumbering the remaining peers in their original order.
+
+Matrices and vectors stored by peer index (not identifier)
+must be remapped using the old-to-new index mapping (see --remap-output).`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runPeerMapCompact,
+	}
+	peerMapFilename            string
+	peerIdNormalizers          []string
+	peerAliasesFilename        string
+	compactLocalTrustFilenames []string
+	compactPreTrustFilenames   []string
+	compactRemapFilename       string
+)
+
+// openPeerMap sets up peerMap, unless raw peer IDs are used:
+// a new in-memory one, or one backed by the --peer-map file if given,
+// with peer ID normalizers and aliases if given,
+// which also apply to the peer IDs loaded from the file.
+// Only a writable map records newly seen peers into the file.
+func openPeerMap(writable bool) error {
+	if rawPeerIds {
+		peerMap = nil
+		return nil
+	}
+	var opts []peer.MapOption
+	if len(peerIdNormalizers) != 0 {
+		normalizer, err := peer.ParseNormalizers(peerIdNormalizers...)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, peer.WithNormalizer(normalizer))
+	}
+	if peerAliasesFilename != "" {
+		f, err := os.Open(peerAliasesFilename)
+		if err != nil {
+			return fmt.Errorf("cannot load peer aliases: %w", err)
+		}
+		defer util.Close(f)
+		r := csv.NewReader(f)
+		r.Comment = '#'
+		opts = append(opts, peer.WithAliases(r))
+	}
+	m, err := openPeerMapFile(writable, opts...)
+	if err != nil {
+		return err
+	}
+	peerMap = m
+	if merged := peerMap.Merged(); merged != nil {
+		ids := peerMap.Ids()
+		for index, first := range merged {
+			logger.Warn().
+				Int("index", index).
+				Int("mergedInto", first).
+				Str("id", ids[index]).
+				Msg("peer map file entry merged after normalization")
+		}
+	}
+	return nil
+}
+
+func openPeerMapFile(
+	writable bool, opts ...peer.MapOption,
+) (m *peer.Map, err error) {
+	switch {
+	case peerMapFilename == "":
+		m = peer.NewMap()
+		for _, opt := range opts {
+			if err = opt(m); err != nil {
+				break
+			}
+		}
+	case writable:
+		m, err = peer.OpenMapFile(peerMapFilename, opts...)
+	default:
+		m, err = peer.OpenMapFileReadOnly(peerMapFilename, opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot open peer map: %w", err)
+	}
+	return m, nil
+}
+
+// closePeerMap syncs and closes the peer map file, if any.
+func closePeerMap() {
+	if peerMap == nil {
+		return
+	}
+	if err := peerMap.Close(); err != nil {
+		logger.Err(err).Msg("cannot save peer map file")
+	}
+}
+
+// addPeerMapFlags adds peer map flags to cmd.
+func addPeerMapFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&peerMapFilename, "peer-map", "",
+		`Persistent peer map file, for stable peer indices across runs
+(default: none; peer indices are assigned in order of appearance)`)
+	addPeerIdFlags(cmd)
+}
+
+// addPeerIdFlags adds peer ID normalization and aliasing flags to cmd.
+func addPeerIdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&peerIdNormalizers, "peer-id-normalizers", nil,
+		`Peer ID normalizers to apply in order, so that different spellings
+of the same ID refer to the same peer: trim (whitespace), lower (case),
+eip55 (Ethereum address checksum case), strip-prefix:PREFIX
+(default: none)`)
+	cmd.Flags().StringVar(&peerAliasesFilename, "peer-aliases", "",
+		`Peer alias CSV file, with alias and peer ID per line (no header),
+merging aliases into the same peer (default: none)`)
+}
+
+func runPeerMapCompact( /*cmd*/ *cobra.Command /*args*/, []string) {
+	rawPeerIds = false
+	if err := openPeerMap(true); err != nil {
+		logger.Err(err).Msg("cannot open peer map")
+		return
+	}
+	defer closePeerMap()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	remap, err := compactPeerMap(ctx)
+	if err != nil {
+		logger.Err(err).Msg("cannot compact peer map")
+		return
+	}
+	logger.Info().
+		Int("before", len(remap)).
+		Int("after", peerMap.Len()).
+		Msg("peer map compacted")
+	if err = writeRemap(remap, compactRemapFilename); err != nil {
+		logger.Err(err).Msg("cannot write remap file")
+	}
+}
+
+func compactPeerMap(ctx context.Context) ([]peer.Index, error) {
+	var mark []func(used []bool)
+	for _, filename := range compactLocalTrustFilenames {
+		c, err := readTrustMatrixFile(ctx, filename, spopt.AllowNegative)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load local trust %#v: %w",
+				filename, err)
+		}
+		mark = append(mark, c.MarkUsed)
+	}
+	for _, filename := range compactPreTrustFilenames {
+		p, err := readTrustVectorFile(ctx, filename)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load pre-trust %#v: %w",
+				filename, err)
+		}
+		mark = append(mark, p.MarkUsed)
+	}
+	// Loading may have allocated new peers; size after all are loaded.
+	used := make([]bool, peerMap.Len())
+	for _, markUsed := range mark {
+		markUsed(used)
+	}
+	return peerMap.Compact(func(index peer.Index) bool { return used[index] })
+}
+
+// writeRemap writes the old-to-new peer index mapping into a CSV file,
+// with -1 for dropped peers.
+func writeRemap(remap []peer.Index, filename string) error {
+	file, err := util.OpenOutputFile(filename)
+	if err != nil {
+		return err
+	}
+	defer util.Close(file)
+	w := csv.NewWriter(file)
+	if err = w.Write([]string{"old", "new"}); err != nil {
+		return err
+	}
+	for from, to := range remap {
+		err = w.Write([]string{strconv.Itoa(from), strconv.Itoa(to)})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func init() {
+	rootCmd.AddCommand(peerMapCmd)
+	peerMapCmd.AddCommand(peerMapCompactCmd)
+	peerMapCompactCmd.Flags().StringVar(&peerMapFilename, "peer-map", "",
+		`Peer map file to compact (required)`)
+	_ = peerMapCompactCmd.MarkFlagRequired("peer-map")
+	peerMapCompactCmd.Flags().StringSliceVarP(&compactLocalTrustFilenames,
+		"local-trust", "l", nil, `Local trust file names (repeatable)`)
+	peerMapCompactCmd.Flags().StringSliceVarP(&compactPreTrustFilenames,
+		"pre-trust", "p", nil, `Pre-trust file names (repeatable)`)
+	peerMapCompactCmd.Flags().StringVar(&compactRemapFilename,
+		"remap-output", "",
+		`Old-to-new peer index mapping output CSV file name.
+"" (default) suppresses output; "-" uses standard output`)
+	addTextFormatFlags(peerMapCompactCmd)
+	addPeerIdFlags(peerMapCompactCmd)
+}
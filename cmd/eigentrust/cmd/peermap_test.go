@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenPeerMap_NormalizesFile(t *testing.T) {
+	mapFilename := filepath.Join(t.TempDir(), "peers.txt")
+	require.NoError(t, os.WriteFile(mapFilename, []byte("0xABC\n"), 0o644))
+
+	savedRaw, savedFilename, savedNormalizers :=
+		rawPeerIds, peerMapFilename, peerIdNormalizers
+	defer func() {
+		rawPeerIds, peerMapFilename, peerIdNormalizers =
+			savedRaw, savedFilename, savedNormalizers
+		peerMap = nil
+	}()
+	rawPeerIds, peerMapFilename = false, mapFilename
+	peerIdNormalizers = []string{"lower"}
+	require.NoError(t, openPeerMap(true))
+	assert.Equal(t, 0, peerMap.Allocate("0xABC"))
+	assert.Equal(t, 0, peerMap.Allocate("0xabc"))
+	closePeerMap()
+
+	content, err := os.ReadFile(mapFilename)
+	require.NoError(t, err)
+	assert.Equal(t, "0xABC\n", string(content))
+}
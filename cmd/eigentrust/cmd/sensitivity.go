@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/basic"
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// sensitivityCmd represents the sensitivity command
+	sensitivityCmd = &cobra.Command{
+		Use:   "sensitivity",
+		Short: "Find the local trust edges that most influence a score.",
+		Long: `Compute global trust locally, then estimate how the score
+of the given peer (--peer) depends on each local trust edge,
+and print a JSON report of the most influential edges and trusters.
+
+Distrust (negative local trust) is ignored.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runSensitivity,
+	}
+	sensitivityLocalTrustFilename string
+	sensitivityPreTrustFilename   string
+	sensitivityAlpha              float64
+	sensitivityEpsilon            float64
+	sensitivityPeer               string
+	sensitivityTopEdges           int
+	sensitivityTopTrusters        int
+	sensitivityOutputFilename     string
+)
+
+// edgeInfluence is an edge influence, with peer identifiers.
+type edgeInfluence struct {
+	Truster     string  `json:"truster"`
+	Trustee     string  `json:"trustee"`
+	Value       float64 `json:"value"`
+	Sensitivity float64 `json:"sensitivity"`
+	Influence   float64 `json:"influence"`
+}
+
+// sensitivity is a sensitivity report, with peer identifiers.
+type sensitivity struct {
+	Peer     string          `json:"peer"`
+	Score    float64         `json:"score"`
+	Edges    []edgeInfluence `json:"edges"`
+	Trusters []contribution  `json:"trusters"`
+}
+
+func runSensitivity( /*cmd*/ *cobra.Command /*args*/, []string) {
+	if err := openPeerMap(false); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s, err := analyzeSensitivity(ctx)
+	if err != nil {
+		logger.Err(err).Msg("cannot analyze sensitivity")
+		return
+	}
+	if err = writeSensitivity(s, sensitivityOutputFilename); err != nil {
+		logger.Err(err).Msg("cannot write report")
+	}
+}
+
+func analyzeSensitivity(ctx context.Context) (*basic.Sensitivity, error) {
+	if !(sensitivityAlpha > 0 && sensitivityAlpha <= 1) {
+		return nil, fmt.Errorf("alpha=%f out of range (0..1]",
+			sensitivityAlpha)
+	}
+	c, err := readTrustMatrixFile(ctx, sensitivityLocalTrustFilename,
+		spopt.AllowNegative)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load local trust: %w", err)
+	}
+	var p *sparse.Vector
+	if sensitivityPreTrustFilename != "" {
+		p, err = readTrustVectorFile(ctx, sensitivityPreTrustFilename)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load pre-trust: %w", err)
+		}
+	} else {
+		p = sparse.NewVector(0, nil)
+	}
+	// Peers are known once local trust and pre-trust are loaded.
+	index, err := peer.ParseId(sensitivityPeer, peerMap, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --peer: %w", err)
+	}
+	// align dimensions
+	rows, cols := c.Dims()
+	n := max(rows, cols, p.Dim, index+1)
+	c.SetDim(n, n)
+	p.SetDim(n)
+	if _, err = basic.ExtractDistrust(c); err != nil {
+		return nil, fmt.Errorf("cannot drop distrust: %w", err)
+	}
+	basic.CanonicalizeTrustVector(p)
+	if err = basic.CanonicalizeLocalTrust(c, p); err != nil {
+		return nil, fmt.Errorf("cannot canonicalize local trust: %w", err)
+	}
+	e := sensitivityEpsilon
+	if e == 0 {
+		e = 1e-6 / float64(n)
+	}
+	t, err := basic.Compute(ctx, c, p, sensitivityAlpha, e)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute EigenTrust: %w", err)
+	}
+	return basic.AnalyzeSensitivity(ctx, c, t, sensitivityAlpha, e, index,
+		basic.WithTopEdges(sensitivityTopEdges),
+		basic.WithTopTrusters(sensitivityTopTrusters))
+}
+
+// writeSensitivity writes the given sensitivity report into the given file
+// as JSON, with peer indices turned back into peer identifiers.
+func writeSensitivity(s *basic.Sensitivity, filename string) error {
+	var err error
+	out := sensitivity{
+		Score:    s.Score,
+		Edges:    make([]edgeInfluence, 0, len(s.Edges)),
+		Trusters: make([]contribution, 0, len(s.Trusters)),
+	}
+	if out.Peer, err = peer.GetId(s.Peer, peerMap); err != nil {
+		return err
+	}
+	for _, edge := range s.Edges {
+		e := edgeInfluence{
+			Value:       edge.Value,
+			Sensitivity: edge.Sensitivity,
+			Influence:   edge.Influence,
+		}
+		if e.Truster, err = peer.GetId(edge.Truster, peerMap); err != nil {
+			return err
+		}
+		if e.Trustee, err = peer.GetId(edge.Trustee, peerMap); err != nil {
+			return err
+		}
+		out.Edges = append(out.Edges, e)
+	}
+	for _, truster := range s.Trusters {
+		id, err := peer.GetId(truster.Peer, peerMap)
+		if err != nil {
+			return err
+		}
+		out.Trusters = append(out.Trusters,
+			contribution{Peer: id, Value: truster.Value})
+	}
+	file, err := util.OpenOutputFile(filename)
+	if err != nil {
+		return fmt.Errorf("cannot open output file: %w", err)
+	}
+	defer util.Close(file)
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+func init() {
+	rootCmd.AddCommand(sensitivityCmd)
+	sensitivityCmd.Flags().StringVarP(&sensitivityLocalTrustFilename,
+		"local-trust", "l", "localtrust.csv", `Local trust file name.`)
+	sensitivityCmd.Flags().StringVarP(&sensitivityPreTrustFilename,
+		"pre-trust", "p", "",
+		`Pre-trust file name.
+If not given, every peer is considered pre-trusted.`)
+	sensitivityCmd.Flags().Float64VarP(&sensitivityAlpha, "alpha", "a", 0.5,
+		`Alpha value, greater than 0.0 and at most 1.0.`)
+	sensitivityCmd.Flags().Float64VarP(&sensitivityEpsilon, "epsilon", "e",
+		0.0, `Epsilon (error max).  0 (default) uses 1e-6 / number of peers.`)
+	sensitivityCmd.Flags().StringVar(&sensitivityPeer, "peer", "",
+		`Peer whose score to analyze`)
+	sensitivityCmd.Flags().IntVar(&sensitivityTopEdges, "top-edges", 10,
+		`Number of most influential edges to list; 0 lists all`)
+	sensitivityCmd.Flags().IntVar(&sensitivityTopTrusters, "top-trusters",
+		10, `Number of most influential trusters to list; 0 lists all`)
+	sensitivityCmd.Flags().StringVarP(&sensitivityOutputFilename, "output",
+		"o", "-",
+		`Report output file name; "-" (default) uses standard output`)
+	sensitivityCmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
+		`Whether to use truster/trustee in input CSV directly as peer indices
+(default: false)`)
+	addTextFormatFlags(sensitivityCmd)
+	addPeerMapFlags(sensitivityCmd)
+	_ = sensitivityCmd.MarkFlagRequired("peer")
+}
@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/api/openapi"
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// simulateCmd represents the simulate command
+	simulateCmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate local trust and pre-trust changes.",
+		Long: `Submit a compute request (see "basic compute" for its flags)
+with hypothetical local trust (--local-trust-changes)
+and pre-trust (--pre-trust-changes) changes applied,
+and report the peers whose scores changed from the baseline,
+computed with the same flags but without the changes.
+
+Change files are in the same format as the local trust and pre-trust files;
+a zero value removes the entry.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runSimulate,
+	}
+	simulateLocalTrustChangesFilename string
+	simulatePreTrustChangesFilename   string
+	simulateMinChange                 float64
+	simulateLimit                     int
+	simulateOutputFilename            string
+)
+
+// scoreChange is a score change, with the peer identifier.
+type scoreChange struct {
+	Peer       string  `json:"peer"`
+	Before     float64 `json:"before"`
+	After      float64 `json:"after"`
+	RankBefore int     `json:"rankBefore"`
+	RankAfter  int     `json:"rankAfter"`
+}
+
+// simulation is a simulate response, with peer identifiers.
+type simulation struct {
+	NumChanged int           `json:"numChanged"`
+	Changes    []scoreChange `json:"changes"`
+}
+
+func runSimulate( /*cmd*/ *cobra.Command /*args*/, []string) {
+	basicSetupEndpoint()
+	if useFileURI {
+		rawPeerIds = true
+	}
+	if err := openPeerMap(true); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		return
+	}
+	defer closePeerMap()
+	client, err := openapi.NewClientWithResponses(endpoint)
+	if err != nil {
+		logger.Err(err).Msg("cannot create an API client")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	computeBody, err := newComputeRequestBody()
+	if err != nil {
+		logger.Err(err).Msg("invalid compute request")
+		return
+	}
+	requestBody := openapi.SimulateJSONRequestBody{
+		Compute:   *computeBody,
+		MinChange: &simulateMinChange,
+		Limit:     &simulateLimit,
+	}
+	if requestBody.LocalTrustChanges, err = readLocalTrustChanges(
+		ctx, simulateLocalTrustChangesFilename,
+	); err != nil {
+		logger.Err(err).Msg("cannot load local trust changes")
+		return
+	}
+	if requestBody.PreTrustChanges, err = readPreTrustChanges(
+		ctx, simulatePreTrustChangesFilename,
+	); err != nil {
+		logger.Err(err).Msg("cannot load pre-trust changes")
+		return
+	}
+	resp, err := client.SimulateWithResponse(ctx, requestBody)
+	if err != nil {
+		logger.Err(err).Msg("request failed")
+		return
+	}
+	switch resp.StatusCode() {
+	case 200:
+		if resp.JSON200 == nil {
+			logger.Error().Msg("cannot recover HTTP 200 response")
+		} else if err = writeSimulation(
+			resp.JSON200, simulateOutputFilename,
+		); err != nil {
+			logger.Err(err).Msg("cannot write simulation")
+		}
+	case 400:
+		if resp.JSON400 != nil {
+			logger.Error().Str("error", resp.JSON400.Message).
+				Msg("invalid request")
+		}
+	default:
+		logger.Error().Str("status", resp.HTTPResponse.Status).
+			Msg("server returned unknown status code")
+	}
+}
+
+// readLocalTrustChanges reads local trust changes from the given file,
+// in the local trust file format; nil if filename is empty.
+// Zero entries (removals) are kept, and later entries override earlier ones.
+func readLocalTrustChanges(
+	ctx context.Context, filename string,
+) (*[]openapi.LocalTrustChange, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	m, err := readTrustMatrixFile(ctx, filename,
+		spopt.AllowNegative, spopt.IncludeZero, spopt.LastWins)
+	if err != nil {
+		return nil, err
+	}
+	var changes []openapi.LocalTrustChange
+	for i, row := range m.Entries {
+		for _, e := range row {
+			changes = append(changes,
+				openapi.LocalTrustChange{I: i, J: e.Index, V: e.Value})
+		}
+	}
+	return &changes, nil
+}
+
+// readPreTrustChanges reads pre-trust changes from the given file,
+// as with readLocalTrustChanges.
+func readPreTrustChanges(
+	ctx context.Context, filename string,
+) (*[]openapi.PreTrustChange, error) {
+	if filename == "" {
+		return nil, nil
+	}
+	v, err := readTrustVectorFile(ctx, filename,
+		spopt.IncludeZero, spopt.LastWins)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]openapi.PreTrustChange, 0, len(v.Entries))
+	for _, e := range v.Entries {
+		changes = append(changes, openapi.PreTrustChange{I: e.Index, V: e.Value})
+	}
+	return &changes, nil
+}
+
+// writeSimulation writes the given simulation into the given file
+// as JSON, with peer indices turned back into peer identifiers.
+func writeSimulation(sim *openapi.Simulation, filename string) error {
+	out := simulation{
+		NumChanged: sim.NumChanged,
+		Changes:    make([]scoreChange, 0, len(sim.Changes)),
+	}
+	for _, sc := range sim.Changes {
+		id, err := peer.GetId(sc.Peer, peerMap)
+		if err != nil {
+			return err
+		}
+		out.Changes = append(out.Changes, scoreChange{
+			Peer:       id,
+			Before:     sc.Before,
+			After:      sc.After,
+			RankBefore: sc.RankBefore,
+			RankAfter:  sc.RankAfter,
+		})
+	}
+	file, err := util.OpenOutputFile(filename)
+	if err != nil {
+		return fmt.Errorf("cannot open output file: %w", err)
+	}
+	defer util.Close(file)
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().StringVarP(&endpoint, "endpoint", "H",
+		"https://api.k3l.io/basic/v1",
+		`API endpoint address`)
+	simulateCmd.Flags().BoolVarP(&useLocalEndpoint, "local", "L", false,
+		`use local API endpoint at http://localhost:8080 (ignores --endpoint)`)
+	addComputeFlags(simulateCmd)
+	simulateCmd.Flags().StringVar(&simulateLocalTrustChangesFilename,
+		"local-trust-changes", "",
+		`Local trust changes file, in the local trust file format
+(default: none)`)
+	simulateCmd.Flags().StringVar(&simulatePreTrustChangesFilename,
+		"pre-trust-changes", "",
+		`Pre-trust changes file, in the pre-trust file format (default: none)`)
+	simulateCmd.Flags().Float64Var(&simulateMinChange, "min-change", 0,
+		`Minimum absolute score change to report; 0 reports all changes`)
+	simulateCmd.Flags().IntVar(&simulateLimit, "limit", 0,
+		`Maximum number of peers to report, largest changes first; 0: unlimited`)
+	simulateCmd.Flags().StringVarP(&simulateOutputFilename, "output", "o", "-",
+		`Simulation output file name; "-" (default) uses standard output`)
+}
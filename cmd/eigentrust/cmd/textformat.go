@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/api/openapi"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+var (
+	csvDelimiter      string
+	csvComment        string
+	csvHasHeader      bool
+	localTrustColumns []int
+	preTrustColumns   []int
+	localTrustFields  []string
+	preTrustFields    []string
+)
+
+// textFormat returns the delimited-text input format
+// specified on the command line, with the given column positions.
+func textFormat(columns []int) *openapi.DelimitedTextFormat {
+	f := &openapi.DelimitedTextFormat{Header: &csvHasHeader}
+	if csvDelimiter != "" {
+		f.Delimiter = &csvDelimiter
+	}
+	if csvComment != "" {
+		f.Comment = &csvComment
+	}
+	if len(columns) != 0 {
+		f.Columns = &columns
+	}
+	return f
+}
+
+// jsonLinesFormat returns the JSON Lines input format
+// with the given member names.
+func jsonLinesFormat(fields []string) *openapi.JSONLinesFormat {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &openapi.JSONLinesFormat{Fields: &fields}
+}
+
+// localTrustTextOptions returns sparse options
+// for reading the given local trust file.
+func localTrustTextOptions(filename string) ([]spopt.Option, error) {
+	return openapi.DelimitedTextOptions(textFormat(localTrustColumns),
+		filename, true)
+}
+
+// preTrustTextOptions returns sparse options
+// for reading the given pre-trust file.
+func preTrustTextOptions(filename string) ([]spopt.Option, error) {
+	return openapi.DelimitedTextOptions(textFormat(preTrustColumns),
+		filename, false)
+}
+
+// localTrustJSONLinesOptions returns sparse options
+// for reading a local trust JSON Lines file.
+func localTrustJSONLinesOptions() ([]spopt.Option, error) {
+	return openapi.JSONLinesOptions(jsonLinesFormat(localTrustFields), true)
+}
+
+// preTrustJSONLinesOptions returns sparse options
+// for reading a pre-trust JSON Lines file.
+func preTrustJSONLinesOptions() ([]spopt.Option, error) {
+	return openapi.JSONLinesOptions(jsonLinesFormat(preTrustFields), false)
+}
+
+// addTextFormatFlags adds delimited-text and JSON Lines input format flags
+// to cmd.
+func addTextFormatFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&csvDelimiter, "csv-delimiter", "",
+		`Input field delimiter character
+(default: tab for .tsv files, comma otherwise)`)
+	cmd.Flags().StringVar(&csvComment, "csv-comment", "",
+		`Input comment character, e.g. "#";
+lines beginning with it are ignored (default: none)`)
+	cmd.Flags().BoolVar(&csvHasHeader, "csv-header", true,
+		`Whether input CSV has a header line (default: true)`)
+	cmd.Flags().IntSliceVar(&localTrustColumns, "local-trust-columns", nil,
+		`Zero-based truster, trustee and trust level column positions
+in the local trust file (default: by header name, or 0,1,2 without header)`)
+	cmd.Flags().IntSliceVar(&preTrustColumns, "pre-trust-columns", nil,
+		`Zero-based peer and trust level column positions
+in the pre-trust file (default: by header name, or 0,1 without header)`)
+	cmd.Flags().StringSliceVar(&localTrustFields, "local-trust-fields", nil,
+		`Truster, trustee and trust level member names
+in the local trust JSON Lines file (default: i,j,v)`)
+	cmd.Flags().StringSliceVar(&preTrustFields, "pre-trust-fields", nil,
+		`Peer and trust level member names
+in the pre-trust JSON Lines file (default: i,v)`)
+}
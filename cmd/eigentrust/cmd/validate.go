@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+var (
+	// validateCmd represents the validate command
+	validateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate local trust and pre-trust files.",
+		Long: `Validate local trust and pre-trust files,
+reporting every problem found with its line (and column, for CSV),
+so that the files can be fixed at once.
+
+Exits with status 1 if any problem is found.`,
+		Args: cobra.MatchAll(cobra.NoArgs),
+		Run:  runValidate,
+	}
+	validateLocalTrustFilename string
+	validatePreTrustFilename   string
+	validateAllowNegative      bool
+	validateAllowDuplicates    bool
+)
+
+func runValidate( /*cmd*/ *cobra.Command /*args*/, []string) {
+	if err := openPeerMap(false); err != nil {
+		logger.Err(err).Msg("cannot set up peer map")
+		os.Exit(2)
+	}
+	defer closePeerMap()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := []spopt.Option{spopt.AllowNegativeSetTo(validateAllowNegative)}
+	if !validateAllowDuplicates {
+		opts = append(opts, spopt.RejectDuplicates)
+	}
+	numIssues := 0
+	for _, file := range []struct {
+		filename    string
+		textOptions func(filename string) ([]spopt.Option, error)
+		validate    func(
+			ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+		) ([]sparse.CSVIssue, error)
+		jsonlOptions  func() ([]spopt.Option, error)
+		validateJSONL func(
+			ctx context.Context, r io.Reader, opts ...spopt.Option,
+		) ([]sparse.CSVIssue, error)
+	}{
+		{
+			validateLocalTrustFilename, localTrustTextOptions,
+			sparse.ValidateMatrixCSV,
+			localTrustJSONLinesOptions, sparse.ValidateMatrixJSONL,
+		},
+		{
+			validatePreTrustFilename, preTrustTextOptions,
+			sparse.ValidateVectorCSV,
+			preTrustJSONLinesOptions, sparse.ValidateVectorJSONL,
+		},
+	} {
+		if file.filename == "" {
+			continue
+		}
+		var (
+			formatOpts []spopt.Option
+			issues     []sparse.CSVIssue
+			err        error
+		)
+		jsonl := isJSONLinesFile(file.filename)
+		if jsonl {
+			formatOpts, err = file.jsonlOptions()
+		} else {
+			formatOpts, err = file.textOptions(file.filename)
+		}
+		if err != nil {
+			logger.Err(err).Str("filename", file.filename).
+				Msg("invalid input format")
+			os.Exit(2)
+		}
+		if jsonl {
+			issues, err = validateJSONLinesFile(ctx, file.filename,
+				file.validateJSONL, append(formatOpts, opts...))
+		} else {
+			issues, err = validateFile(ctx, file.filename, file.validate,
+				append(formatOpts, opts...))
+		}
+		if err != nil {
+			logger.Err(err).Str("filename", file.filename).
+				Msg("cannot validate file")
+			os.Exit(2)
+		}
+		for _, issue := range issues {
+			fmt.Printf("%s: %v\n", file.filename, issue)
+		}
+		numIssues += len(issues)
+	}
+	if numIssues != 0 {
+		os.Exit(1)
+	}
+}
+
+func validateFile(
+	ctx context.Context, filename string,
+	validate func(
+		ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+	) ([]sparse.CSVIssue, error),
+	opts []spopt.Option,
+) ([]sparse.CSVIssue, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	if ext != ".csv" && ext != ".tsv" {
+		return nil, fmt.Errorf("invalid file type %#v", ext)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(f)
+	opts = append([]spopt.Option{validatePeerMapOption()}, opts...)
+	return validate(ctx, sparse.NewCSVReader(f, opts...), opts...)
+}
+
+// isJSONLinesFile returns whether the given file is JSON Lines,
+// judging by its extension.
+func isJSONLinesFile(filename string) bool {
+	ext := strings.ToLower(filepath.Ext(filename))
+	return ext == ".jsonl" || ext == ".ndjson"
+}
+
+// validateJSONLinesFile is validateFile for JSON Lines files.
+func validateJSONLinesFile(
+	ctx context.Context, filename string,
+	validate func(
+		ctx context.Context, r io.Reader, opts ...spopt.Option,
+	) ([]sparse.CSVIssue, error),
+	opts []spopt.Option,
+) ([]sparse.CSVIssue, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer util.Close(f)
+	opts = append([]spopt.Option{validatePeerMapOption()}, opts...)
+	return validate(ctx, f, opts...)
+}
+
+// validatePeerMapOption returns the peer map option for validation.
+func validatePeerMapOption() spopt.Option {
+	switch {
+	case peerMap == nil:
+		return spopt.LiteralIndices
+	case peerMapFilename != "":
+		// Only look up, so that unknown peer IDs are reported.
+		return spopt.IndicesIn(peerMap)
+	default:
+		return spopt.IndicesInto(peerMap)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+	validateCmd.Flags().StringVarP(&validateLocalTrustFilename, "local-trust",
+		"l", "", `Local trust file name.`)
+	validateCmd.Flags().StringVarP(&validatePreTrustFilename, "pre-trust", "p",
+		"", `Pre-trust file name.`)
+	validateCmd.Flags().BoolVar(&validateAllowNegative, "allow-negative", false,
+		`Whether to allow negative values (distrust)`)
+	validateCmd.Flags().BoolVar(&validateAllowDuplicates, "allow-duplicates",
+		false,
+		`Whether to allow multiple entries for the same peer(s);
+the last one wins`)
+	validateCmd.Flags().BoolVar(&rawPeerIds, "raw-peer-ids", false,
+		`Whether to use truster/trustee in input CSV directly as peer indices
+(default: false)`)
+	addTextFormatFlags(validateCmd)
+	addPeerMapFlags(validateCmd)
+}
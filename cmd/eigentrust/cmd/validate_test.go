@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestValidateFile_UnknownPeerId(t *testing.T) {
+	dir := t.TempDir()
+	mapFilename := filepath.Join(dir, "peers.txt")
+	require.NoError(t, os.WriteFile(mapFilename, []byte("alice\nbob\n"), 0o644))
+	ltFilename := filepath.Join(dir, "lt.csv")
+	require.NoError(t, os.WriteFile(ltFilename,
+		[]byte("i,j,v\nalice,bob,1\nbob,carol,1\n"), 0o644))
+
+	savedRaw, savedFilename := rawPeerIds, peerMapFilename
+	defer func() {
+		rawPeerIds, peerMapFilename = savedRaw, savedFilename
+		peerMap = nil
+	}()
+	rawPeerIds, peerMapFilename = false, mapFilename
+	require.NoError(t, openPeerMap(false))
+	defer closePeerMap()
+
+	issues, err := validateFile(context.Background(), ltFilename,
+		sparse.ValidateMatrixCSV, nil)
+	require.NoError(t, err)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, 3, issues[0].Line)
+		assert.ErrorIs(t, issues[0], peer.NoSuchId{Value: "carol"})
+	}
+	// Validation must not record the unknown peer.
+	_, found := peerMap.Index("carol")
+	assert.False(t, found)
+}
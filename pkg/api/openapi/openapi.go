@@ -22,6 +22,21 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+// Defines values for DanglingStrategy.
+const (
+	Pretrust     DanglingStrategy = "pretrust"
+	Redistribute DanglingStrategy = "redistribute"
+	Selfloop     DanglingStrategy = "selfloop"
+	Uniform      DanglingStrategy = "uniform"
+)
+
+// Defines values for DiscountMode.
+const (
+	Clamped    DiscountMode = "clamped"
+	Onestep    DiscountMode = "onestep"
+	Propagated DiscountMode = "propagated"
+)
+
 // Defines values for TrustRefScheme.
 const (
 	Inline        TrustRefScheme = "inline"
@@ -33,14 +48,73 @@ const (
 type ComputeParams struct {
 	Alpha *float64 `json:"alpha,omitempty"`
 
+	// AntiTrustWeight The factor by which to scale Anti-TrustRank scores
+	// before subtracting them from global trust.
+	AntiTrustWeight *float64 `json:"antiTrustWeight,omitempty"`
+
 	// CheckFreq If given (n), exit criteria are checked every n iterations.
 	// It can be used in conjunction with minIterations
 	// for "modulo n" behavior,
 	// e.g. with minIterations=7 and checkFreq=5
 	// exit criteria are checked after 7/12/17/... iterations.
 	// Default is 1: exit criteria are checked after every iteration.
-	CheckFreq *int     `json:"checkFreq,omitempty"`
-	Epsilon   *float64 `json:"epsilon,omitempty"`
+	CheckFreq *int `json:"checkFreq,omitempty"`
+
+	// Dangling How to handle trust of dangling peers,
+	// i.e. peers without outbound local trust:
+	//
+	//   * `pretrust` (default): Distribute it according to the pre-trust
+	//     (pre-trust teleport).
+	//   * `uniform`: Distribute it equally onto all peers
+	//     (uniform teleport).
+	//   * `selfloop`: Keep it, as if dangling peers trusted themselves.
+	//   * `redistribute`: Redistribute it onto all peers
+	//     in proportion to their current trust, in each iteration.
+	Dangling *DanglingStrategy `json:"dangling,omitempty"`
+
+	// Decay Decay to apply to local trust values
+	// by the age of each entry, before canonicalization.
+	// Entry times come from `t` of inline entries,
+	// or from the `t` column (CSV header) or member (JSON Lines)
+	// of object storage and uploaded local trust:
+	//
+	//   * `exp:HALF-LIFE`: Halve values every HALF-LIFE.
+	//   * `linear:WINDOW`: Scale values linearly
+	//     from 1 (brand new) down to 0 (WINDOW old or older).
+	//   * `step:CUTOFF`: Drop values older than CUTOFF.
+	//
+	// Durations are in Go format (e.g. `72h`) or in days (e.g. `30d`).
+	// Entries without time are not decayed,
+	// but decaying local trust without any entry time is an error.
+	// Applies to `localTrustLayers` as well.
+	Decay *string `json:"decay,omitempty"`
+
+	// DecayReference The Unix time (in seconds) as of which to compute entry ages
+	// for `decay`.  Defaults to now.
+	DecayReference *int64 `json:"decayReference,omitempty"`
+
+	// DiscountMode How distrust (negative local trust) adjusts global trust:
+	//
+	//   * `onestep` (default): Subtract each distruster's distrust,
+	//     scaled by their own global trust, exactly once.
+	//     Global trust may become negative.
+	//   * `clamped`: Same as `onestep`,
+	//     then clamp negative global trust at zero.
+	//   * `propagated`: Also distrust whom trusted peers distrust:
+	//     each distruster's distrust is scaled by their own global trust
+	//     plus the trust flowing into them
+	//     (from their trusters, through `distrustHops` hops).
+	DiscountMode *DiscountMode `json:"discountMode,omitempty"`
+
+	// DistrustHops The number of hops to propagate distrust through trust,
+	// for the `propagated` discount mode.
+	DistrustHops *int `json:"distrustHops,omitempty"`
+
+	// DistrustWeight The factor by which to scale all distrust (negative local trust)
+	// when discounting global trust,
+	// e.g. 2 punishes distrusted peers twice as hard, 0 ignores distrust.
+	DistrustWeight *float64 `json:"distrustWeight,omitempty"`
+	Epsilon        *float64 `json:"epsilon,omitempty"`
 
 	// FlatTail The length of the flat tail
 	// (ranking unchanged from previous iteration)
@@ -83,6 +157,21 @@ type ComputeParams struct {
 	// (the peer is the "truster").
 	LocalTrust TrustRef `json:"localTrust"`
 
+	// LocalTrustLayers Additional local trust layers, e.g. one per type of trust signal
+	// such as follows, endorsements, or payments.
+	//
+	// If given, `localTrust` (the first layer, weighted by
+	// `localTrustWeight`) and these layers are blended
+	// into their weighted sum, which is then used as the local trust.
+	// Each layer except raw ones is scaled first
+	// so that each truster's absolute values sum to one,
+	// so that layers on different scales contribute by weight only.
+	LocalTrustLayers *[]LocalTrustLayer `json:"localTrustLayers,omitempty"`
+
+	// LocalTrustWeight The weight of `localTrust` when blending it with
+	// `localTrustLayers`; ignored otherwise.
+	LocalTrustWeight *float64 `json:"localTrustWeight,omitempty"`
+
 	// MaxIterations The maximum number of iterations after which to stop
 	// even if other termination criteria are not met.
 	// 0 means no limit.
@@ -97,6 +186,40 @@ type ComputeParams struct {
 	// for the purpose of flat-tail algorithm.  0 means everyone.
 	NumLeaders *int `json:"numLeaders,omitempty"`
 
+	// PeerAlpha A trust collection (matrix/vector).
+	//
+	// Individual entry values in the collection represent trust levels;
+	// the index/-ices – that is, the coordinate/-s – of an entry
+	// indicate the peer/-s to which the trust level (value) is bound.
+	//
+	// The actual nature of this binding between peer/-s and the trust level
+	// is up to the context.
+	// For example, in a global trust (vector) the entry index denotes
+	// the peer to which the trust value is assigned,
+	// (the network trusts this peer by the trust level amount;
+	// the peer is the "trustee"),
+	// while in a column vector of a local trust matrix the entry index denotes
+	// the peer from which the inbound trust is originating
+	// (the peer is the "truster").
+	PeerAlpha *TrustRef `json:"peerAlpha,omitempty"`
+
+	// PreDistrust A trust collection (matrix/vector).
+	//
+	// Individual entry values in the collection represent trust levels;
+	// the index/-ices – that is, the coordinate/-s – of an entry
+	// indicate the peer/-s to which the trust level (value) is bound.
+	//
+	// The actual nature of this binding between peer/-s and the trust level
+	// is up to the context.
+	// For example, in a global trust (vector) the entry index denotes
+	// the peer to which the trust value is assigned,
+	// (the network trusts this peer by the trust level amount;
+	// the peer is the "trustee"),
+	// while in a column vector of a local trust matrix the entry index denotes
+	// the peer from which the inbound trust is originating
+	// (the peer is the "truster").
+	PreDistrust *TrustRef `json:"preDistrust,omitempty"`
+
 	// PreTrust A trust collection (matrix/vector).
 	//
 	// Individual entry values in the collection represent trust levels;
@@ -113,12 +236,31 @@ type ComputeParams struct {
 	// the peer from which the inbound trust is originating
 	// (the peer is the "truster").
 	PreTrust *TrustRef `json:"preTrust,omitempty"`
+
+	// Preprocess Preprocessing steps to apply in order to the local trust
+	// (after extracting distrust, before canonicalization):
+	//
+	//   * `log`: Replace each value v with log(1+v).
+	//   * `sqrt`: Replace each value with its square root.
+	//   * `cap:MAX`: Cap each value at MAX.
+	//   * `threshold:MIN`: Drop values below MIN.
+	//   * `top-k:K`: Keep only the K largest values of each truster.
+	//   * `rank`: Replace the values of each truster
+	//     with 1, 1/2, 1/3, … in descending order.
+	//   * `no-self-loops`: Drop self-trust.
+	//   * `reciprocity:FACTOR`: Scale mutual trust
+	//     (i→j where j→i also exists) by FACTOR, between 0 and 1.
+	Preprocess *[]string `json:"preprocess,omitempty"`
 }
 
 // ComputeRequestBody defines model for ComputeRequestBody.
 type ComputeRequestBody struct {
 	Alpha *float64 `json:"alpha,omitempty"`
 
+	// AntiTrustWeight The factor by which to scale Anti-TrustRank scores
+	// before subtracting them from global trust.
+	AntiTrustWeight *float64 `json:"antiTrustWeight,omitempty"`
+
 	// CheckFreq If given (n), exit criteria are checked every n iterations.
 	// It can be used in conjunction with minIterations
 	// for "modulo n" behavior,
@@ -127,6 +269,61 @@ type ComputeRequestBody struct {
 	// Default is 1: exit criteria are checked after every iteration.
 	CheckFreq *int `json:"checkFreq,omitempty"`
 
+	// Dangling How to handle trust of dangling peers,
+	// i.e. peers without outbound local trust:
+	//
+	//   * `pretrust` (default): Distribute it according to the pre-trust
+	//     (pre-trust teleport).
+	//   * `uniform`: Distribute it equally onto all peers
+	//     (uniform teleport).
+	//   * `selfloop`: Keep it, as if dangling peers trusted themselves.
+	//   * `redistribute`: Redistribute it onto all peers
+	//     in proportion to their current trust, in each iteration.
+	Dangling *DanglingStrategy `json:"dangling,omitempty"`
+
+	// Decay Decay to apply to local trust values
+	// by the age of each entry, before canonicalization.
+	// Entry times come from `t` of inline entries,
+	// or from the `t` column (CSV header) or member (JSON Lines)
+	// of object storage and uploaded local trust:
+	//
+	//   * `exp:HALF-LIFE`: Halve values every HALF-LIFE.
+	//   * `linear:WINDOW`: Scale values linearly
+	//     from 1 (brand new) down to 0 (WINDOW old or older).
+	//   * `step:CUTOFF`: Drop values older than CUTOFF.
+	//
+	// Durations are in Go format (e.g. `72h`) or in days (e.g. `30d`).
+	// Entries without time are not decayed,
+	// but decaying local trust without any entry time is an error.
+	// Applies to `localTrustLayers` as well.
+	Decay *string `json:"decay,omitempty"`
+
+	// DecayReference The Unix time (in seconds) as of which to compute entry ages
+	// for `decay`.  Defaults to now.
+	DecayReference *int64 `json:"decayReference,omitempty"`
+
+	// DiscountMode How distrust (negative local trust) adjusts global trust:
+	//
+	//   * `onestep` (default): Subtract each distruster's distrust,
+	//     scaled by their own global trust, exactly once.
+	//     Global trust may become negative.
+	//   * `clamped`: Same as `onestep`,
+	//     then clamp negative global trust at zero.
+	//   * `propagated`: Also distrust whom trusted peers distrust:
+	//     each distruster's distrust is scaled by their own global trust
+	//     plus the trust flowing into them
+	//     (from their trusters, through `distrustHops` hops).
+	DiscountMode *DiscountMode `json:"discountMode,omitempty"`
+
+	// DistrustHops The number of hops to propagate distrust through trust,
+	// for the `propagated` discount mode.
+	DistrustHops *int `json:"distrustHops,omitempty"`
+
+	// DistrustWeight The factor by which to scale all distrust (negative local trust)
+	// when discounting global trust,
+	// e.g. 2 punishes distrusted peers twice as hard, 0 ignores distrust.
+	DistrustWeight *float64 `json:"distrustWeight,omitempty"`
+
 	// EffectiveInitialTrust A trust collection (matrix/vector).
 	//
 	// Individual entry values in the collection represent trust levels;
@@ -237,6 +434,21 @@ type ComputeRequestBody struct {
 	// (the peer is the "truster").
 	LocalTrust TrustRef `json:"localTrust"`
 
+	// LocalTrustLayers Additional local trust layers, e.g. one per type of trust signal
+	// such as follows, endorsements, or payments.
+	//
+	// If given, `localTrust` (the first layer, weighted by
+	// `localTrustWeight`) and these layers are blended
+	// into their weighted sum, which is then used as the local trust.
+	// Each layer except raw ones is scaled first
+	// so that each truster's absolute values sum to one,
+	// so that layers on different scales contribute by weight only.
+	LocalTrustLayers *[]LocalTrustLayer `json:"localTrustLayers,omitempty"`
+
+	// LocalTrustWeight The weight of `localTrust` when blending it with
+	// `localTrustLayers`; ignored otherwise.
+	LocalTrustWeight *float64 `json:"localTrustWeight,omitempty"`
+
 	// MaxIterations The maximum number of iterations after which to stop
 	// even if other termination criteria are not met.
 	// 0 means no limit.
@@ -251,6 +463,40 @@ type ComputeRequestBody struct {
 	// for the purpose of flat-tail algorithm.  0 means everyone.
 	NumLeaders *int `json:"numLeaders,omitempty"`
 
+	// PeerAlpha A trust collection (matrix/vector).
+	//
+	// Individual entry values in the collection represent trust levels;
+	// the index/-ices – that is, the coordinate/-s – of an entry
+	// indicate the peer/-s to which the trust level (value) is bound.
+	//
+	// The actual nature of this binding between peer/-s and the trust level
+	// is up to the context.
+	// For example, in a global trust (vector) the entry index denotes
+	// the peer to which the trust value is assigned,
+	// (the network trusts this peer by the trust level amount;
+	// the peer is the "trustee"),
+	// while in a column vector of a local trust matrix the entry index denotes
+	// the peer from which the inbound trust is originating
+	// (the peer is the "truster").
+	PeerAlpha *TrustRef `json:"peerAlpha,omitempty"`
+
+	// PreDistrust A trust collection (matrix/vector).
+	//
+	// Individual entry values in the collection represent trust levels;
+	// the index/-ices – that is, the coordinate/-s – of an entry
+	// indicate the peer/-s to which the trust level (value) is bound.
+	//
+	// The actual nature of this binding between peer/-s and the trust level
+	// is up to the context.
+	// For example, in a global trust (vector) the entry index denotes
+	// the peer to which the trust value is assigned,
+	// (the network trusts this peer by the trust level amount;
+	// the peer is the "trustee"),
+	// while in a column vector of a local trust matrix the entry index denotes
+	// the peer from which the inbound trust is originating
+	// (the peer is the "truster").
+	PreDistrust *TrustRef `json:"preDistrust,omitempty"`
+
 	// PreTrust A trust collection (matrix/vector).
 	//
 	// Individual entry values in the collection represent trust levels;
@@ -267,6 +513,21 @@ type ComputeRequestBody struct {
 	// the peer from which the inbound trust is originating
 	// (the peer is the "truster").
 	PreTrust *TrustRef `json:"preTrust,omitempty"`
+
+	// Preprocess Preprocessing steps to apply in order to the local trust
+	// (after extracting distrust, before canonicalization):
+	//
+	//   * `log`: Replace each value v with log(1+v).
+	//   * `sqrt`: Replace each value with its square root.
+	//   * `cap:MAX`: Cap each value at MAX.
+	//   * `threshold:MIN`: Drop values below MIN.
+	//   * `top-k:K`: Keep only the K largest values of each truster.
+	//   * `rank`: Replace the values of each truster
+	//     with 1, 1/2, 1/3, … in descending order.
+	//   * `no-self-loops`: Drop self-trust.
+	//   * `reciprocity:FACTOR`: Scale mutual trust
+	//     (i→j where j→i also exists) by FACTOR, between 0 and 1.
+	Preprocess *[]string `json:"preprocess,omitempty"`
 }
 
 // ComputeRequestParams defines model for ComputeRequestParams.
@@ -363,6 +624,125 @@ type ComputeWithStatsResponseOK struct {
 	FlatTailStats FlatTailStats `json:"flatTailStats"`
 }
 
+// Contribution One peer's contribution to (or deduction from) a score.
+type Contribution struct {
+	// Peer The contributing peer index.
+	Peer int `json:"peer"`
+
+	// Value The contribution.
+	Value float64 `json:"value"`
+}
+
+// DanglingStrategy How to handle trust of dangling peers,
+// i.e. peers without outbound local trust:
+//
+//   - `pretrust` (default): Distribute it according to the pre-trust
+//     (pre-trust teleport).
+//   - `uniform`: Distribute it equally onto all peers
+//     (uniform teleport).
+//   - `selfloop`: Keep it, as if dangling peers trusted themselves.
+//   - `redistribute`: Redistribute it onto all peers
+//     in proportion to their current trust, in each iteration.
+type DanglingStrategy string
+
+// DegreeStats Degree distribution statistics.
+type DegreeStats struct {
+	// Histogram Number of peers by degree, in power-of-two buckets:
+	// The first element counts peers of degree 0,
+	// and the k-th element (k > 0) counts peers
+	// of degree [2^(k-1)..2^k).
+	Histogram []int   `json:"histogram"`
+	Max       int     `json:"max"`
+	Mean      float64 `json:"mean"`
+	Median    int     `json:"median"`
+	Min       int     `json:"min"`
+}
+
+// DelimitedTextFormat Describes a delimited-text file, such as CSV or TSV.
+type DelimitedTextFormat struct {
+	// Columns The 0-based positions of the columns to use, in order:
+	// `i`, `j`, and `v` for trust matrix,
+	// or `i` and `v` for trust vector.
+	// They override column names in the header.
+	// Without header or columns, the first columns are used in order.
+	// Other columns are ignored.
+	Columns *[]int `json:"columns,omitempty"`
+
+	// Comment If given, lines beginning with this character are ignored.
+	Comment *string `json:"comment,omitempty"`
+
+	// Delimiter The field delimiter character.
+	// Defaults to tab for `.tsv` files, comma otherwise.
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// Header Whether the first line is a header naming the columns.
+	// Without a header, columns are located by position.
+	Header *bool `json:"header,omitempty"`
+}
+
+// DiscountMode How distrust (negative local trust) adjusts global trust:
+//
+//   - `onestep` (default): Subtract each distruster's distrust,
+//     scaled by their own global trust, exactly once.
+//     Global trust may become negative.
+//   - `clamped`: Same as `onestep`,
+//     then clamp negative global trust at zero.
+//   - `propagated`: Also distrust whom trusted peers distrust:
+//     each distruster's distrust is scaled by their own global trust
+//     plus the trust flowing into them
+//     (from their trusters, through `distrustHops` hops).
+type DiscountMode string
+
+// ExplainRequestBody defines model for ExplainRequestBody.
+type ExplainRequestBody struct {
+	Compute ComputeRequestBody `json:"compute"`
+
+	// MaxPathLength The maximum number of hops in a trust path.
+	MaxPathLength *int `json:"maxPathLength,omitempty"`
+
+	// Peer The index of the peer whose score to explain.
+	Peer int `json:"peer"`
+
+	// TopContributors The number of top contributors (and distrusters) to list;
+	// 0 lists all.
+	TopContributors *int `json:"topContributors,omitempty"`
+
+	// TopPaths The number of strongest trust paths to find.
+	TopPaths *int `json:"topPaths,omitempty"`
+}
+
+// Explanation The breakdown of a peer's EigenTrust score.
+type Explanation struct {
+	// DiscountedScore The final score, as returned by /compute.
+	DiscountedScore float64 `json:"discountedScore"`
+
+	// Distrust The top distrust deductions, in descending order.
+	Distrust []Contribution `json:"distrust"`
+
+	// DistrustTotal The sum of all distrust deductions, listed or not.
+	DistrustTotal float64 `json:"distrustTotal"`
+
+	// Inbound The top inbound contributors, in descending order.
+	Inbound []Contribution `json:"inbound"`
+
+	// InboundTotal The sum of all inbound contributions, listed or not.
+	InboundTotal float64 `json:"inboundTotal"`
+
+	// Paths The strongest trust paths from pre-trusted peers,
+	// in descending order of strength.
+	Paths []TrustPath `json:"paths"`
+
+	// Peer The explained peer index.
+	Peer int `json:"peer"`
+
+	// PreTrust The share of the score teleported from pre-trust.
+	PreTrust float64 `json:"preTrust"`
+
+	// Score The score before distrust discounts,
+	// i.e. inboundTotal + preTrust.
+	Score float64 `json:"score"`
+}
+
 // FlatTailStats Flat-tail algorithm stats and peer ranking.
 type FlatTailStats struct {
 	// DeltaNorm The d value as of the head of the last flat-tail.
@@ -396,6 +776,11 @@ type FlatTailStats struct {
 // InlineTrustEntry Represents an entry in the trust collection.  Consists of the entry's
 // value (`v`) and the index/indices (position) in the collection.
 type InlineTrustEntry struct {
+	// T The Unix time (in seconds) of the entry,
+	// e.g. when the trust was given, for time-decayed local trust
+	// (see `decay`).  Local trust (matrix) entries only.
+	T *int64 `json:"t,omitempty"`
+
 	// V Represents the amount of trust bound to the peer/-s
 	// indicated by the entry's index/-ices.
 	V     float64 `json:"v"`
@@ -424,23 +809,192 @@ type InvalidRequest struct {
 	Message string `json:"message"`
 }
 
+// JSONLinesFormat Describes a JSON Lines (newline-delimited JSON) file,
+// with one JSON object per entry.
+// Peer indices can be given as numbers or numeric strings.
+type JSONLinesFormat struct {
+	// Fields The member names to use, in order:
+	// `i`, `j`, and `v` for trust matrix,
+	// or `i` and `v` for trust vector.
+	// Defaults to these names.
+	// Other members are ignored.
+	Fields *[]string `json:"fields,omitempty"`
+}
+
+// LocalTrustChange A hypothetical local trust entry.
+type LocalTrustChange struct {
+	// I The truster peer index.
+	I int `json:"i"`
+
+	// J The trustee peer index.
+	J int `json:"j"`
+
+	// V The local trust; 0 removes it.
+	V float64 `json:"v"`
+}
+
+// LocalTrustLayer One local trust layer to blend; see `localTrustLayers`.
+type LocalTrustLayer struct {
+	// LocalTrust A trust collection (matrix/vector).
+	//
+	// Individual entry values in the collection represent trust levels;
+	// the index/-ices – that is, the coordinate/-s – of an entry
+	// indicate the peer/-s to which the trust level (value) is bound.
+	//
+	// The actual nature of this binding between peer/-s and the trust level
+	// is up to the context.
+	// For example, in a global trust (vector) the entry index denotes
+	// the peer to which the trust value is assigned,
+	// (the network trusts this peer by the trust level amount;
+	// the peer is the "trustee"),
+	// while in a column vector of a local trust matrix the entry index denotes
+	// the peer from which the inbound trust is originating
+	// (the peer is the "truster").
+	LocalTrust TrustRef `json:"localTrust"`
+
+	// Raw Whether to use the values of this layer as they are,
+	// without scaling each truster's values to sum to one.
+	Raw *bool `json:"raw,omitempty"`
+
+	// Weight The weight of this layer.
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// LocalTrustStats Local trust graph statistics.
+//
+// Peer i trusting peer j (a positive entry with i and j)
+// is an edge from i to j.
+// Negative entries (distrust) and zero entries are not edges.
+type LocalTrustStats struct {
+	// InDegree Degree distribution statistics.
+	InDegree DegreeStats `json:"inDegree"`
+
+	// LargestComponent The number of peers in the largest strongly connected component.
+	LargestComponent int `json:"largestComponent"`
+
+	// NumComponents The number of strongly connected components.
+	NumComponents int `json:"numComponents"`
+
+	// NumDangling The number of peers without outbound trust
+	// (no positive local trust entries),
+	// whatever `dangling` strategy compute later applies to them.
+	NumDangling int `json:"numDangling"`
+
+	// NumEdges The number of positive entries, including self-loops.
+	NumEdges int `json:"numEdges"`
+
+	// NumNegativeEdges The number of negative (distrust) entries.
+	NumNegativeEdges int `json:"numNegativeEdges"`
+
+	// NumPeers The number of peers.
+	NumPeers int `json:"numPeers"`
+
+	// NumSelfLoops The number of peers trusting or distrusting themselves.
+	NumSelfLoops int `json:"numSelfLoops"`
+
+	// NumSingletonComponents The number of strongly connected components
+	// consisting of only one peer.
+	NumSingletonComponents int `json:"numSingletonComponents"`
+
+	// NumUnreachable The number of peers not reachable from any pre-trusted peer.
+	NumUnreachable int `json:"numUnreachable"`
+
+	// OutDegree Degree distribution statistics.
+	OutDegree DegreeStats `json:"outDegree"`
+
+	// UnreachableShare The number of unreachable peers as a fraction of all peers.
+	UnreachableShare float64 `json:"unreachableShare"`
+}
+
 // ObjectStorageTrustRef Refers to a trust collection in a remote object storage service.
 type ObjectStorageTrustRef struct {
+	// Format Describes a delimited-text file, such as CSV or TSV.
+	Format *DelimitedTextFormat `json:"format,omitempty"`
+
+	// JsonLines Describes a JSON Lines (newline-delimited JSON) file,
+	// with one JSON object per entry.
+	// Peer indices can be given as numbers or numeric strings.
+	JsonLines *JSONLinesFormat `json:"jsonLines,omitempty"`
+
 	// Url URL of the trust collection file.
 	//
 	// It must refer to a CSV file,
 	// with three columns `i`, `j`, and `v` (for trust matrix)
 	// or two columns `i` and `v` (for trust vector).
+	// Other delimited-text formats, such as TSV, can be described
+	// with `format`; URLs ending in `.tsv` default to TSV.
+	// URLs ending in `.jsonl` or `.ndjson` refer to a JSON Lines file,
+	// with one JSON object per line, described by `jsonLines`.
 	// Currently the `s3://` URL scheme (AWS S3) is supported.
 	Url string `json:"url"`
 }
 
+// PreTrustChange A hypothetical pre-trust entry.
+type PreTrustChange struct {
+	// I The pre-trusted peer index.
+	I int `json:"i"`
+
+	// V The pre-trust; 0 removes it.
+	V float64 `json:"v"`
+}
+
+// ScoreChange The change in one peer's score.
+type ScoreChange struct {
+	// After The simulated score, with the changes.
+	After float64 `json:"after"`
+
+	// Before The baseline score, without the changes.
+	Before float64 `json:"before"`
+
+	// Peer The peer index.
+	Peer int `json:"peer"`
+
+	// RankAfter The 1-based simulated rank.
+	RankAfter int `json:"rankAfter"`
+
+	// RankBefore The 1-based baseline rank,
+	// i.e. 1 + the number of peers with a higher score.
+	RankBefore int `json:"rankBefore"`
+}
+
 // ServerStatus defines model for ServerStatus.
 type ServerStatus struct {
 	// Message The server status message.
 	Message string `json:"message"`
 }
 
+// SimulateRequestBody defines model for SimulateRequestBody.
+type SimulateRequestBody struct {
+	Compute ComputeRequestBody `json:"compute"`
+
+	// Limit The maximum number of peers to report, largest changes first;
+	// 0 reports all.
+	Limit *int `json:"limit,omitempty"`
+
+	// LocalTrustChanges Hypothetical local trust changes.
+	// Each entry sets the local trust from truster (i) to trustee (j);
+	// 0 removes it.  Later entries override earlier ones.
+	// The changes apply after blending and decay, if any.
+	LocalTrustChanges *[]LocalTrustChange `json:"localTrustChanges,omitempty"`
+
+	// MinChange The minimum absolute score change for a peer to be reported;
+	// 0 reports all changed peers.
+	MinChange *float64 `json:"minChange,omitempty"`
+
+	// PreTrustChanges Hypothetical pre-trust changes, as with localTrustChanges.
+	PreTrustChanges *[]PreTrustChange `json:"preTrustChanges,omitempty"`
+}
+
+// Simulation The result of a simulate request.
+type Simulation struct {
+	// Changes The peers whose scores changed, largest changes first.
+	Changes []ScoreChange `json:"changes"`
+
+	// NumChanged The number of peers whose scores changed,
+	// including those left out of changes due to limit.
+	NumChanged int `json:"numChanged"`
+}
+
 // StoredTrustId An identifier of a stored trust collection (matrix/vector).
 //
 // It identifies a trust collection within the local server.
@@ -463,6 +1017,17 @@ type TrustMatrixEntryIndices struct {
 	J int `json:"j"`
 }
 
+// TrustPath A chain of local trust from a pre-trusted peer.
+type TrustPath struct {
+	// Peers The peer indices along the path,
+	// from the pre-trusted peer to the explained peer.
+	Peers []int `json:"peers"`
+
+	// Strength The pre-trust of the first peer
+	// times the local trust along the path.
+	Strength float64 `json:"strength"`
+}
+
 // TrustRef A trust collection (matrix/vector).
 //
 // Individual entry values in the collection represent trust levels;
@@ -493,6 +1058,33 @@ type TrustVectorEntryIndex struct {
 	I int `json:"i"`
 }
 
+// ValidationIssue A problem found in a trust collection.
+type ValidationIssue struct {
+	// Column The 1-based column (byte position within the line)
+	// in the CSV file.
+	// Absent if the problem is with the entire line.
+	Column *int `json:"column,omitempty"`
+
+	// Entry The 0-based position of the offending inline entry.
+	Entry *int `json:"entry,omitempty"`
+
+	// Field The CSV header name of the offending field.
+	Field *string `json:"field,omitempty"`
+
+	// Line The 1-based line number in the CSV file.
+	Line *int `json:"line,omitempty"`
+
+	// Message Describes the problem in a human-readable message.
+	Message string `json:"message"`
+}
+
+// ValidationReport The result of a trust collection validation.
+type ValidationReport struct {
+	// Issues The problems found, in the order found.
+	// Empty if the trust collection is valid.
+	Issues []ValidationIssue `json:"issues"`
+}
+
 // LocalTrustIdParam An identifier of a stored trust collection (matrix/vector).
 //
 // It identifies a trust collection within the local server.
@@ -515,6 +1107,9 @@ type LocalTrustIdParam = StoredTrustId
 // (the peer is the "truster").
 type ComputeResponseOK = TrustRef
 
+// ExplainResponseOK The breakdown of a peer's EigenTrust score.
+type ExplainResponseOK = Explanation
+
 // LocalTrustGetResponseOK An inline "reference" to a trust collection.
 //
 // Instead of pointing (referencing) to an externally stored collection,
@@ -522,12 +1117,25 @@ type ComputeResponseOK = TrustRef
 // within the reference object itself.
 type LocalTrustGetResponseOK = InlineTrustRef
 
+// LocalTrustStatsResponseOK Local trust graph statistics.
+//
+// Peer i trusting peer j (a positive entry with i and j)
+// is an edge from i to j.
+// Negative entries (distrust) and zero entries are not edges.
+type LocalTrustStatsResponseOK = LocalTrustStats
+
 // ServerNotReady defines model for ServerNotReady.
 type ServerNotReady = ServerStatus
 
 // ServerReady defines model for ServerReady.
 type ServerReady = ServerStatus
 
+// SimulateResponseOK The result of a simulate request.
+type SimulateResponseOK = Simulation
+
+// ValidationResponseOK The result of a trust collection validation.
+type ValidationResponseOK = ValidationReport
+
 // UpdateLocalTrustParams defines parameters for UpdateLocalTrust.
 type UpdateLocalTrustParams struct {
 	// Merge Controls behavior if a local trust exists under the same ID.
@@ -537,6 +1145,19 @@ type UpdateLocalTrustParams struct {
 	// If true, the local trust ref contents are merged
 	// into the existing one under the same ID.
 	Merge *bool `form:"merge,omitempty" json:"merge,omitempty"`
+
+	// Fields Member names of JSON Lines request body objects,
+	// in the order of `i`, `j`, and `v` (default: `i,j,v`).
+	// Ignored for other request body types.
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// GetLocalTrustStatsParams defines parameters for GetLocalTrustStats.
+type GetLocalTrustStatsParams struct {
+	// PreTrusted Indices of pre-trusted peers,
+	// from which reachability is measured.
+	// If not given, every peer is considered pre-trusted.
+	PreTrusted *[]int `form:"preTrusted,omitempty" json:"preTrusted,omitempty"`
 }
 
 // ComputeJSONRequestBody defines body for Compute for application/json ContentType.
@@ -545,9 +1166,18 @@ type ComputeJSONRequestBody = ComputeRequestBody
 // ComputeWithStatsJSONRequestBody defines body for ComputeWithStats for application/json ContentType.
 type ComputeWithStatsJSONRequestBody = ComputeRequestBody
 
+// ExplainJSONRequestBody defines body for Explain for application/json ContentType.
+type ExplainJSONRequestBody = ExplainRequestBody
+
 // UpdateLocalTrustJSONRequestBody defines body for UpdateLocalTrust for application/json ContentType.
 type UpdateLocalTrustJSONRequestBody = TrustRef
 
+// SimulateJSONRequestBody defines body for Simulate for application/json ContentType.
+type SimulateJSONRequestBody = SimulateRequestBody
+
+// ValidateLocalTrustJSONRequestBody defines body for ValidateLocalTrust for application/json ContentType.
+type ValidateLocalTrustJSONRequestBody = TrustRef
+
 // AsTrustMatrixEntryIndices returns the union data inside the InlineTrustEntry as a TrustMatrixEntryIndices
 func (t InlineTrustEntry) AsTrustMatrixEntryIndices() (TrustMatrixEntryIndices, error) {
 	var body TrustMatrixEntryIndices
@@ -613,6 +1243,13 @@ func (t InlineTrustEntry) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.T != nil {
+		object["t"], err = json.Marshal(t.T)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 't': %w", err)
+		}
+	}
+
 	object["v"], err = json.Marshal(t.V)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'v': %w", err)
@@ -633,6 +1270,13 @@ func (t *InlineTrustEntry) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	if raw, found := object["t"]; found {
+		err = json.Unmarshal(raw, &t.T)
+		if err != nil {
+			return fmt.Errorf("error reading 't': %w", err)
+		}
+	}
+
 	if raw, found := object["v"]; found {
 		err = json.Unmarshal(raw, &t.V)
 		if err != nil {
@@ -847,6 +1491,11 @@ type ClientInterface interface {
 
 	ComputeWithStats(ctx context.Context, body ComputeWithStatsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExplainWithBody request with any body
+	ExplainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Explain(ctx context.Context, body ExplainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteLocalTrust request
 	DeleteLocalTrust(ctx context.Context, id LocalTrustIdParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -861,8 +1510,21 @@ type ClientInterface interface {
 
 	UpdateLocalTrust(ctx context.Context, id LocalTrustIdParam, params *UpdateLocalTrustParams, body UpdateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLocalTrustStats request
+	GetLocalTrustStats(ctx context.Context, id LocalTrustIdParam, params *GetLocalTrustStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimulateWithBody request with any body
+	SimulateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Simulate(ctx context.Context, body SimulateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStatus request
 	GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateLocalTrustWithBody request with any body
+	ValidateLocalTrustWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateLocalTrust(ctx context.Context, body ValidateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ComputeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -913,6 +1575,30 @@ func (c *Client) ComputeWithStats(ctx context.Context, body ComputeWithStatsJSON
 	return c.Client.Do(req)
 }
 
+func (c *Client) ExplainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Explain(ctx context.Context, body ExplainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteLocalTrust(ctx context.Context, id LocalTrustIdParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteLocalTrustRequest(c.Server, id)
 	if err != nil {
@@ -973,8 +1659,8 @@ func (c *Client) UpdateLocalTrust(ctx context.Context, id LocalTrustIdParam, par
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetStatusRequest(c.Server)
+func (c *Client) GetLocalTrustStats(ctx context.Context, id LocalTrustIdParam, params *GetLocalTrustStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLocalTrustStatsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -985,28 +1671,88 @@ func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-// NewComputeRequest calls the generic Compute builder with application/json body
-func NewComputeRequest(server string, body ComputeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SimulateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewComputeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewComputeRequestWithBody generates requests for Compute with any type of body
-func NewComputeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Simulate(ctx context.Context, body SimulateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimulateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/compute")
-	if operationPath[0] == '/' {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateLocalTrustWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateLocalTrustRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateLocalTrust(ctx context.Context, body ValidateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateLocalTrustRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewComputeRequest calls the generic Compute builder with application/json body
+func NewComputeRequest(server string, body ComputeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewComputeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewComputeRequestWithBody generates requests for Compute with any type of body
+func NewComputeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/compute")
+	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
 
@@ -1065,6 +1811,46 @@ func NewComputeWithStatsRequestWithBody(server string, contentType string, body
 	return req, nil
 }
 
+// NewExplainRequest calls the generic Explain builder with application/json body
+func NewExplainRequest(server string, body ExplainJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExplainRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExplainRequestWithBody generates requests for Explain with any type of body
+func NewExplainRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/explain")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewDeleteLocalTrustRequest generates requests for DeleteLocalTrust
 func NewDeleteLocalTrustRequest(server string, id LocalTrustIdParam) (*http.Request, error) {
 	var err error
@@ -1223,6 +2009,22 @@ func NewUpdateLocalTrustRequestWithBody(server string, id LocalTrustIdParam, par
 
 		}
 
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1236,6 +2038,102 @@ func NewUpdateLocalTrustRequestWithBody(server string, id LocalTrustIdParam, par
 	return req, nil
 }
 
+// NewGetLocalTrustStatsRequest generates requests for GetLocalTrustStats
+func NewGetLocalTrustStatsRequest(server string, id LocalTrustIdParam, params *GetLocalTrustStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/local-trust/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PreTrusted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "preTrusted", runtime.ParamLocationQuery, *params.PreTrusted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimulateRequest calls the generic Simulate builder with application/json body
+func NewSimulateRequest(server string, body SimulateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimulateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimulateRequestWithBody generates requests for Simulate with any type of body
+func NewSimulateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/simulate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetStatusRequest generates requests for GetStatus
 func NewGetStatusRequest(server string) (*http.Request, error) {
 	var err error
@@ -1263,6 +2161,46 @@ func NewGetStatusRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewValidateLocalTrustRequest calls the generic ValidateLocalTrust builder with application/json body
+func NewValidateLocalTrustRequest(server string, body ValidateLocalTrustJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateLocalTrustRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewValidateLocalTrustRequestWithBody generates requests for ValidateLocalTrust with any type of body
+func NewValidateLocalTrustRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/validate-local-trust")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -1316,6 +2254,11 @@ type ClientWithResponsesInterface interface {
 
 	ComputeWithStatsWithResponse(ctx context.Context, body ComputeWithStatsJSONRequestBody, reqEditors ...RequestEditorFn) (*ComputeWithStatsResponse, error)
 
+	// ExplainWithBodyWithResponse request with any body
+	ExplainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainResponse, error)
+
+	ExplainWithResponse(ctx context.Context, body ExplainJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainResponse, error)
+
 	// DeleteLocalTrustWithResponse request
 	DeleteLocalTrustWithResponse(ctx context.Context, id LocalTrustIdParam, reqEditors ...RequestEditorFn) (*DeleteLocalTrustResponse, error)
 
@@ -1330,8 +2273,21 @@ type ClientWithResponsesInterface interface {
 
 	UpdateLocalTrustWithResponse(ctx context.Context, id LocalTrustIdParam, params *UpdateLocalTrustParams, body UpdateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocalTrustResponse, error)
 
+	// GetLocalTrustStatsWithResponse request
+	GetLocalTrustStatsWithResponse(ctx context.Context, id LocalTrustIdParam, params *GetLocalTrustStatsParams, reqEditors ...RequestEditorFn) (*GetLocalTrustStatsResponse, error)
+
+	// SimulateWithBodyWithResponse request with any body
+	SimulateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateResponse, error)
+
+	SimulateWithResponse(ctx context.Context, body SimulateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateResponse, error)
+
 	// GetStatusWithResponse request
 	GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error)
+
+	// ValidateLocalTrustWithBodyWithResponse request with any body
+	ValidateLocalTrustWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateLocalTrustResponse, error)
+
+	ValidateLocalTrustWithResponse(ctx context.Context, body ValidateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateLocalTrustResponse, error)
 }
 
 type ComputeResponse struct {
@@ -1380,6 +2336,29 @@ func (r ComputeWithStatsResponse) StatusCode() int {
 	return 0
 }
 
+type ExplainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExplainResponseOK
+	JSON400      *InvalidRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DeleteLocalTrustResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1467,6 +2446,52 @@ func (r UpdateLocalTrustResponse) StatusCode() int {
 	return 0
 }
 
+type GetLocalTrustStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LocalTrustStatsResponseOK
+	JSON400      *InvalidRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLocalTrustStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLocalTrustStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimulateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SimulateResponseOK
+	JSON400      *InvalidRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SimulateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimulateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1490,6 +2515,29 @@ func (r GetStatusResponse) StatusCode() int {
 	return 0
 }
 
+type ValidateLocalTrustResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidationResponseOK
+	JSON400      *InvalidRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateLocalTrustResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateLocalTrustResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // ComputeWithBodyWithResponse request with arbitrary body returning *ComputeResponse
 func (c *ClientWithResponses) ComputeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ComputeResponse, error) {
 	rsp, err := c.ComputeWithBody(ctx, contentType, body, reqEditors...)
@@ -1524,6 +2572,23 @@ func (c *ClientWithResponses) ComputeWithStatsWithResponse(ctx context.Context,
 	return ParseComputeWithStatsResponse(rsp)
 }
 
+// ExplainWithBodyWithResponse request with arbitrary body returning *ExplainResponse
+func (c *ClientWithResponses) ExplainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExplainResponse, error) {
+	rsp, err := c.ExplainWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExplainWithResponse(ctx context.Context, body ExplainJSONRequestBody, reqEditors ...RequestEditorFn) (*ExplainResponse, error) {
+	rsp, err := c.Explain(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainResponse(rsp)
+}
+
 // DeleteLocalTrustWithResponse request returning *DeleteLocalTrustResponse
 func (c *ClientWithResponses) DeleteLocalTrustWithResponse(ctx context.Context, id LocalTrustIdParam, reqEditors ...RequestEditorFn) (*DeleteLocalTrustResponse, error) {
 	rsp, err := c.DeleteLocalTrust(ctx, id, reqEditors...)
@@ -1568,6 +2633,32 @@ func (c *ClientWithResponses) UpdateLocalTrustWithResponse(ctx context.Context,
 	return ParseUpdateLocalTrustResponse(rsp)
 }
 
+// GetLocalTrustStatsWithResponse request returning *GetLocalTrustStatsResponse
+func (c *ClientWithResponses) GetLocalTrustStatsWithResponse(ctx context.Context, id LocalTrustIdParam, params *GetLocalTrustStatsParams, reqEditors ...RequestEditorFn) (*GetLocalTrustStatsResponse, error) {
+	rsp, err := c.GetLocalTrustStats(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLocalTrustStatsResponse(rsp)
+}
+
+// SimulateWithBodyWithResponse request with arbitrary body returning *SimulateResponse
+func (c *ClientWithResponses) SimulateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimulateResponse, error) {
+	rsp, err := c.SimulateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimulateWithResponse(ctx context.Context, body SimulateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimulateResponse, error) {
+	rsp, err := c.Simulate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimulateResponse(rsp)
+}
+
 // GetStatusWithResponse request returning *GetStatusResponse
 func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatusResponse, error) {
 	rsp, err := c.GetStatus(ctx, reqEditors...)
@@ -1577,6 +2668,23 @@ func (c *ClientWithResponses) GetStatusWithResponse(ctx context.Context, reqEdit
 	return ParseGetStatusResponse(rsp)
 }
 
+// ValidateLocalTrustWithBodyWithResponse request with arbitrary body returning *ValidateLocalTrustResponse
+func (c *ClientWithResponses) ValidateLocalTrustWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateLocalTrustResponse, error) {
+	rsp, err := c.ValidateLocalTrustWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateLocalTrustResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateLocalTrustWithResponse(ctx context.Context, body ValidateLocalTrustJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateLocalTrustResponse, error) {
+	rsp, err := c.ValidateLocalTrust(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateLocalTrustResponse(rsp)
+}
+
 // ParseComputeResponse parses an HTTP response from a ComputeWithResponse call
 func ParseComputeResponse(rsp *http.Response) (*ComputeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1643,6 +2751,39 @@ func ParseComputeWithStatsResponse(rsp *http.Response) (*ComputeWithStatsRespons
 	return response, nil
 }
 
+// ParseExplainResponse parses an HTTP response from a ExplainWithResponse call
+func ParseExplainResponse(rsp *http.Response) (*ExplainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExplainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExplainResponseOK
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDeleteLocalTrustResponse parses an HTTP response from a DeleteLocalTrustWithResponse call
 func ParseDeleteLocalTrustResponse(rsp *http.Response) (*DeleteLocalTrustResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1703,28 +2844,94 @@ func ParseHeadLocalTrustResponse(rsp *http.Response) (*HeadLocalTrustResponse, e
 		return nil, err
 	}
 
-	response := &HeadLocalTrustResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response := &HeadLocalTrustResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLocalTrustResponse parses an HTTP response from a UpdateLocalTrustWithResponse call
+func ParseUpdateLocalTrustResponse(rsp *http.Response) (*UpdateLocalTrustResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLocalTrustResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLocalTrustStatsResponse parses an HTTP response from a GetLocalTrustStatsWithResponse call
+func ParseGetLocalTrustStatsResponse(rsp *http.Response) (*GetLocalTrustStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLocalTrustStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LocalTrustStatsResponseOK
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateLocalTrustResponse parses an HTTP response from a UpdateLocalTrustWithResponse call
-func ParseUpdateLocalTrustResponse(rsp *http.Response) (*UpdateLocalTrustResponse, error) {
+// ParseSimulateResponse parses an HTTP response from a SimulateWithResponse call
+func ParseSimulateResponse(rsp *http.Response) (*SimulateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateLocalTrustResponse{
+	response := &SimulateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SimulateResponseOK
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest InvalidRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1770,6 +2977,39 @@ func ParseGetStatusResponse(rsp *http.Response) (*GetStatusResponse, error) {
 	return response, nil
 }
 
+// ParseValidateLocalTrustResponse parses an HTTP response from a ValidateLocalTrustWithResponse call
+func ParseValidateLocalTrustResponse(rsp *http.Response) (*ValidateLocalTrustResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateLocalTrustResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidationResponseOK
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest InvalidRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Compute EigenTrust scores
@@ -1778,6 +3018,9 @@ type ServerInterface interface {
 	// Compute EigenTrust scores, with execution statistics
 	// (POST /compute-with-stats)
 	ComputeWithStats(ctx echo.Context) error
+	// Explain a peer's EigenTrust score
+	// (POST /explain)
+	Explain(ctx echo.Context) error
 	// Delete local trust
 	// (DELETE /local-trust/{id})
 	DeleteLocalTrust(ctx echo.Context, id LocalTrustIdParam) error
@@ -1790,9 +3033,18 @@ type ServerInterface interface {
 	// Update local trust
 	// (PUT /local-trust/{id})
 	UpdateLocalTrust(ctx echo.Context, id LocalTrustIdParam, params UpdateLocalTrustParams) error
+	// Get local trust graph statistics
+	// (GET /local-trust/{id}/stats)
+	GetLocalTrustStats(ctx echo.Context, id LocalTrustIdParam, params GetLocalTrustStatsParams) error
+	// Simulate local trust and pre-trust changes
+	// (POST /simulate)
+	Simulate(ctx echo.Context) error
 	// Get the health check status
 	// (GET /status)
 	GetStatus(ctx echo.Context) error
+	// Validate local trust
+	// (POST /validate-local-trust)
+	ValidateLocalTrust(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1818,6 +3070,15 @@ func (w *ServerInterfaceWrapper) ComputeWithStats(ctx echo.Context) error {
 	return err
 }
 
+// Explain converts echo context to params.
+func (w *ServerInterfaceWrapper) Explain(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Explain(ctx)
+	return err
+}
+
 // DeleteLocalTrust converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteLocalTrust(ctx echo.Context) error {
 	var err error
@@ -1886,11 +3147,52 @@ func (w *ServerInterfaceWrapper) UpdateLocalTrust(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merge: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateLocalTrust(ctx, id, params)
 	return err
 }
 
+// GetLocalTrustStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLocalTrustStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id LocalTrustIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLocalTrustStatsParams
+	// ------------- Optional query parameter "preTrusted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "preTrusted", ctx.QueryParams(), &params.PreTrusted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter preTrusted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLocalTrustStats(ctx, id, params)
+	return err
+}
+
+// Simulate converts echo context to params.
+func (w *ServerInterfaceWrapper) Simulate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Simulate(ctx)
+	return err
+}
+
 // GetStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStatus(ctx echo.Context) error {
 	var err error
@@ -1900,6 +3202,15 @@ func (w *ServerInterfaceWrapper) GetStatus(ctx echo.Context) error {
 	return err
 }
 
+// ValidateLocalTrust converts echo context to params.
+func (w *ServerInterfaceWrapper) ValidateLocalTrust(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ValidateLocalTrust(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -1930,11 +3241,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.POST(baseURL+"/compute", wrapper.Compute)
 	router.POST(baseURL+"/compute-with-stats", wrapper.ComputeWithStats)
+	router.POST(baseURL+"/explain", wrapper.Explain)
 	router.DELETE(baseURL+"/local-trust/:id", wrapper.DeleteLocalTrust)
 	router.GET(baseURL+"/local-trust/:id", wrapper.GetLocalTrust)
 	router.HEAD(baseURL+"/local-trust/:id", wrapper.HeadLocalTrust)
 	router.PUT(baseURL+"/local-trust/:id", wrapper.UpdateLocalTrust)
+	router.GET(baseURL+"/local-trust/:id/stats", wrapper.GetLocalTrustStats)
+	router.POST(baseURL+"/simulate", wrapper.Simulate)
 	router.GET(baseURL+"/status", wrapper.GetStatus)
+	router.POST(baseURL+"/validate-local-trust", wrapper.ValidateLocalTrust)
 
 }
 
@@ -1942,14 +3257,22 @@ type ComputeResponseOKJSONResponse TrustRef
 
 type ComputeWithStatsResponseOKJSONResponse ComputeWithStatsResponseOK
 
+type ExplainResponseOKJSONResponse Explanation
+
 type InvalidRequestJSONResponse InvalidRequest
 
 type LocalTrustGetResponseOKJSONResponse InlineTrustRef
 
+type LocalTrustStatsResponseOKJSONResponse LocalTrustStats
+
 type ServerNotReadyJSONResponse ServerStatus
 
 type ServerReadyJSONResponse ServerStatus
 
+type SimulateResponseOKJSONResponse Simulation
+
+type ValidationResponseOKJSONResponse ValidationReport
+
 type ComputeRequestObject struct {
 	Body *ComputeJSONRequestBody
 }
@@ -2004,6 +3327,32 @@ func (response ComputeWithStats400JSONResponse) VisitComputeWithStatsResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ExplainRequestObject struct {
+	Body *ExplainJSONRequestBody
+}
+
+type ExplainResponseObject interface {
+	VisitExplainResponse(w http.ResponseWriter) error
+}
+
+type Explain200JSONResponse struct{ ExplainResponseOKJSONResponse }
+
+func (response Explain200JSONResponse) VisitExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Explain400JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response Explain400JSONResponse) VisitExplainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type DeleteLocalTrustRequestObject struct {
 	Id LocalTrustIdParam `json:"id"`
 }
@@ -2089,9 +3438,10 @@ func (response HeadLocalTrust404Response) VisitHeadLocalTrustResponse(w http.Res
 }
 
 type UpdateLocalTrustRequestObject struct {
-	Id     LocalTrustIdParam `json:"id"`
-	Params UpdateLocalTrustParams
-	Body   *UpdateLocalTrustJSONRequestBody
+	Id       LocalTrustIdParam `json:"id"`
+	Params   UpdateLocalTrustParams
+	JSONBody *UpdateLocalTrustJSONRequestBody
+	Body     io.Reader
 }
 
 type UpdateLocalTrustResponseObject interface {
@@ -2123,6 +3473,69 @@ func (response UpdateLocalTrust400JSONResponse) VisitUpdateLocalTrustResponse(w
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetLocalTrustStatsRequestObject struct {
+	Id     LocalTrustIdParam `json:"id"`
+	Params GetLocalTrustStatsParams
+}
+
+type GetLocalTrustStatsResponseObject interface {
+	VisitGetLocalTrustStatsResponse(w http.ResponseWriter) error
+}
+
+type GetLocalTrustStats200JSONResponse struct {
+	LocalTrustStatsResponseOKJSONResponse
+}
+
+func (response GetLocalTrustStats200JSONResponse) VisitGetLocalTrustStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocalTrustStats400JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetLocalTrustStats400JSONResponse) VisitGetLocalTrustStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocalTrustStats404Response struct {
+}
+
+func (response GetLocalTrustStats404Response) VisitGetLocalTrustStatsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SimulateRequestObject struct {
+	Body *SimulateJSONRequestBody
+}
+
+type SimulateResponseObject interface {
+	VisitSimulateResponse(w http.ResponseWriter) error
+}
+
+type Simulate200JSONResponse struct{ SimulateResponseOKJSONResponse }
+
+func (response Simulate200JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate400JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response Simulate400JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetStatusRequestObject struct {
 }
 
@@ -2148,6 +3561,34 @@ func (response GetStatus500JSONResponse) VisitGetStatusResponse(w http.ResponseW
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ValidateLocalTrustRequestObject struct {
+	Body *ValidateLocalTrustJSONRequestBody
+}
+
+type ValidateLocalTrustResponseObject interface {
+	VisitValidateLocalTrustResponse(w http.ResponseWriter) error
+}
+
+type ValidateLocalTrust200JSONResponse struct {
+	ValidationResponseOKJSONResponse
+}
+
+func (response ValidateLocalTrust200JSONResponse) VisitValidateLocalTrustResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateLocalTrust400JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response ValidateLocalTrust400JSONResponse) VisitValidateLocalTrustResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Compute EigenTrust scores
@@ -2156,6 +3597,9 @@ type StrictServerInterface interface {
 	// Compute EigenTrust scores, with execution statistics
 	// (POST /compute-with-stats)
 	ComputeWithStats(ctx context.Context, request ComputeWithStatsRequestObject) (ComputeWithStatsResponseObject, error)
+	// Explain a peer's EigenTrust score
+	// (POST /explain)
+	Explain(ctx context.Context, request ExplainRequestObject) (ExplainResponseObject, error)
 	// Delete local trust
 	// (DELETE /local-trust/{id})
 	DeleteLocalTrust(ctx context.Context, request DeleteLocalTrustRequestObject) (DeleteLocalTrustResponseObject, error)
@@ -2168,9 +3612,18 @@ type StrictServerInterface interface {
 	// Update local trust
 	// (PUT /local-trust/{id})
 	UpdateLocalTrust(ctx context.Context, request UpdateLocalTrustRequestObject) (UpdateLocalTrustResponseObject, error)
+	// Get local trust graph statistics
+	// (GET /local-trust/{id}/stats)
+	GetLocalTrustStats(ctx context.Context, request GetLocalTrustStatsRequestObject) (GetLocalTrustStatsResponseObject, error)
+	// Simulate local trust and pre-trust changes
+	// (POST /simulate)
+	Simulate(ctx context.Context, request SimulateRequestObject) (SimulateResponseObject, error)
 	// Get the health check status
 	// (GET /status)
 	GetStatus(ctx context.Context, request GetStatusRequestObject) (GetStatusResponseObject, error)
+	// Validate local trust
+	// (POST /validate-local-trust)
+	ValidateLocalTrust(ctx context.Context, request ValidateLocalTrustRequestObject) (ValidateLocalTrustResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -2243,6 +3696,35 @@ func (sh *strictHandler) ComputeWithStats(ctx echo.Context) error {
 	return nil
 }
 
+// Explain operation middleware
+func (sh *strictHandler) Explain(ctx echo.Context) error {
+	var request ExplainRequestObject
+
+	var body ExplainJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Explain(ctx.Request().Context(), request.(ExplainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Explain")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ExplainResponseObject); ok {
+		return validResponse.VisitExplainResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteLocalTrust operation middleware
 func (sh *strictHandler) DeleteLocalTrust(ctx echo.Context, id LocalTrustIdParam) error {
 	var request DeleteLocalTrustRequestObject
@@ -2324,12 +3806,16 @@ func (sh *strictHandler) UpdateLocalTrust(ctx echo.Context, id LocalTrustIdParam
 
 	request.Id = id
 	request.Params = params
-
-	var body UpdateLocalTrustJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
+	if strings.HasPrefix(ctx.Request().Header.Get("Content-Type"), "application/json") {
+		var body UpdateLocalTrustJSONRequestBody
+		if err := ctx.Bind(&body); err != nil {
+			return err
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.Request().Header.Get("Content-Type"), "application/x-ndjson") {
+		request.Body = ctx.Request().Body
 	}
-	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UpdateLocalTrust(ctx.Request().Context(), request.(UpdateLocalTrustRequestObject))
@@ -2350,6 +3836,61 @@ func (sh *strictHandler) UpdateLocalTrust(ctx echo.Context, id LocalTrustIdParam
 	return nil
 }
 
+// GetLocalTrustStats operation middleware
+func (sh *strictHandler) GetLocalTrustStats(ctx echo.Context, id LocalTrustIdParam, params GetLocalTrustStatsParams) error {
+	var request GetLocalTrustStatsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLocalTrustStats(ctx.Request().Context(), request.(GetLocalTrustStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLocalTrustStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLocalTrustStatsResponseObject); ok {
+		return validResponse.VisitGetLocalTrustStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Simulate operation middleware
+func (sh *strictHandler) Simulate(ctx echo.Context) error {
+	var request SimulateRequestObject
+
+	var body SimulateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Simulate(ctx.Request().Context(), request.(SimulateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Simulate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SimulateResponseObject); ok {
+		return validResponse.VisitSimulateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetStatus operation middleware
 func (sh *strictHandler) GetStatus(ctx echo.Context) error {
 	var request GetStatusRequestObject
@@ -2373,92 +3914,197 @@ func (sh *strictHandler) GetStatus(ctx echo.Context) error {
 	return nil
 }
 
+// ValidateLocalTrust operation middleware
+func (sh *strictHandler) ValidateLocalTrust(ctx echo.Context) error {
+	var request ValidateLocalTrustRequestObject
+
+	var body ValidateLocalTrustJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateLocalTrust(ctx.Request().Context(), request.(ValidateLocalTrustRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateLocalTrust")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ValidateLocalTrustResponseObject); ok {
+		return validResponse.VisitValidateLocalTrustResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xb3XLbxpJ+lSl4tw65C/FPkmXTlQvHP1nV8UlclpNzEaRKQ6BBjAXMIDMDSopLVXmH",
-	"c7t7uw+WJ9nqngEIkKBIKXZtzsWJDGJmerq//m98DmJVlEqCtCaYfw7ghhdlDvT3K1WUlYUP8GsFxv5D",
-	"GCPk8p2Kef5RV8biKwmYWIvSCiWDeXAumc2EYX6TkOX4MrP4NlvcsiczJgwr3EbjSl5JdS1HkXwPmr0R",
-	"S5C0L+P5UmlhsyKMpLC4hBtTFZAwq9gCmM2AGV4A44b+LjUc0RmjSH7MOK4I/VmthY6KJxPGZcKeTMNI",
-	"0hMhl+zJlKWq0syKAnANK6o4w/8+mYwiGYSBqYqC69tgHrxkx0clgK7vyK6Fzeorbd6XM3w1CIMVzytA",
-	"fvG8zHgwn4ymYZB3OAnSaoF8//lzIIL5JAw+BfNpGKyC+fQubD2b0bNj/2zaeja9+yUMTJxBAcE8EDIX",
-	"EpB48RvQgqDUcM95rZPcuSf373d3F+6DyEuZvG+duQctUln2iYQ0+5vp8rJCVBiF4o5kI+8Wmu5HEWeV",
-	"FKnSBeusrQwkTKRMqu5zU0IsUgEJgqQGFIoSEfHH7/96MmNct3AHCYNfK57nt4RAkExIZistQ8LnDnQ8",
-	"mUXycGyjLoxgtAvXsAJ9qyS0CHkkblE9mlP/KtjdxtqFwGtMt2H1sYUpVnJjUKU7N1QpO/biHHh5DkN2",
-	"nYGGeSQjeYRWgl41zlDQA4c//3TG/vj9X8xeixg69sK/PV2/GCJD6eGseTgJGclSQyxKrWJuAZ/+zbDa",
-	"jEWyMVSbUHvhISAVvvhkin+3kdiyZIXSiCgunSGL5PsG5cZqkEubscElyfVyiPtMRlN679yC5shPZjMN",
-	"JlN5wgaXUBqRK+le5QsD0r5ABQHpYAt6BRqVChmeQMqr3DKCTyQXHHWtKpV7V1bFAjRKwsvheLiJWCfg",
-	"Ddj+aTxORrPTHkhORmenvah0z2b0bPKFrOxkNOvY2cno2ePQP9uDfmH67MlKqKpldeEmhtJ64P+EzDUE",
-	"ORPzHBKWiDQFDdLmty/wjT5okEg2AbIUK5AMbspcxMKSRWq5Z6TFITGHFeQGDWJDK0l88GRCZhQvFHMD",
-	"w0gminxExlfgzSUC3xOqNIu5VFLEPBe/QVLvGOfCAVXJnJ4IzTTk3IoVsIIvpbBVgn9ZC9p4KqEhnInt",
-	"C0eyues3UziaTi5DPH4ymtT/mw7JtpNdSIUE7dSQbih+gyOnDl5HcLspHD1lY3bc2en4j9//dxhG0igm",
-	"LLsWec4svwKn1w1dZr33lnQjia6TVmowqI9CuuU8jivNLTDN5ZWQyzCSQH4PvQfjhSK3cA36CF+AxCuq",
-	"BK6d8LjIna3ounHUaeJ0bWJtpqplFkZyg2WIkQRSIYUFPFEytQJ9JfJ87iTQCMlT6Inq+mG6GoIizrhc",
-	"QiR5atGwIAWcpXDd4hOR+xrhpkrcA1EOMlaV5kvnS+GmBC0KkDaSaH1tJYE1sCZvS/iRAIlBjo2WIwwg",
-	"amgxq0o6NM6RT9dCyvogXEIBBWex4togwnOul6CHrRPoOrm4Qo6YKk1FDAfZRefOB5WUEIMxXIv8dkjI",
-	"Y37vXZaz/nlOUvkqnv1r2NDHR6qmVNLA/eGDN5zav+wFz/74/b97uf/H7//DtDPMzs3ib85L4zIHAyei",
-	"TCwzMNbbPpJIyOJcGchvI5mqHHWObBcd8GTygjkXjxvlwOulkDzYX+7wRSeT4+PZ8+Pp8dnzk9nZ2aZr",
-	"mp5Nzk6eT49PJ2dnp8dnZ2tputWz0+ez6enpdDp7Nn12erpHEDvkMPsycojkXjVoiYrxhVoBCex7ZSlQ",
-	"si2/tFq7QjKYSrMcjGEiAWkFRZOKop8en0pk0NtkmsmcT/+98aUxGuYECiWNRdskl3QuaWBNKLvmpm0U",
-	"v4IZuB8QT58/m05mJ0+Pn/YjYvJs+vzZyfNns6f9kJhNnz+fzk6f7kXEuVzxXCQ+snnjbtKjmS9ZyTUv",
-	"AO07rXBeGbRW2nGnuVmBl1/igTGX6B1yxZN2DjBnvvrQSQw0pMzelhCQxWhOo2LIOqk9T97jL9sEXork",
-	"kiUgFYbzpK+tvWOV5xATxUIyuqpQ0tEtcHXJbRaEgeSOUUkQBghUoSEJ5lZX4NnI8eB/05AG8+DJeF29",
-	"GbtfzfjCKg2JJ9VdxSP+W5WIvtLOtyq5xaexkhYkGV1eYvhDHB5/Mni9bm2otxrUR1W9aLyvmnQXBvvq",
-	"B39m//ZWd2HQsv8P2LVe1Wwwe9QGswCFcpg0eyRFIu1C732DVZYqzTDGoGUtt7SFJwcMZ1m7oHDPfvj7",
-	"AzHxYJ52XfFjmNp1IodzlXDwAdI+Xl5UMRrPtMJA2PMxIX1uVZdMrDSss4XGQy1Ucot2hCJftblqBbEl",
-	"Y0Xezf0LsxZMZYzSeI6QDMkBmaBbUDpxaXKdYq0uh5QPcNpeLSwXkvHafrlAGYW99rT/FDa7sNyaB8n1",
-	"QeDsO+KrMBaJ5kI669rs4JYgS64hz/G/K67RIUfSWG6FsSI2VE3xDDJsUHB56zIMX0XLgKU5t0eY16xr",
-	"h0PPy66PeqBetF3avbDe4QkPh/UGmT0yeEXZMDP4fxxdES1om4m7sOXrvgP7FWBzTlHAfTroRE80QbLh",
-	"R4kAMyJ7QSWn75X9APwgF3agB6VtEdGV6QWyq3S5IhzTeDZhCHNzT3Wbvv8v4nYS1uCpbfbJhdCDUmN+",
-	"bH2o4JNFDHaoWoEh3mkYpEoX3AbzIFHVghKNgt+IoiooUCyEdH9PwoAiqnngSn7IlTiD+Oqthl97egKp",
-	"rxsN5DBkcCMsizVm8IJTJE5LIXHlbia7yf25rW2pK+xLBMunSrqoyxe817VNg/mWZlFQqKTKFZNRwBaQ",
-	"8ZVQus7ttxd9c0aWpLnDN6eR3E2nq0acjaez8fRsPBqNuhS/9kVSYdh0zvZt4y7dbOB8esPpacNpIS0s",
-	"Haub7P7znxEYGsaPXOR9WRpmpVRIVmljRKk4FMlBXbippKvOJCzVqljnS81Vhr7PUlBrBJgBQDmmVL8G",
-	"XQi5zpI0xJU27v4TVgCXhvH1sVRoIb30+4S+lNNeSoml3xcdiMohv2WtGrXn2waLJ30sFlJYsScI3g47",
-	"Ngsth64q+M0ai/3y8GJt1dhbpUKHpOtMxBlaBmNVGUlAjRMpUzYDvWa4kl00IlMLsC2+S8VyUQh7CJ86",
-	"WrSDcrdBP+VWsRI0ovhggh2xXsloh0ZtQ88D36irS7H00vQQzZJV8Q544rPD7bus72BVeVRrgi+JKrRM",
-	"RiSgnQ2iKkKlS2WoftoTiIwYq5let/oOYXq5J3vqiYfbacLPbZT+0hygFp8gtsFWV6LOI3me/5BSWeGA",
-	"4NH7nbvwIXlQvWg/TbvcGqQppuMrOH+k/jYbvHuUIjfL3z9YRGGwzNXiwWc+SrI7socNXjbh+0MuUXsV",
-	"2n7fwredlzfv0iJgc9++u73dPLmrvm+31Y9hIuFyCKq31ZlWEG6wIoHc8u+VLvqtQt0r46b2lxnwpP47",
-	"58aulb9OE9sxjVVUzTuixoR3Ut4cXmcgyURX1NLvsyFkMHrigPt8v/Pu/bdZn+FjgIHhtyF7R+096Yqj",
-	"dKd3/zltm/KMJ+tmn2flsL6ur/n2+wAMEmJV5Ql5d76CJJKL291XjuSg5Kb5FYXuJiTqnG/dxEQJswXk",
-	"6noYyetM5MB4nAlY1YGHo5a6aIeYXn+vfs75ZL92C74PzoRMREzZ73vXbVOWpaqSCctAg2t8/gZaMWcA",
-	"mnGBIAyEBWfm7qfKP+Fa81v6d33/HXRWy6XLwWrfvGbYuho7qHk7dFE4dfacV65jvpTnphUcGqYWNC+Q",
-	"jCLpE905O08Zb3hSUkNWspffvnr9+s2bN2/eNv+j2ni9QSQHwOOM5YDv02gCSzDnl3FTERnWwWS7uK5x",
-	"GbXkFspm7PVrUm88iYKHTYojqdIa51N69ZiqE86KU3/7vNWuDtnHmlPfnNBQUcNKIX1dxyomllJpIFUx",
-	"22fux9mmUXfa2pZr2LJJa1T2mcVWWv5GWn27DYkPUGowaJgZlwzwJdejh60q94ixVxjjGNuYOnr/byaS",
-	"rWIWq2NzIRO4GXsFYINSGUGZQb1/a2diipJwQIxBl/kHt1rc0JXO3f57ow1a9xPV5+p1cEPhRtfcr+7l",
-	"EdLNC1VJmjnyg1+kzt76oNKPj0wk6d6Ui/ipBc8rz5YjbxV6zfeGyd5AxGqfqNEbb/daJHOtGhYFVM0E",
-	"GUMUIN18W9RuDMBY78pKJSSla4N6KakgrpUMblCracjAUKOitZGb94y51gLqGp+r9rABcmglEjTdpuTa",
-	"OBYJMMMaXut9XB/QA6chn7nrM2EN5Knj5kYcUzfENtnxql13lEoekRH2r+/UgK5dPrAg5jSvx1K7jtkm",
-	"Za9b7abNoaoddNWThMIalogCpDmomLCBKyInbFjWD7LNommX3U2XbvtS+K+FvxY1+BiVubOq4PJIA0/4",
-	"Isf8jjbwI2us4LcYFkBRWj//6EkyVqPN27xCfX4f7T/QXxdWab68R08+NJV+3tvn40xDoWyDPeM2pFm5",
-	"ZrZjXSv++fO6VepW+AVBGFQ6x6scz8fjRRVfgT2SvIBxyW02tmqcihxGsVn1GClauUn5jx/e1YqzRTjt",
-	"VTPVN0adu+Ls1cVP9Hvom+3oaEj3qkIadikuQ3b56ZKmHtnl6pINKL+lEwqyw8NI4pNr1V7U97prj2BI",
-	"8arSbgKNyL0kHlwyvIHjFhu8/OcFuzgeOj9blhRdHQIBZE2f+DtF1sOB+3E9BmlobQuifwqO3Z5ur7mm",
-	"sYRUOAPAa+O6JduBk8J4zV0XstXLTR+SW+bU1eLdJRuj8c4nCdOtO3ZI73c128d52lVnrhQR0lCZuMos",
-	"XlP7jtcm6kXy0EZ5Vxwi6ZXErohiXxyAfHMC8DHO0MmprRrO6/cYhfXoVXfAalvXRT8otbp2ocRob97y",
-	"qX8Hp6yHbbLJyQB33cnMQ1HRi9x1VOCiUT+1sxU0Ml2LozN26uaX21GWG+xuj6nGSumEKsXjI/czCs6H",
-	"v+vQrR3Qoan0NdbunCsbEIFkqCgSbBqdPLZ4C8ltpcFZZnxHuD7wAuw1gGz2r6Pm1tY0cFqVdWhJkdMN",
-	"JodvlV5PJpFPamePbOD5uQ47HTvqSRY/4wTOAWzeyoXy7osEsZSUTg0oGAF7rfRVPfNOt6FNfIDbZoqL",
-	"kV+0TvJFgChww24QBcOwzsrpCh6OvoVOmtTuEnp92n8lSk7XlxLSx+f1pyBKi6XvPviL9dGno2D4kLRk",
-	"swEaHmyoDnm9P3bZthV1qHFYye7Cvb0VB7rH92n3RXPOhlnRTWxB8bnbKmT8CtVXsR8/nPtnI4o00dr8",
-	"3BohI64gzzuRElIibA47DxgFPV6qP+F7kFGHm45J99C8z6TXxvxgM/4467stmzvqW6WKUNCMFH7sTmJ8",
-	"y42I2cv354zGa4rm1u6Hvk+tiLWe+X07BWGwAurABfNgMpqNJsh7VYLkpQjmwfFoOpqgJ+c2Iz6M/ZAH",
-	"xWCq7ysyX6feHiHxRUEk2LWUhSwr6yZLXm7OR1GL3LhvZ9x79EEEY//B3m2ZFbKiPgl1/Sh8b/1tjZP8",
-	"vrfqL3BC6l4qMjFHlDrXiVxt9N2XQFO3wRvKhpryzgNWOz/TYyVpVMAB5gXjee7aam70VRGfuR8/RYgS",
-	"4DEIrVkftOcLb3eZk84IYu9U28Y02mwy2b2Xf2+8PbJ2FwYnh6zcGpVpD9fuBBW9V4PyCGPQI1M3Eb4g",
-	"Pi8AWH0KDfQlUNcDUYb0ucF6mNh5I27XH1D2zlQ1X1HuHotCiWuwlZZ1+tQr8aYp9BcQfe/s2VfFgB/v",
-	"hhuIK5+u1Nx08CAVc1o+/iySO98XAmfGuhx9Tc9bLcTu3PGOOGL9ynh7Lhm9yQY7T3ZMbrRswTU3zBGZ",
-	"MNMa1Bs9mpu47ICDEwWu0QE3wtjRhhQcf9oLqP8Jts83Uyd/rVC0aF1n7Hzkatz0G5U4NaR9YP8O7NeW",
-	"ywFc3TWL92XY+wGsFrDaYnAGPNnJ4dnkhIl0k9H1h8h4jAnZyeTE+ZFrYaCPvf8FPPkL4N7RO/pC/HyV",
-	"QXxFFpt+dmXndJO7ZdUD33eKJ2SNa9jGPM5g8zuCSFLG0s/7JtLt4/ePZcK/tKUJ+wrlWuWmGaRDoPAe",
-	"hrNKUges7quev3b5fOp7YAM/kDMMt76k0JCu+wIaypzHdUpHe1NDVcL2CSERI6lTR+0Y2LO5myHSS0gw",
-	"zfeJ9f1ntD7o+LUCfbv+ooM2CtqTvD4oXyiVA5fBnQPwQ77I+PPD7i+3P4BR9LXMiLFXbvCgYyg9vrY/",
-	"Jui3bft9TkWw7PE5s8n0sB1iDfzLea2OOjud6aovenjTFIa9J9ryHL50/BiL3x4avguD08PXNIPQ3Vt8",
-	"B7YeNclt5mbgfH3aQcJVWJ0R2OY3ZoC+Bpsp43uUf+e64MfsHV/4vqRrT2TWlmY+HvNSjK6O85FQ4wUm",
-	"f+PVdNxjLly9PE+P/Mbt4nLIdCXxqEua7vFJE/50uXnifOxiLtxl/mzybNIcGtz9cvd/AQAA///HGnLr",
-	"40YAAA==",
+	"H4sIAAAAAAAC/8x925Ibt5LgryBKu+Hmnmo22S1ZNhXnQdbF7rVsK9SyPRGmZ4hmJUlIRaAMoPpiR0f4",
+	"aT5g5nX3dX5h3s+n+Es2MgFUoS5sVrek2OMHi03ikkgk8p7AH8lSbQslQVqTzP5I4Ipvixzo8zO1LUoL",
+	"b+C3Eoz9Thgj5PqVWvL8rS6NxSYZmKUWhRVKJrPkVDK7EYb5QVKWY2NmsTU7v2YPjpkwbOsGOirle6ku",
+	"5XguX4NmL8QaJI3LeL5WWtjNNp1LYbELN6bcQsasYufA7AaY4Vtg3NDnQsMhzTGey7cbjj1SP1fU0UHx",
+	"YMK4zNiDaTqX9I2Qa/Zgylaq1MyKLWAfti2XG/z3wWQ8l0mamHK75fo6mSVP2clhAaDDGtmlsJuwpPZ6",
+	"OcOmSZpc8LwExBfPiw1PZpPxNE3yBiZBWi0Q77/8kYhkNkmTd8lsmiYXyWx6k0bfHdN3J/67afTd9ObX",
+	"NDHLDWwhmSVC5kICAi9+B+qQFBpumS+ayc378Pbxbm7SfSTyVGavNQylFqkse0ebdPyZaeKyRKowCrd7",
+	"Lqv9jqjpdirirJRipfSWNfqWBjImVkyq5vemgKVYCciQSAJB4VYiRfz1538+OGZcR3QHGYPfSp7n10SB",
+	"IJmQzJZapkSfO6jjwfFcDqdtPAtjGO+ia7gAfa0kRIDck27xeFSz/rPQbpfWzgQuY9olq7cRTbGCG4NH",
+	"urFCtWInfjsP/H6OUna5AQ2zuZzLQ+QS1NQ4RkFfOPrz3x6zv/78T2YvxRIa/MK3ntYNU0QofXlcfTlJ",
+	"Ge2lhqUotFpyC/jtZ4YFNjaXFaNqk9oTTwJSYcMHU/wc/Rxzsq3SSFFcOkY2l69DO2asBrm2G3awoH1d",
+	"jHCcyXhK7U4taI74ZHajwWxUnrGDBRRG5Eq6pvzcgLRP8ICAdGQL+gI0Kx3CM1jxMreMyGcuzzmetbJQ",
+	"rq0st+egcSf8PpyM2hTrNrhFth9Mj5Px8aMekpyMHz/qpUr33TF9N/lIXHYyPm7w2cn4i/tR//Ee6hem",
+	"j59cCFVGXBeullBYT/g/IXINkZxZ8hwylonVCjRIm18/wRZ9pEFb0iaQtbgAyeCqyMVSWOJIkXhGWBwl",
+	"5nABuUGGWMFKO37wYEJsFBe05AZGc5kpkhEbfgGeXSLhe0CVZksulRRLnovfIQsjLnPhCFXJnL4RmmnI",
+	"uRUXwLZ8LYUtM/xkLWjjoYQKcCa6C57Laq1/n8LhdLJIcfrJeBL+m46ItxNfWAkJ2h1DWqH4HQ7dcfBn",
+	"BIebwuHn7IidNEY6+evP/xqlc2kUE5Zdijxnlr8Hd64ruEw9dmd35/K89D01GDyPQrrufLksNbfANJfv",
+	"hVyncwkk91B6ML5VJBYuQR9iA8j8QZXAtds8LnLHK5piHM80YTqwWLtR5XqTzmULZUgjGayEFBZwRsnU",
+	"Bej3Is9nbgeqTfIQeqCacpiWhkSx3HC5hrnkKwvaQcDZCi4jPBG4z5HcVAHaUTnIpSo1XztZClcFaLEF",
+	"aecSua8tJbCKrLGFox8JkBnE2Hg9RgUikBazqqBJlzni6VJIGSbCLqRQcLZUXBuk8JzrNehRNAMtJxfv",
+	"ESOmXK3EEgbxRSfOD0opYQnGcC3y6xFRHvNj7+Kc4ecZ7conkeyfgofeX1M1hZIGblcfPOPUvrHfePbX",
+	"n/+nF/t//fl/mXaM2YlZ/M1JaezmyMBt0UasN2Cs5320Iylb5spAfj2XK5XjmSPeRRM8mDxhD6ZhoBx4",
+	"6ArZneXlDln0cHJycvzlyfTk8ZcPjx8/boum6ePJ44dfTk8eTR4/fnTy+HG9m6738aMvj6ePHk2nx19M",
+	"v3j0aM9G7NiH44+zD3O59xhEW8X4uboA2rDvlSVFyUZy6aIWhcQwlWY5GMNEBtIK0ibVXPZyXbfV1JpY",
+	"M7Hz6f+sZOkSGXMGWyWNRd4k1zQvncAAKLvkJmaKn4AN3E4Qn3/5xXRy/PDzk8/7KWLyxfTLLx5++cXx",
+	"5/0kcTz98svp8aPP91LEqbzguci8ZvPiiu84mU9ZwTXfggXNqIeTyqC10uN5Y2VbXPwaJ1xyidIhVzyL",
+	"bYAZ896H+EumYcXsdQEJcYxqNnKG1EbtafYaf+kCuBDZgmUglQV/XqOxlyrPYUkQC8loqUJJB7fA3gW3",
+	"myRNJHeIypI0QUIVGrJkZnUJHo0cJ/4fGlbJLHlwVHtvjtyv5ujMKg2ZB9UtxVP8VyoTfa6dr1R2jd8u",
+	"lbQgienyAtUfwvDRO6Nk2zfU6w3qgyp0OtrnTbpJk33+gw8ZPx7qJk0i/n+HUUOvaoDjew1wnOCmDNvN",
+	"np2iLW2S3uuKVtlKaYY6BnWLxFKHnhxhOM7aJAr33Q/f3pEm7ozTpii+D1KbQmQ4VokO3sCqD5dn5RKZ",
+	"56pERdjjMaPzHHmXzFJpqK2FSkKdq+wa+Qhpvqrd6wKWlpgVSTf3F1otaMoYpXEeIRmCAzJDsaB05szk",
+	"YGJdLEZkD3AaXp1bLiTjgX85RRk3u5a0Pwu7ObPcmjvt652Is2+KT4JYBJoL6bhrNYLrgii5hDzHfy+4",
+	"RoE8l8ZyK4wVS0PeFI8gww62XF47C8N70TbAVjm3h2jX1L7Dkcfli6si50J+AhTSyJJ67sUZOCh2IG2c",
+	"dITpHQ9wLHtvPX87RPbw89cCs2fhz8hsZwb/xyUTrkPMz27SSCh/DfYTbM4pqSu3MQtHowQTZC2BTwCY",
+	"cRPQT3UUWzPcDdb6mBC0Z+TJ+17ZN8AHaQYDFRMaFsEre+Fzv3vfJtM4Nx1N/r6CO4bv/xdwtwEmtiWy",
+	"lE+wv37oIWzCeCgcm3COEQffT5Xa/AkgjAcvlLa7SDDS3Z1PahwxjlgRIaWGvii0KkBbr7x698Ufifef",
+	"odHxKE0wvsNtMksyVZ6T6bvlV2Jbbsl02QrpPk/ShHT8WeKc0IgXLq2gs/MziPXGNsae9q1hxUl0n1+z",
+	"y41YbpAayA3JnkorDh3D4PK9F01zeQ4rpYGZ8txqvgwW35attNqyda7Ow1F0ilrPUm4Df7mB5fuXGn7r",
+	"CbKtvCP2QI7Q1yssW2phQQtOpi11hczFj5hsestObVBOXKRMIlN7V0pnxvgIUh0sMOjA0GyebFVW5orJ",
+	"ecLOYcMvhNLBWdbt9PfHJJqrNfz90VzuhtO59x4fTY+Ppo+PxuNxE+LnbtfwmE5nbN8wbtHVAA73Faan",
+	"FaaFtLB2qM64XOdCrvcdhue+3ZnV3ML6mvrCkl93t+g5fo0UhEePPsSs2bkh5tJ7s/maPKzAlxsG0urr",
+	"lHnaipzfYTUvsIGPBS3VFhy5LewCh3CmOPNOgHQulXa/4zTYZqnycivZwbOzn9gGeIaeSqXZFih0c/C/",
+	"z374nr0SEsxoLtHpe/4OlpYZq9CdSntaFmh3N2UNBRoY+19sAVfF7Junr14evjp9+WIxY9/w/AL8ev3W",
+	"VD+PfR8EmevZz6ffP//h58WMndGh833cj+hHY4y5tUzZwblGUCRcjliGBr9VbMIO3AgMoxdK4z+gR2ES",
+	"Y6GYPfvx7Q8vXy5m7LlWRZiB2jmfjvvduZTL4IhHEhOSfa2YO8HsgIh+8fh4syDkoXbPr034/mSSLUZ+",
+	"owQYOh2qtLRlNBgKQiIbyLwvn/5qx2xDP1Rqodp0CtPVDpKnyNmB7IZF7eV9xa9Bm0XQnt0ZCN7DWYKb",
+	"dDLJkuosGKuR/AM5vwHv0erz4AH7UYorB8uBkMzAUsnMjHAytapZZzBWHeh8DZ6TLGiKxZgxf64JeKku",
+	"W1xSSPv5w6T3uAqzVKW036kM9h7ZuK3rS9j9RhVmv0SoI5obVRCcKLT4mltgYSRmNxqDISxE9HGRdNyq",
+	"tuhB8nCwrcpgEE/yw3+w8OJ5XsN6IGHt4i8RpY3m8nIDsoIRCTEWX4HNH7OilMJswFQDViGkKmq+4TpL",
+	"2YSJtVQ6ankvGVjFMf74EEUATcC3XOT91Jy7kLlaVeYihcHm8iCEqErp1K3MsZ/KM1zJmJHPKNlSEggw",
+	"AyADB7egt0LW/mANy1Ibx8onbAscOUw9LYWUiEP4cVIftIq7kgvdjwsZMyrH0FIUjfd4a9HZpI/OhBRW",
+	"7HH3dR0s7ZDS3Xs5FtXjEM4ygR953mCGOTVPGRGiksAK0OTWpY2jJkasJc/n0vi8DRd3wT4yU9rAFqGi",
+	"SGzBr+kPF+r0ylQaM9AFOyBqEDrMnbJLOokUx5nLqK07oYsR8/tkwENLzP48B5lBNpdCVpHGaiRTblN/",
+	"Xl1URDqljHdczShPUD2gkX14n2l+ibigkIoPmBPE5EwiiiSVggYA/Rnld6i8tJVwNeWWPE4S0rqPB17J",
+	"Ok3AjW7I/NXiHEc4v/bLoDipd3lb2Jp95PCqSQJIFZ4qudb8ukklQ7lfAGXV3ERia7QDePyEk6mNzfOS",
+	"8onnVxlTdgP6Uhi4F8Pa8qtaCe7nN55tRbIlCvo7Fbbm4FYVcwmo6ouVA61mKEo21WBkGluwEV+RiuVi",
+	"K+wQPtBQ33dALuRuyFE2gkZ0DQbYARtrAZW9UJ0Kl3LHsqjRdIj4lOX2Fam3O9ZSr8Gq4jBwei/LUHmR",
+	"RmSga2lelLpQhthNj0txzFhAekjaG4J0nO9pMHyH8tBCw3MvVO/Y7e09+mAqG5geLL6ufkPMGQuFqe0d",
+	"Ib2X23thI042lwfeULuqzOagJOy0e0a1hZGr9WLG3kCR8yU4Buf86Bc+QqvWB9O/XdSK/2/a9neg5sIa",
+	"Zn4rkR61Ujb0WvJi9t3Tf1nM2DNexJ24Zd89/ZfQrMoemn13+n3LrjiHXF2y706/rxqr4vD97NvFjH0L",
+	"UNT5Jd8yyhmpw9PBFvSMO/RHKo1WYjewo4OzlWh505RNj47xfycp++vP/+oLRYTxpTo0kK8Oc6UKExZD",
+	"3wQR5MDwCY7CXs9ePn329oc3lc22LW1Z7zMCcSD++vf/eOfyMNm7v/79P4TLuYQrYawZoRhxY+DW20sA",
+	"yVyO5LRltPySNMBLUEqs0TwgpE4nk+TXSAB17JqmiLmJw2a/xLrMr1VTZ/omnSy9EFflef7DisLsA4Ip",
+	"3ut1kw5q7WcKnfbDtMupBqsVLFHdP72nllcN8EotP6T763swH2eC3LHbvXZ2RzSthcsqMnOXRQTbg4bf",
+	"1/Flo3F7LREA7XH71+Z1NeEMqCb3/kG6jMzPIqWO0iEVO1CaZZCVziGIJs+I8RCNSltYwTH6JWw9rBes",
+	"TMgMrsZ7hWKV8HHbmEqOe7WzlkbWQmGjcqMPaR0PX6x5JoWGkEDfhO0bdYmY23CZ5VAnpAe/Ii3fhGR/",
+	"+qPy7qjSnqtS7nCnhRkX7MCDMZoxkv5OCxcWcz6VJmbuZW2VN+45cPU3s5CTE7+Sjb6AYtEe09cbMCVR",
+	"oue5g9mP5zt1R0PujMw5CDhhU8YNE21EhGQ3hHZrIL8AE4bQkFWAkKjLGnD1wSMkOWWUDvTrbKxlqcly",
+	"8XqFkE5CtvzCIJEMf4l31i8vSZOwHkqyqAGJCKeWL89hrQGqU952BuOPrBoCAY2jc+1DtRHGqnVvOtL3",
+	"rRz782uW0ei0xIJye9Xq0F4qdl4u34M1PvHWmbKQkxnMyM9j/Bhq5cdgk8jb8P7Qbqr2B+/ZvJxMToBN",
+	"Ro3Oc1n3/uX4Xw/eH05H4/Hxv74ftUzC28982wLc8qv9nbbAd7mGbrXRIBOu416LaF+jFnvBHg52D1w1",
+	"WRrtaS/fATLUIHsLV/alX0+XjPCvc1+J4TscWriybCVySFnwfKBzX2n29uynhhLlkgJdBAA/T9JpevJr",
+	"moSxdDJL5ngEXGAgma14buDm1zZ5ViP0seiJT4MvlBHOMvSeNd8LT2hpHLmS9jmby4VYpGzxbkFlNWxx",
+	"saB8J8ezttxqceUiGQux6GkR5d/ANaVWapGF+ZjkWzCu5gB8xGM8lz977uu+oDIDB54rYnCHJUDMdR0r",
+	"CwrzD2Taxi28A+GDyH6ptlsfsu2P+KUUD0HbYi2kRI5KWr6rp9hwtKdAd8HZ8qtX5OYMztLor54QQEUO",
+	"fRu8EpBnFf3petqWLW/5OW3SYmwN7pfIwaQYFdjytpPlTuAF4owEs0ukbIL68wZoi+rtRMxR9CTsuuTb",
+	"4JX1GxlRRmiVNjY5p7oul8rtCdwtwYN5rlSOB//mpu+Qt+IWFfyJkmAsFL16xR7fPePZOypAiz32tQLh",
+	"R27oD2c+YO0kYhifdMHwR+pkq3cqnocSGwy1xfNg8JkvLWkKVNSAnb6OGrAtv2bnQHHKAH5lYud8W0CG",
+	"xqOvZaqg9dOTQ5SaVZ0b0zNu2e+g1bjSlqqAy4w9RTOzQt7lRm1ZM2QRfpu5yXYjI3Kv7sSEG6PISxNl",
+	"m69ydUmOR+/63XoNKkRkhWdh5NkOYaRFHKJaUOBp1FRWamrxKPQKhFt6r3pSpbo1TNg2Wydj6D65q3SE",
+	"X3O7CQc3Iu7P00FOUFwni9IeMXN6PMiB1s+myNgIsgebIQkYcHaMLwtClOyfw6qisqWUbkUNJ+k+52Jt",
+	"tCisiUT5VVOZGSEouTBYyzahDwYV3CHOQ6sKRHkToJPb4TFWK7mua1UQy8StV0Jm+1DR0nYCvfhd6FNr",
+	"4jzI3l0618DfUwRfrXxl/WemJwmyrYKEUCVkZ9hgl6SSPHcjkCGiwZZaulN85KEfZEXW0dj+iXCXQ4va",
+	"dDbpDmfb0EhJw4Lv0RbClG+V5TuCm6bcEmbzvB9AJDhXZukqj4dhQ0gyWXcjwzdokP6nxoafcxgyOgB+",
+	"CDqK+hR2puw9byGMfNgQSOgf6M8TD3Xdd8AWnR5kD32o2s0162Tku3hsip3XQRAONlxD4MSe/XrXAWQt",
+	"XAw8jmb3oXcT+DBCTfOeX1ROmJha2N9YWMHAPe93Kzmo0g5zqg9Mi0ojzEUspn2wA4H1MdiXbfdiEyEv",
+	"u5Eqcjy4xHna4lBe0GWxkFv+vdLbfjyHAnFeGXioL4fPOSf1x88eaiPivEMnc+CQqnF9voKPHFLUVirL",
+	"Sgot9YXb7hObzSPdpCMqqjlyf4OC4dcpe0XmoJzLak2v/jaNo54bntUV7h6Vo7BcX+jYHy7FSPtSlXmG",
+	"GDH8AlMEzq93L3kuDwpuql9x052bLrj96sp93GEXfaLMHpED48uNgItg7ThoXZruAEXDr2vHeXPH2LcJ",
+	"jinkHGJJfr3XrsRcWbYilkuRIKr2R9W9J0/2nrZztf5dzH+9djnyftgIYXUJ4kHA7chlylI5uwtgB15F",
+	"TpE6YccwdU6XZKCp7YsmZuwUtZkqqsytBS3Z06+ePX/+4sWLFy+r/6ggNAwwlwdkhOSA7Z2piqxAyGVV",
+	"BjQKrC2uKNXYzSUTKrthz5/T8caZyGhtQ0w+O0/nU2p6QiU5LlRDyRyn0R0NKXsbMPX3hwhVjco4zOsc",
+	"DnRUTHfO/XTWjty40xrvaxrxpJoq+9hiVOJB6bJdkngDhQYD0rpsSmwUnETt0s4xY8+UNKSae/ZG7T8z",
+	"cxlVcIX0Hyc0j/wBYAfBTzAK40cjE1KUhAGBRFrMd+QMoyWduvH3hhSp30/kIgv94CrpOvXsnXI9YzxU",
+	"WeAbiBGIhOl9VuSrE1s49FmvrXQAAxCyQkdjxl7VP2JJF654FDKao2SjAYmiF7fuO4LKt5SUWeWQOa0w",
+	"BFIA9NGhQbUsE5XfJ9p/v9WHntPdQ3O42Ee+GEbs5sjJkOg9T3TI1Z0nCDfvkq+7z8NYL54LJVyK50Ho",
+	"SmwF+0oGVxa0pNiPoYrjaCB3cduSa9oI68NxhMoDxNCFyFAcmYJrU6WgjyL3rx/HFfT7w1CBH1LNhTWQ",
+	"rxw2mxRaVba30fEsLiCUSh6SYPHNd57q4bp0h5v0SB9X+t712Nd14+3bkXbAVSmo1rBMbEGaQUUMLboi",
+	"cNIKZf1E1i4qbKK7KrffHYago6C1S4HnbFNuuTzUwDN+ngPzAzj6C55ABtvCXjd8psFL1VpCmL8PdixT",
+	"oCqFIaGSuqYBXaiXuJeHVfiEfh25+IkjTMoupT6eIgvQ7sB7ZSboNkGddaU43PgdNmQ+llvQYsnc2kxP",
+	"GIbc6PgxQa0CsaHqyHSXPYfmvXl5rnjDRTo+XXil4dwnIU8zVsEQB8aeYMhtnv1Ojk5n3+sslGeUkd3D",
+	"GtnmulB2A+42jziF2O1hh62IfqRSH9B3soPf3TYU3C0Jon+oaD1P2IRp2KoLMEzY+2RDiARBTncIoXae",
+	"bm8KSSdFm67wykFmTxiJ9U6mbXcD7pdJrvllw/FJCufOIBAdilbGHCm4DmiXb32NpOu5gCpd0jMKylYS",
+	"tR/Bqih9uj8IlCaXd85frqEa39XEvVvuU7u2uLO/sR621rzYxDkL1Y1IglU3rhJ9v2MH3MfGLkLxj0u0",
+	"JN7yzt2hxiWDbO1r1wQi8d14Lr8PQZ4guw+CJ8Rp1w25HlKecRzTpy8I6fIu9pYIRakb6CZwuZjPQsN9",
+	"+cMNae47e78f3YggJSwtKVJ+wCEmtyy3zxpX+O536vdPZsZD5noeFUDuX2sndSko8lLVO9/mvKgL4tna",
+	"cAsXgGVgfs4FMz7ZqioZyzlFsevSNruB7UC0vUBy2LuOmD4FkG96mZfkda1zTAehLtDsoHmrKGZE2B6E",
+	"QZORL2XQJg0a7gzy1SulimFD1gdd6cqzGoqeqzSuQfMKuc7BKvmRaHwul85A9x4oyqxWPsNxIEw/SvKi",
+	"oOY6DBv+OgPXxTEyrNRsu/WHTK9Key9WVdYwn2243gt41N4vglPxGaXhKxmCI46AdrlYh1XdtWRRRbvR",
+	"Ge05Pi2qbPKmGE9pzd3bzLKHg+8kus7O9+C0T3b+QJ/OXFX0bjv9TXVlEO+9MIyTAmehXWaN7sDqksiG",
+	"1VDdueZ6+A4Its6TWWJOZkdHLu/vUPItHGHk4MiqI7Rvxktz0eP4WVUm1O2U101QQ43XKEmm1b7+bYvt",
+	"xoPcRtmPb14Fj0EHY7SIYE36q92ccscp5S024uxGQ51z1rWADtom0IhMIMybjDr1NXf20Kgye9qZeLQ+",
+	"UyfjvT37KQ2mYubN0sxDuXCtF0/Yj29eGRbq1GRImgr3LVvlk/k6zXAD8gWjPCuZ4V+LGC2R7bvPxM2F",
+	"hLSGEF1di2p7F+O5fOYyan3RyoJobYGAM0eV7ODpz2fs7GTkfMSFC/ANMfWREvqOWagaGGjs1YnOdzP1",
+	"2iz7A220arghFtpwFip2WmoUZdyFo7fVbTHkEqjT/ndkVlBtVv8w9RU0PqfCn7ToOppB0VsXweif45wb",
+	"IM9mNAVdnnDnWXYHue+yxxhleLobJVOf7VqjBjuM95YnYquvbkFDGLdCB3YIrsEp+1uvR5G2g7vbaLXf",
+	"37s6Dn0o229R6smhAXCMlF5yjC9dGu5WfFvfNm+ob+RA/CBnYX1/06dKfiMB0Ly7aFjSW1VvqikdIq3s",
+	"R0/qLnOVUsNci8HJYXnLU9ajYH+zy1EWzpkvOXc2vAHbvf/UJTJ6T9mBGFVPVwCwg3cjD3jFARl7RZZd",
+	"FcwJudrAdS4QJZKmrXmW8fWkRIZ1JTcl0WHAKGVihWr3verPPcvsKz8QMuant26q24a6tJ7OXWC57t7O",
+	"ArxjDPw2QtbeUxaumLhd+b4tv6FoSMt9G14LS49pSpHzFbQt2rkDdlsie1/lZTh2t5zbnbmD/u57ShwM",
+	"/Le6SrAj15a7sBIkgokTRE3Yjx1HcjwUH7Fs7iE0NF3cRANdL30gzmXtvbDUIIcVuWewY4A7K4HZwRcC",
+	"tPfJY68Bce+mNe4p7o1c0lXbK+GWxUOcsaPt+9DvUa1vu4yM0N30GVVRZNHxKSdRqvXeFn6IQO+Punan",
+	"87CrxlspyJwqKLMgld08vX7K7K6XP7cUw/6d2JUwsC8kjnhzG+DjXCO3T7GxVMXD2vZp/ZxA89GAm18H",
+	"6uJaXX5gtMXX/QwapDcgshOZlE7ZQxbLDRfkPOmIRt51B/VW0JrbFVXcBcZz5dO30KjHe6bCvW7tOUL+",
+	"QjOZ84OSq0IG6h6Lp7pFiQpucNa5dDfVtTWH5mrun3Zpkgi4nTs39Dz38pw6tcFpQj7+08nmYTocpMYj",
+	"OO41pThVxD0zFT+as1RUwsstHB26n9Wqykuq80/irBTcZH9PTPPVHXZAAJIVTu756tplvqTbGSS3ZUjI",
+	"xTbC6VTh+oUwfkhnioam0E1ZBPqi9I8rFCYvla7fSSDHVqNM58Djs86dcegI9+o7DAXSba+KluPfRxNr",
+	"SQKPbmeSYC+Vfu+aGbcaGsRn6cRIcYk+T6KZfHbmPKFmAPNklIZ0SVqCZyQOdscDYwr2nHD/kuic1osK",
+	"qedVaZHSYu1vCPML64NPz5PRXfLF2rccp4NFzJDm/Q7QLpcP/sphcd0z17p9zv0gt53us2qeFnfSlZ+Q",
+	"kozcUCnj7wXViv/45tR/N44KrOoHLQgrSepnDO5WhETYHHZOMO67WrE/E+9O4hiuGsLYk+ZtwjiI4cEC",
+	"+H5ys29v6quDT40pe913hVbnOWx9ZnBU/RXlaSX95ce3+03CNafn1xaqSs2GdigkPj7m/wreY7xQk57A",
+	"Q7PSiVYHoDC1swukFdqNMOQmKuhPQO2rlQ7iU61WlY+3us/1er9GRElC/VPV971S1k53JurbS7d0Fm7F",
+	"NsHoTZU2RvfiZ2CeWbUTt2SafZCbqHPT9V6js6M+1Jdgd6lW4BnYpee5tRl3DtKAQ5davfIy/AUmzgW6",
+	"7EwtjJv9DqZ6+3zus9X9Crq4u6Hqq5XCCeu3jN42XzP4ihuxZE9fnzJ612NbMTj3Q98br+Ok5rN9I6FD",
+	"HOhCzGSWTMbH4wmuQRUgeSGSWXIyno4nSVSfdRR5+QrVV6/k/Xvdtyt8YYbdhHw/IYvS3yD5tP0wC10i",
+	"b9yjna5dVZD9qqNB0Hb7pFl3fR62qx/1dEx+X6ugA6d0magibeKQUn3DwWxdr+UGeEHZm1WK/R16O5Wy",
+	"RyEShgWqeUKeLSr2d/k6qnBXe3pFpvDlMKdZjfokftjoehcNN94+2uGVbTyDczyZ7B7LtzvqvpVzkyYP",
+	"h/TsPH0Rv+q1k6ioXSDKQxQwhybkYn1E+jwDqOpdySOZQajJwD2kbIn6FTOneHJbv9zc+5hL9Xzz7vdY",
+	"cMdDze0tO17dvvVPsPW9j958UhrwUTS4gmX7YiBHHt6Kvw9NcFPve6boPni6UoGqrv3F6VXJpFpF1FMA",
+	"ZQ47JnFrVa+/hAnjUgfTQ3rFYfSP/7b/Jv7x38t/E++YBb01jUCBqUd1paE7q0Hrhv3V1TgpzZS1ZooK",
+	"7KPJ7lCL20Ot/gqFLpF+vBeDmhc0DHgRTAYfz61Pgt3nPHTfRvoox8APu7vU39E8iRW3I0d/iOzG16OC",
+	"E93NfXlO30f3EzYf+dthJtdNjrqPAKKx1ELZw7352FTw5IDMmImebhnfG3XYbcDEeLSJW9OlmuMWyh1+",
+	"4g448Bpsn+mJzDpiA9SprgWKJ3UPWXr7RMOqj8F/DfZT78sArO56T+rjoPcNWC3gooNgNLZ2Yvh48jBo",
+	"8hGi/Xw0jUnZw8nD9kVJTfR+Azz7J6B7B+/4I+HzGV4BTdyNfnalYas2dovS9iWs84w0kEC2SyyL7d4/",
+	"XDnOu7ivHDl9+P6xyPjH5jRpXzGbVrmpHtmhCHcPwlkpM9B1Pffp83Cdvau9rS58StsYwFXWtXvaXSfs",
+	"PZY0NqXRSujOEIXbT6lkEvYM7q751uv4Avw9c0Svp/5Wgr6un0+lgZL4NbrurVttdH4X10apVZwQF4w1",
+	"eoTQO/jSuWwY32rVlzvoMTtjC5G+Sy/otZdTf388Ui6d2ub4CGlVBlbkdAOYL1npW6ov94rXOvRu4zQx",
+	"9ppsZrrG0p3pj6+wNK7cjYe5OnR5iM2hqtjOuZCcVtr21HSUnacdsqJXlHiGBdkun7IhgaqDO5dPcypj",
+	"deXsaV8tAhOykRxJ4GEZ3M7MyJDPufhjnog5euDmybs5OrXmycUcnz1efGiB4CC9bb8SUhKf6lFCjifT",
+	"YSMsNfCPp8Y0+Ltjok1+3qfyHVWWcK+q8lTy/Pp3GK6rxIVM7pJTZ5q6C+Arm2s2l1n3tlaTtu/yjcpE",
+	"0rqsg2qR8CmN26oV/B2rNE4jJb/fFNmrWAXb+ePLIp8yQOknPbcVRYEtvwiRC/RRGrYFbkpXAHq6ImHv",
+	"rwJwT4CFAFd4ZgGyeILdEiCkOFFMpoc13iWo/aHK5MdyE3wUrelrsI3W7Xo9d8ZCltRH8iXQZSPc4EZS",
+	"2DIkq7pivSpe4o5nnKk9l43TKbNuNpqv/srScFYLpW0V/+5PhAqv/m8ocmKq7BzNtiqjZJy+sxTSQz+R",
+	"Yd+XfTrkre9ORtvHsux7njP9KIw9jMv27q0nxipB2DP4DovzKcT3WmT0mOxNmjwa3qd6ILd7wPwlV7nd",
+	"uIdqfJ6yW46P/cBhJMh2n7Pn+vpQl7Kl2DvBzQ4Wr398yzoScYGPoJCNs8d2qZLm8QDQWbDNY+SZcDv4",
+	"SnmBLpkjhO/oao9WeRKmSVBJdBQcDWmt9ZsqpJVxWYVive7zxL2o06pLMWmro2vtM0vaKp5J2a7557Ia",
+	"xyVlNG/pbZKYD4A1LbpPrCsP03IDMY0/zpHvfSH4oxz6gMKWPodt6DQ5VaQr2TAO6FqwjTL+Zp1vud7y",
+	"E/aKn3ulxxW1bawtzOzoiBdi/P4kHwt1dI4hwKOL6VGP0vKWMjHz1aEfOM4DTRkeOqxsosc0HM7pp0V7",
+	"xtmRO4A4yuyLyReTatLk5teb/zcAU3jVImKVAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,121 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+// DelimitedTextOptions converts the given delimited-text format
+// of a trust matrix (if matrix is true) or a trust vector file
+// into sparse options, for use with sparse.NewCSVReader
+// and the sparse CSV parsing functions.
+//
+// f may be nil, which means the default format.
+// If f does not specify a delimiter,
+// the file name (or URL) decides it: tab for .tsv, comma otherwise.
+func DelimitedTextOptions(
+	f *DelimitedTextFormat, filename string, matrix bool,
+) ([]spopt.Option, error) {
+	var opts []spopt.Option
+	if strings.EqualFold(path.Ext(filename), ".tsv") {
+		opts = append(opts, spopt.TSV)
+	}
+	if f == nil {
+		return opts, nil
+	}
+	if f.Delimiter != nil {
+		delimiter, err := singleRune(*f.Delimiter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delimiter: %w", err)
+		}
+		opts = append(opts, spopt.Delimiter(delimiter))
+	}
+	if f.Comment != nil {
+		comment, err := singleRune(*f.Comment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid comment: %w", err)
+		}
+		opts = append(opts, spopt.CommentSetTo(comment))
+	}
+	if f.Header != nil {
+		opts = append(opts, spopt.HeaderSetTo(*f.Header))
+	}
+	if f.Columns != nil {
+		columns := *f.Columns
+		positioners := []func(int) spopt.Option{spopt.IndexAt, spopt.ValueAt}
+		if matrix {
+			positioners = []func(int) spopt.Option{
+				spopt.RowIndexAt, spopt.ColumnIndexAt, spopt.ValueAt,
+			}
+		}
+		if len(columns) != len(positioners) {
+			return nil, fmt.Errorf("%d columns given, %d expected",
+				len(columns), len(positioners))
+		}
+		for i, column := range columns {
+			if column < 0 {
+				return nil, fmt.Errorf("negative column position %d", column)
+			}
+			opts = append(opts, positioners[i](column))
+		}
+	}
+	return opts, nil
+}
+
+// IsJSONLines returns whether the given file name (or URL)
+// refers to a JSON Lines file, i.e. ends in .jsonl or .ndjson.
+func IsJSONLines(filename string) bool {
+	ext := strings.ToLower(path.Ext(filename))
+	return ext == ".jsonl" || ext == ".ndjson"
+}
+
+// JSONLinesOptions converts the given JSON Lines format
+// of a trust matrix (if matrix is true) or a trust vector file
+// into sparse options, for use with the sparse JSON Lines parsing functions.
+//
+// f may be nil, which means the default format.
+func JSONLinesOptions(f *JSONLinesFormat, matrix bool) ([]spopt.Option, error) {
+	if f == nil || f.Fields == nil {
+		return nil, nil
+	}
+	return JSONLinesFieldOptions(*f.Fields, matrix)
+}
+
+// JSONLinesFieldOptions returns sparse options that name
+// JSON Lines object members of a trust matrix (if matrix is true)
+// or a trust vector.
+func JSONLinesFieldOptions(fields []string, matrix bool) (
+	[]spopt.Option, error,
+) {
+	namers := []func(string) spopt.Option{spopt.IndexNamed, spopt.ValueNamed}
+	if matrix {
+		namers = []func(string) spopt.Option{
+			spopt.RowIndexNamed, spopt.ColumnIndexNamed, spopt.ValueNamed,
+		}
+	}
+	if len(fields) != len(namers) {
+		return nil, fmt.Errorf("%d fields given, %d expected",
+			len(fields), len(namers))
+	}
+	var opts []spopt.Option
+	for i, field := range fields {
+		if field == "" {
+			return nil, errors.New("empty field name")
+		}
+		opts = append(opts, namers[i](field))
+	}
+	return opts, nil
+}
+
+func singleRune(s string) (rune, error) {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError || size != len(s) {
+		return 0, fmt.Errorf("%#v is not a single character", s)
+	}
+	return r, nil
+}
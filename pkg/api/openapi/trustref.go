@@ -2,6 +2,7 @@ package openapi
 
 import (
 	"context"
+	"slices"
 
 	"k3l.io/go-eigentrust/pkg/sparse"
 	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
@@ -92,6 +93,32 @@ func InlineFromMatrix(
 	return &InlineTrustRef{Entries: ites, Size: size}, nil
 }
 
+// SetInlineEntryTimes sets t of the given inline trust matrix entries
+// from the corresponding edge times, in Unix seconds;
+// zero edge times (none) leave t unset.
+func SetInlineEntryTimes(
+	inline *InlineTrustRef, edgeTimes *sparse.Matrix,
+) error {
+	for idx := range inline.Entries {
+		entry := &inline.Entries[idx]
+		ij, err := entry.AsTrustMatrixEntryIndices()
+		if err != nil {
+			return err
+		}
+		if ij.I >= len(edgeTimes.Entries) {
+			continue
+		}
+		times := edgeTimes.Entries[ij.I]
+		k, found := slices.BinarySearchFunc(times, ij.J,
+			func(e sparse.Entry, j int) int { return e.Index - j })
+		if found && times[k].Value != 0 {
+			t := int64(times[k].Value)
+			entry.T = &t
+		}
+	}
+	return nil
+}
+
 func SparseFromInlineEntry(
 	ite InlineTrustEntry,
 ) (*sparse.Entry, error) {
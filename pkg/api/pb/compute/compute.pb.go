@@ -12,6 +12,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	trustmatrix "k3l.io/go-eigentrust/pkg/api/pb/trustmatrix"
 	trustvector "k3l.io/go-eigentrust/pkg/api/pb/trustvector"
 )
 
@@ -22,6 +23,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How distrust (negative local trust) adjusts global trust.
+type DiscountMode int32
+
+const (
+	// Subtract each distruster's distrust, scaled by their own global trust,
+	// exactly once.  Global trust may become negative.
+	DiscountMode_DISCOUNT_MODE_ONE_STEP DiscountMode = 0
+	// Same as DISCOUNT_MODE_ONE_STEP,
+	// then clamp negative global trust at zero.
+	DiscountMode_DISCOUNT_MODE_CLAMPED DiscountMode = 1
+	// Also distrust whom trusted peers distrust, through distrust_hops hops.
+	DiscountMode_DISCOUNT_MODE_PROPAGATED DiscountMode = 2
+)
+
+// Enum value maps for DiscountMode.
+var (
+	DiscountMode_name = map[int32]string{
+		0: "DISCOUNT_MODE_ONE_STEP",
+		1: "DISCOUNT_MODE_CLAMPED",
+		2: "DISCOUNT_MODE_PROPAGATED",
+	}
+	DiscountMode_value = map[string]int32{
+		"DISCOUNT_MODE_ONE_STEP":   0,
+		"DISCOUNT_MODE_CLAMPED":    1,
+		"DISCOUNT_MODE_PROPAGATED": 2,
+	}
+)
+
+func (x DiscountMode) Enum() *DiscountMode {
+	p := new(DiscountMode)
+	*p = x
+	return p
+}
+
+func (x DiscountMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_compute_proto_enumTypes[0].Descriptor()
+}
+
+func (DiscountMode) Type() protoreflect.EnumType {
+	return &file_compute_proto_enumTypes[0]
+}
+
+func (x DiscountMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountMode.Descriptor instead.
+func (DiscountMode) EnumDescriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{0}
+}
+
+// How to handle trust of dangling peers, i.e. peers without outbound trust.
+type DanglingStrategy int32
+
+const (
+	// Distribute it according to the pre-trust (pre-trust teleport).
+	DanglingStrategy_DANGLING_STRATEGY_PRE_TRUST DanglingStrategy = 0
+	// Distribute it equally onto all peers (uniform teleport).
+	DanglingStrategy_DANGLING_STRATEGY_UNIFORM DanglingStrategy = 1
+	// Keep it, as if dangling peers trusted themselves.
+	DanglingStrategy_DANGLING_STRATEGY_SELF_LOOP DanglingStrategy = 2
+	// Redistribute it onto all peers in proportion to their current trust,
+	// in each iteration.
+	DanglingStrategy_DANGLING_STRATEGY_REDISTRIBUTE DanglingStrategy = 3
+)
+
+// Enum value maps for DanglingStrategy.
+var (
+	DanglingStrategy_name = map[int32]string{
+		0: "DANGLING_STRATEGY_PRE_TRUST",
+		1: "DANGLING_STRATEGY_UNIFORM",
+		2: "DANGLING_STRATEGY_SELF_LOOP",
+		3: "DANGLING_STRATEGY_REDISTRIBUTE",
+	}
+	DanglingStrategy_value = map[string]int32{
+		"DANGLING_STRATEGY_PRE_TRUST":    0,
+		"DANGLING_STRATEGY_UNIFORM":      1,
+		"DANGLING_STRATEGY_SELF_LOOP":    2,
+		"DANGLING_STRATEGY_REDISTRIBUTE": 3,
+	}
+)
+
+func (x DanglingStrategy) Enum() *DanglingStrategy {
+	p := new(DanglingStrategy)
+	*p = x
+	return p
+}
+
+func (x DanglingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DanglingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_compute_proto_enumTypes[1].Descriptor()
+}
+
+func (DanglingStrategy) Type() protoreflect.EnumType {
+	return &file_compute_proto_enumTypes[1]
+}
+
+func (x DanglingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DanglingStrategy.Descriptor instead.
+func (DanglingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{1}
+}
+
 type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -50,6 +164,44 @@ type Params struct {
 	Destinations []*trustvector.Destination `protobuf:"bytes,7,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// Positive-only trust vector ID.
 	PositiveGlobalTrustId string `protobuf:"bytes,8,opt,name=positive_global_trust_id,json=positiveGlobalTrustId,proto3" json:"positive_global_trust_id,omitempty"`
+	// How to handle trust of dangling peers (without outbound local trust).
+	DanglingStrategy DanglingStrategy `protobuf:"varint,9,opt,name=dangling_strategy,json=danglingStrategy,proto3,enum=compute.DanglingStrategy" json:"dangling_strategy,omitempty"`
+	// Preprocessing steps to apply in order to the local trust
+	// before canonicalization, e.g. "log", "top-k:100";
+	// see the preprocess compute parameter of the REST API.
+	Preprocess []string `protobuf:"bytes,10,rep,name=preprocess,proto3" json:"preprocess,omitempty"`
+	// How distrust (negative local trust) adjusts global trust.
+	DiscountMode DiscountMode `protobuf:"varint,11,opt,name=discount_mode,json=discountMode,proto3,enum=compute.DiscountMode" json:"discount_mode,omitempty"`
+	// Distrust weight factor; defaults to 1.
+	DistrustWeight *float64 `protobuf:"fixed64,12,opt,name=distrust_weight,json=distrustWeight,proto3,oneof" json:"distrust_weight,omitempty"`
+	// Number of distrust propagation hops, for DISCOUNT_MODE_PROPAGATED;
+	// 0 (default) means 1.
+	DistrustHops uint32 `protobuf:"varint,13,opt,name=distrust_hops,json=distrustHops,proto3" json:"distrust_hops,omitempty"`
+	// Pre-distrust vector ID, i.e. known-bad peers such as confirmed spammers.
+	// If given, their distrust is propagated backward along trust edges
+	// (Anti-TrustRank), and the resulting scores,
+	// scaled by anti_trust_weight, are subtracted from the global trust.
+	PreDistrustId string `protobuf:"bytes,14,opt,name=pre_distrust_id,json=preDistrustId,proto3" json:"pre_distrust_id,omitempty"`
+	// Anti-TrustRank weight factor; defaults to 1.
+	AntiTrustWeight *float64 `protobuf:"fixed64,15,opt,name=anti_trust_weight,json=antiTrustWeight,proto3,oneof" json:"anti_trust_weight,omitempty"`
+	// Per-peer alpha vector ID, overriding alpha for individual peers:
+	// in each iteration, each peer sends this fraction of its trust
+	// back to the pre-trust.  Peers not in this vector use alpha.
+	PeerAlphaId string `protobuf:"bytes,16,opt,name=peer_alpha_id,json=peerAlphaId,proto3" json:"peer_alpha_id,omitempty"`
+	// Additional local trust layers to blend with the local trust
+	// (the first layer, weighted by local_trust_weight).
+	LocalTrustLayers []*LocalTrustLayer `protobuf:"bytes,17,rep,name=local_trust_layers,json=localTrustLayers,proto3" json:"local_trust_layers,omitempty"`
+	// Weight of the local trust when blending it with local_trust_layers;
+	// defaults to 1.
+	LocalTrustWeight *float64 `protobuf:"fixed64,18,opt,name=local_trust_weight,json=localTrustWeight,proto3,oneof" json:"local_trust_weight,omitempty"`
+	// Decay to apply to local trust by the Unix time of each entry,
+	// before canonicalization, e.g. "exp:30d";
+	// see the decay compute parameter of the REST API.
+	// Empty (default) applies no decay.
+	// Decaying local trust without any entry time is an error.
+	Decay string `protobuf:"bytes,19,opt,name=decay,proto3" json:"decay,omitempty"`
+	// Reference Unix time (in seconds) for decay; 0 (default) means now.
+	DecayReference int64 `protobuf:"varint,20,opt,name=decay_reference,json=decayReference,proto3" json:"decay_reference,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -140,6 +292,158 @@ func (x *Params) GetPositiveGlobalTrustId() string {
 	return ""
 }
 
+func (x *Params) GetDanglingStrategy() DanglingStrategy {
+	if x != nil {
+		return x.DanglingStrategy
+	}
+	return DanglingStrategy_DANGLING_STRATEGY_PRE_TRUST
+}
+
+func (x *Params) GetPreprocess() []string {
+	if x != nil {
+		return x.Preprocess
+	}
+	return nil
+}
+
+func (x *Params) GetDiscountMode() DiscountMode {
+	if x != nil {
+		return x.DiscountMode
+	}
+	return DiscountMode_DISCOUNT_MODE_ONE_STEP
+}
+
+func (x *Params) GetDistrustWeight() float64 {
+	if x != nil && x.DistrustWeight != nil {
+		return *x.DistrustWeight
+	}
+	return 0
+}
+
+func (x *Params) GetDistrustHops() uint32 {
+	if x != nil {
+		return x.DistrustHops
+	}
+	return 0
+}
+
+func (x *Params) GetPreDistrustId() string {
+	if x != nil {
+		return x.PreDistrustId
+	}
+	return ""
+}
+
+func (x *Params) GetAntiTrustWeight() float64 {
+	if x != nil && x.AntiTrustWeight != nil {
+		return *x.AntiTrustWeight
+	}
+	return 0
+}
+
+func (x *Params) GetPeerAlphaId() string {
+	if x != nil {
+		return x.PeerAlphaId
+	}
+	return ""
+}
+
+func (x *Params) GetLocalTrustLayers() []*LocalTrustLayer {
+	if x != nil {
+		return x.LocalTrustLayers
+	}
+	return nil
+}
+
+func (x *Params) GetLocalTrustWeight() float64 {
+	if x != nil && x.LocalTrustWeight != nil {
+		return *x.LocalTrustWeight
+	}
+	return 0
+}
+
+func (x *Params) GetDecay() string {
+	if x != nil {
+		return x.Decay
+	}
+	return ""
+}
+
+func (x *Params) GetDecayReference() int64 {
+	if x != nil {
+		return x.DecayReference
+	}
+	return 0
+}
+
+// One local trust layer to blend, e.g. one type of trust signal.
+type LocalTrustLayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Local trust matrix ID.
+	LocalTrustId string `protobuf:"bytes,1,opt,name=local_trust_id,json=localTrustId,proto3" json:"local_trust_id,omitempty"`
+	// Weight of this layer; defaults to 1.
+	Weight *float64 `protobuf:"fixed64,2,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	// Whether to use the values of this layer as they are,
+	// without scaling each truster's values to sum to one.
+	Raw bool `protobuf:"varint,3,opt,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (x *LocalTrustLayer) Reset() {
+	*x = LocalTrustLayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compute_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalTrustLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalTrustLayer) ProtoMessage() {}
+
+func (x *LocalTrustLayer) ProtoReflect() protoreflect.Message {
+	mi := &file_compute_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalTrustLayer.ProtoReflect.Descriptor instead.
+func (*LocalTrustLayer) Descriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LocalTrustLayer) GetLocalTrustId() string {
+	if x != nil {
+		return x.LocalTrustId
+	}
+	return ""
+}
+
+func (x *LocalTrustLayer) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *LocalTrustLayer) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
 // A periodic compute job specification.
 type JobSpec struct {
 	state         protoimpl.MessageState
@@ -179,7 +483,7 @@ type JobSpec struct {
 func (x *JobSpec) Reset() {
 	*x = JobSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[1]
+		mi := &file_compute_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -192,7 +496,7 @@ func (x *JobSpec) String() string {
 func (*JobSpec) ProtoMessage() {}
 
 func (x *JobSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[1]
+	mi := &file_compute_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +509,7 @@ func (x *JobSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobSpec.ProtoReflect.Descriptor instead.
 func (*JobSpec) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{1}
+	return file_compute_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *JobSpec) GetParams() *Params {
@@ -233,7 +537,7 @@ type BasicComputeRequest struct {
 func (x *BasicComputeRequest) Reset() {
 	*x = BasicComputeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[2]
+		mi := &file_compute_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -246,7 +550,7 @@ func (x *BasicComputeRequest) String() string {
 func (*BasicComputeRequest) ProtoMessage() {}
 
 func (x *BasicComputeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[2]
+	mi := &file_compute_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,7 +563,7 @@ func (x *BasicComputeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicComputeRequest.ProtoReflect.Descriptor instead.
 func (*BasicComputeRequest) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{2}
+	return file_compute_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BasicComputeRequest) GetParams() *Params {
@@ -278,7 +582,7 @@ type BasicComputeResponse struct {
 func (x *BasicComputeResponse) Reset() {
 	*x = BasicComputeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[3]
+		mi := &file_compute_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +595,7 @@ func (x *BasicComputeResponse) String() string {
 func (*BasicComputeResponse) ProtoMessage() {}
 
 func (x *BasicComputeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[3]
+	mi := &file_compute_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +608,244 @@ func (x *BasicComputeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicComputeResponse.ProtoReflect.Descriptor instead.
 func (*BasicComputeResponse) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{3}
+	return file_compute_proto_rawDescGZIP(), []int{4}
+}
+
+type SimulateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Compute parameters, as with BasicCompute.
+	// The global trust vector (global_trust_id) serves both as the baseline
+	// and as the starting point; it must hold the result of BasicCompute
+	// with the same parameters and the current inputs,
+	// as it is not recomputed: if it is stale, the reported changes
+	// include those since it was computed.  It is not modified.
+	// positive_global_trust_id and destinations are ignored.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Hypothetical local trust changes.
+	// Each entry sets the local trust from truster to trustee;
+	// 0 removes it.  Later entries override earlier ones.
+	// unix_time is ignored.
+	// The changes apply after blending and decay, if any.
+	LocalTrustChanges []*trustmatrix.Entry `protobuf:"bytes,2,rep,name=local_trust_changes,json=localTrustChanges,proto3" json:"local_trust_changes,omitempty"`
+	// Hypothetical pre-trust changes, as with local_trust_changes.
+	PreTrustChanges []*trustvector.Entry `protobuf:"bytes,3,rep,name=pre_trust_changes,json=preTrustChanges,proto3" json:"pre_trust_changes,omitempty"`
+	// Minimum absolute score change for a peer to be reported;
+	// 0 (default) reports all changed peers.
+	MinChange float64 `protobuf:"fixed64,4,opt,name=min_change,json=minChange,proto3" json:"min_change,omitempty"`
+	// Maximum number of peers to report, largest changes first;
+	// 0 (default): unlimited.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SimulateRequest) Reset() {
+	*x = SimulateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compute_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRequest) ProtoMessage() {}
+
+func (x *SimulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_compute_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRequest) Descriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SimulateRequest) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SimulateRequest) GetLocalTrustChanges() []*trustmatrix.Entry {
+	if x != nil {
+		return x.LocalTrustChanges
+	}
+	return nil
+}
+
+func (x *SimulateRequest) GetPreTrustChanges() []*trustvector.Entry {
+	if x != nil {
+		return x.PreTrustChanges
+	}
+	return nil
+}
+
+func (x *SimulateRequest) GetMinChange() float64 {
+	if x != nil {
+		return x.MinChange
+	}
+	return 0
+}
+
+func (x *SimulateRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// The change in one peer's global trust.
+type ScoreChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peer string `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// Scores before (baseline) and after (simulated) the changes.
+	Before float64 `protobuf:"fixed64,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  float64 `protobuf:"fixed64,3,opt,name=after,proto3" json:"after,omitempty"`
+	// 1-based ranks before and after the changes,
+	// i.e. 1 + the number of peers with a higher score.
+	RankBefore uint64 `protobuf:"varint,4,opt,name=rank_before,json=rankBefore,proto3" json:"rank_before,omitempty"`
+	RankAfter  uint64 `protobuf:"varint,5,opt,name=rank_after,json=rankAfter,proto3" json:"rank_after,omitempty"`
+}
+
+func (x *ScoreChange) Reset() {
+	*x = ScoreChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compute_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreChange) ProtoMessage() {}
+
+func (x *ScoreChange) ProtoReflect() protoreflect.Message {
+	mi := &file_compute_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreChange.ProtoReflect.Descriptor instead.
+func (*ScoreChange) Descriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScoreChange) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *ScoreChange) GetBefore() float64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *ScoreChange) GetAfter() float64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *ScoreChange) GetRankBefore() uint64 {
+	if x != nil {
+		return x.RankBefore
+	}
+	return 0
+}
+
+func (x *ScoreChange) GetRankAfter() uint64 {
+	if x != nil {
+		return x.RankAfter
+	}
+	return 0
+}
+
+type SimulateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Peers whose scores changed, largest changes first.
+	Changes []*ScoreChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Number of peers whose scores changed,
+	// including those left out of changes due to limit.
+	NumChanged uint64 `protobuf:"varint,2,opt,name=num_changed,json=numChanged,proto3" json:"num_changed,omitempty"`
+}
+
+func (x *SimulateResponse) Reset() {
+	*x = SimulateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compute_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateResponse) ProtoMessage() {}
+
+func (x *SimulateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_compute_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateResponse.ProtoReflect.Descriptor instead.
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return file_compute_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SimulateResponse) GetChanges() []*ScoreChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SimulateResponse) GetNumChanged() uint64 {
+	if x != nil {
+		return x.NumChanged
+	}
+	return 0
 }
 
 type CreateJobRequest struct {
@@ -318,7 +859,7 @@ type CreateJobRequest struct {
 func (x *CreateJobRequest) Reset() {
 	*x = CreateJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[4]
+		mi := &file_compute_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +872,7 @@ func (x *CreateJobRequest) String() string {
 func (*CreateJobRequest) ProtoMessage() {}
 
 func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[4]
+	mi := &file_compute_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +885,7 @@ func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
 func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{4}
+	return file_compute_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateJobRequest) GetSpec() *JobSpec {
@@ -365,7 +906,7 @@ type CreateJobResponse struct {
 func (x *CreateJobResponse) Reset() {
 	*x = CreateJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[5]
+		mi := &file_compute_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -378,7 +919,7 @@ func (x *CreateJobResponse) String() string {
 func (*CreateJobResponse) ProtoMessage() {}
 
 func (x *CreateJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[5]
+	mi := &file_compute_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +932,7 @@ func (x *CreateJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJobResponse.ProtoReflect.Descriptor instead.
 func (*CreateJobResponse) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{5}
+	return file_compute_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateJobResponse) GetId() string {
@@ -412,7 +953,7 @@ type DeleteJobRequest struct {
 func (x *DeleteJobRequest) Reset() {
 	*x = DeleteJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[6]
+		mi := &file_compute_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +966,7 @@ func (x *DeleteJobRequest) String() string {
 func (*DeleteJobRequest) ProtoMessage() {}
 
 func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[6]
+	mi := &file_compute_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +979,7 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{6}
+	return file_compute_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteJobRequest) GetId() string {
@@ -457,7 +998,7 @@ type DeleteJobResponse struct {
 func (x *DeleteJobResponse) Reset() {
 	*x = DeleteJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_compute_proto_msgTypes[7]
+		mi := &file_compute_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -470,7 +1011,7 @@ func (x *DeleteJobResponse) String() string {
 func (*DeleteJobResponse) ProtoMessage() {}
 
 func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_compute_proto_msgTypes[7]
+	mi := &file_compute_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,77 +1024,175 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
 func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
-	return file_compute_proto_rawDescGZIP(), []int{7}
+	return file_compute_proto_rawDescGZIP(), []int{11}
 }
 
 var File_compute_proto protoreflect.FileDescriptor
 
 var file_compute_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x1a, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x02, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c,
-	0x70, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
-	0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x70, 0x73,
-	0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x07, 0x65, 0x70,
-	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x74, 0x72, 0x75, 0x73, 0x74, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x70, 0x73,
-	0x69, 0x6c, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x27, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x71, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x51, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3e, 0x0a,
-	0x13, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x07, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x1a, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6d,
+	0x61, 0x74, 0x72, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5,
+	0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52,
+	0x07, 0x65, 0x70, 0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0f, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x11, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x64, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x75, 0x73, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x68, 0x6f, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x11, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x0f, 0x61,
+	0x6e, 0x74, 0x69, 0x54, 0x72, 0x75, 0x73, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x10, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a,
+	0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x10, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x64, 0x65, 0x63, 0x61, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x70,
+	0x73, 0x69, 0x6c, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x61, 0x6e,
+	0x74, 0x69, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x71, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x61, 0x77, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x57, 0x0a, 0x07, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x16, 0x0a,
-	0x14, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
-	0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
-	0x23, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe4, 0x01,
-	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x42, 0x61, 0x73,
-	0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
-	0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
-	0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x63, 0x6f,
-	0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x6b, 0x33, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x67,
-	0x6f, 0x2d, 0x65, 0x69, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x3b,
-	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x71, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x51, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x22, 0x3e, 0x0a, 0x13, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0f, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x6d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x11, 0x70,
+	0x72, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x6b, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x22, 0x63, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x63, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x4d, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x41, 0x47, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x97, 0x01, 0x0a, 0x10, 0x44, 0x61, 0x6e, 0x67, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x41, 0x4e,
+	0x47, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50,
+	0x52, 0x45, 0x5f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41,
+	0x4e, 0x47, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
+	0x55, 0x4e, 0x49, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x41, 0x4e,
+	0x47, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53,
+	0x45, 0x4c, 0x46, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x41,
+	0x4e, 0x47, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
+	0x52, 0x45, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x10, 0x03, 0x32, 0xa7,
+	0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x19, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x6b, 0x33, 0x6c, 0x2e,
+	0x69, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x65, 0x69, 0x67, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x3b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -568,34 +1207,52 @@ func file_compute_proto_rawDescGZIP() []byte {
 	return file_compute_proto_rawDescData
 }
 
-var file_compute_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_compute_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_compute_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_compute_proto_goTypes = []interface{}{
-	(*Params)(nil),                  // 0: compute.Params
-	(*JobSpec)(nil),                 // 1: compute.JobSpec
-	(*BasicComputeRequest)(nil),     // 2: compute.BasicComputeRequest
-	(*BasicComputeResponse)(nil),    // 3: compute.BasicComputeResponse
-	(*CreateJobRequest)(nil),        // 4: compute.CreateJobRequest
-	(*CreateJobResponse)(nil),       // 5: compute.CreateJobResponse
-	(*DeleteJobRequest)(nil),        // 6: compute.DeleteJobRequest
-	(*DeleteJobResponse)(nil),       // 7: compute.DeleteJobResponse
-	(*trustvector.Destination)(nil), // 8: trustvector.Destination
+	(DiscountMode)(0),               // 0: compute.DiscountMode
+	(DanglingStrategy)(0),           // 1: compute.DanglingStrategy
+	(*Params)(nil),                  // 2: compute.Params
+	(*LocalTrustLayer)(nil),         // 3: compute.LocalTrustLayer
+	(*JobSpec)(nil),                 // 4: compute.JobSpec
+	(*BasicComputeRequest)(nil),     // 5: compute.BasicComputeRequest
+	(*BasicComputeResponse)(nil),    // 6: compute.BasicComputeResponse
+	(*SimulateRequest)(nil),         // 7: compute.SimulateRequest
+	(*ScoreChange)(nil),             // 8: compute.ScoreChange
+	(*SimulateResponse)(nil),        // 9: compute.SimulateResponse
+	(*CreateJobRequest)(nil),        // 10: compute.CreateJobRequest
+	(*CreateJobResponse)(nil),       // 11: compute.CreateJobResponse
+	(*DeleteJobRequest)(nil),        // 12: compute.DeleteJobRequest
+	(*DeleteJobResponse)(nil),       // 13: compute.DeleteJobResponse
+	(*trustvector.Destination)(nil), // 14: trustvector.Destination
+	(*trustmatrix.Entry)(nil),       // 15: trustmatrix.Entry
+	(*trustvector.Entry)(nil),       // 16: trustvector.Entry
 }
 var file_compute_proto_depIdxs = []int32{
-	8, // 0: compute.Params.destinations:type_name -> trustvector.Destination
-	0, // 1: compute.JobSpec.params:type_name -> compute.Params
-	0, // 2: compute.BasicComputeRequest.params:type_name -> compute.Params
-	1, // 3: compute.CreateJobRequest.spec:type_name -> compute.JobSpec
-	2, // 4: compute.Service.BasicCompute:input_type -> compute.BasicComputeRequest
-	4, // 5: compute.Service.CreateJob:input_type -> compute.CreateJobRequest
-	6, // 6: compute.Service.DeleteJob:input_type -> compute.DeleteJobRequest
-	3, // 7: compute.Service.BasicCompute:output_type -> compute.BasicComputeResponse
-	5, // 8: compute.Service.CreateJob:output_type -> compute.CreateJobResponse
-	7, // 9: compute.Service.DeleteJob:output_type -> compute.DeleteJobResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	14, // 0: compute.Params.destinations:type_name -> trustvector.Destination
+	1,  // 1: compute.Params.dangling_strategy:type_name -> compute.DanglingStrategy
+	0,  // 2: compute.Params.discount_mode:type_name -> compute.DiscountMode
+	3,  // 3: compute.Params.local_trust_layers:type_name -> compute.LocalTrustLayer
+	2,  // 4: compute.JobSpec.params:type_name -> compute.Params
+	2,  // 5: compute.BasicComputeRequest.params:type_name -> compute.Params
+	2,  // 6: compute.SimulateRequest.params:type_name -> compute.Params
+	15, // 7: compute.SimulateRequest.local_trust_changes:type_name -> trustmatrix.Entry
+	16, // 8: compute.SimulateRequest.pre_trust_changes:type_name -> trustvector.Entry
+	8,  // 9: compute.SimulateResponse.changes:type_name -> compute.ScoreChange
+	4,  // 10: compute.CreateJobRequest.spec:type_name -> compute.JobSpec
+	5,  // 11: compute.Service.BasicCompute:input_type -> compute.BasicComputeRequest
+	7,  // 12: compute.Service.Simulate:input_type -> compute.SimulateRequest
+	10, // 13: compute.Service.CreateJob:input_type -> compute.CreateJobRequest
+	12, // 14: compute.Service.DeleteJob:input_type -> compute.DeleteJobRequest
+	6,  // 15: compute.Service.BasicCompute:output_type -> compute.BasicComputeResponse
+	9,  // 16: compute.Service.Simulate:output_type -> compute.SimulateResponse
+	11, // 17: compute.Service.CreateJob:output_type -> compute.CreateJobResponse
+	13, // 18: compute.Service.DeleteJob:output_type -> compute.DeleteJobResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_compute_proto_init() }
@@ -617,7 +1274,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobSpec); i {
+			switch v := v.(*LocalTrustLayer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -629,7 +1286,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasicComputeRequest); i {
+			switch v := v.(*JobSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -641,7 +1298,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasicComputeResponse); i {
+			switch v := v.(*BasicComputeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -653,7 +1310,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobRequest); i {
+			switch v := v.(*BasicComputeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -665,7 +1322,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobResponse); i {
+			switch v := v.(*SimulateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -677,7 +1334,7 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+			switch v := v.(*ScoreChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -689,6 +1346,54 @@ func file_compute_proto_init() {
 			}
 		}
 		file_compute_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compute_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compute_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compute_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compute_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
@@ -702,18 +1407,20 @@ func file_compute_proto_init() {
 		}
 	}
 	file_compute_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_compute_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_compute_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_compute_proto_goTypes,
 		DependencyIndexes: file_compute_proto_depIdxs,
+		EnumInfos:         file_compute_proto_enumTypes,
 		MessageInfos:      file_compute_proto_msgTypes,
 	}.Build()
 	File_compute_proto = out.File
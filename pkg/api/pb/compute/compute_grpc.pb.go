@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Service_BasicCompute_FullMethodName = "/compute.Service/BasicCompute"
+	Service_Simulate_FullMethodName     = "/compute.Service/Simulate"
 	Service_CreateJob_FullMethodName    = "/compute.Service/CreateJob"
 	Service_DeleteJob_FullMethodName    = "/compute.Service/DeleteJob"
 )
@@ -31,6 +32,10 @@ const (
 type ServiceClient interface {
 	// Perform a basic EigenTrust compute.
 	BasicCompute(ctx context.Context, in *BasicComputeRequest, opts ...grpc.CallOption) (*BasicComputeResponse, error)
+	// Simulate hypothetical local trust and pre-trust changes,
+	// and report the resulting global trust changes,
+	// without modifying the stored local trust, pre-trust, or global trust.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
 	// Create a compute job.
 	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error)
 	// Delete/decommission a compute job.
@@ -54,6 +59,15 @@ func (c *serviceClient) BasicCompute(ctx context.Context, in *BasicComputeReques
 	return out, nil
 }
 
+func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, Service_Simulate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error) {
 	out := new(CreateJobResponse)
 	err := c.cc.Invoke(ctx, Service_CreateJob_FullMethodName, in, out, opts...)
@@ -78,6 +92,10 @@ func (c *serviceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opt
 type ServiceServer interface {
 	// Perform a basic EigenTrust compute.
 	BasicCompute(context.Context, *BasicComputeRequest) (*BasicComputeResponse, error)
+	// Simulate hypothetical local trust and pre-trust changes,
+	// and report the resulting global trust changes,
+	// without modifying the stored local trust, pre-trust, or global trust.
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
 	// Create a compute job.
 	CreateJob(context.Context, *CreateJobRequest) (*CreateJobResponse, error)
 	// Delete/decommission a compute job.
@@ -92,6 +110,9 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) BasicCompute(context.Context, *BasicComputeRequest) (*BasicComputeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BasicCompute not implemented")
 }
+func (UnimplementedServiceServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
 func (UnimplementedServiceServer) CreateJob(context.Context, *CreateJobRequest) (*CreateJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
 }
@@ -129,6 +150,24 @@ func _Service_BasicCompute_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Simulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateJobRequest)
 	if err := dec(in); err != nil {
@@ -176,6 +215,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BasicCompute",
 			Handler:    _Service_BasicCompute_Handler,
 		},
+		{
+			MethodName: "Simulate",
+			Handler:    _Service_Simulate_Handler,
+		},
 		{
 			MethodName: "CreateJob",
 			Handler:    _Service_CreateJob_Handler,
@@ -0,0 +1,50 @@
+package basic
+
+import (
+	"context"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// CanonicalizeReverseLocalTrust canonicalizes localTrust in-place
+// for reverse propagation (see ComputeAntiTrust),
+// i.e. scales each column so that its entries sum to one:
+// each peer passes its distrust onto its trusters
+// in proportion to how much they trust the peer.
+//
+// If a non-nil preDistrust vector is given,
+// CanonicalizeReverseLocalTrust substitutes it for zero columns
+// (peers trusted by no one).
+//
+// localTrust must not have negative entries; see ExtractDistrust.
+// If preDistrust is not nil, it must have the same dimension as localTrust.
+func CanonicalizeReverseLocalTrust(
+	localTrust *sparse.Matrix, preDistrust *sparse.Vector,
+) error {
+	// Reverse propagation runs over the reversed trust graph,
+	// whose transpose is the (untransposed) local trust.
+	return CanonicalizeTransposedLocalTrust(localTrust, preDistrust)
+}
+
+// ComputeAntiTrust computes Anti-TrustRank scores,
+// i.e. propagates distrust from known-bad peers (the pre-distrust, q)
+// backward along trust edges:
+// peers that trust distrusted peers become distrusted themselves.
+//
+// Local trust (c) must have been canonicalized
+// with CanonicalizeReverseLocalTrust, and q with CanonicalizeTrustVector.
+// ComputeAntiTrust does not modify c.
+//
+// Alpha (a) and epsilon (e) are as in Compute,
+// with q in place of the pre-trust;
+// opts are passed to Compute, except for WithTransposedLocalTrust.
+//
+// See WithAntiTrust for combining the result with EigenTrust scores.
+func ComputeAntiTrust(
+	ctx context.Context, c *sparse.Matrix, q *sparse.Vector,
+	a float64, e float64,
+	opts ...ComputeOpt,
+) (*sparse.Vector, error) {
+	opts = append(opts, WithTransposedLocalTrust(c))
+	return Compute(ctx, nil, q, a, e, opts...)
+}
@@ -0,0 +1,60 @@
+package basic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestComputeAntiTrust(t *testing.T) {
+	ctx := context.Background()
+	newLocalTrust := func() *sparse.Matrix {
+		return sparse.NewCSRMatrix(5, 5, []sparse.CooEntry{
+			{Row: 0, Column: 1, Value: 1},
+			{Row: 1, Column: 2, Value: 3},
+			{Row: 1, Column: 4, Value: 1},
+			{Row: 3, Column: 0, Value: 2},
+			{Row: 4, Column: 2, Value: 1},
+		}, false)
+	}
+	// peer 2 is a known spammer
+	q := sparse.NewVector(5, []sparse.Entry{{Index: 2, Value: 1}})
+
+	c := newLocalTrust()
+	if !assert.NoError(t, CanonicalizeReverseLocalTrust(c, q)) {
+		return
+	}
+	got, err := ComputeAntiTrust(ctx, c, q, 0.15, 1e-12)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Same as EigenTrust over the reversed graph.
+	r, err := newLocalTrust().Transpose(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, CanonicalizeLocalTrust(r, q)) {
+		return
+	}
+	want, err := Compute(ctx, r, q, 0.15, 1e-12)
+	if !assert.NoError(t, err) {
+		return
+	}
+	d := &sparse.Vector{}
+	if assert.NoError(t, d.SubVec(got, want)) {
+		assert.Less(t, d.Norm2(), 1e-9)
+	}
+
+	// Distrust decays with distance from the spammer, against trust edges.
+	b := make([]float64, 5)
+	for _, e := range got.Entries {
+		b[e.Index] = e.Value
+	}
+	assert.Greater(t, b[2], b[1])
+	assert.Greater(t, b[1], b[0])
+	assert.Greater(t, b[0], b[3])
+	assert.Greater(t, b[1], b[4]) // peer 1 trusts the spammer more
+}
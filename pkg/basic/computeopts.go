@@ -1,6 +1,9 @@
 package basic
 
-import "k3l.io/go-eigentrust/pkg/sparse"
+import (
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
 
 // ComputeOpts contains options for the Compute function.
 type ComputeOpts struct {
@@ -12,6 +15,12 @@ type ComputeOpts struct {
 	maxIterations  *int
 	minIterations  *int
 	checkFreq      *int
+	numWorkers     int
+	ct             sparse.Operator
+	sparseOpts     []spopt.Option
+	redistribute   bool
+	dangling       DanglingStrategy
+	peerAlphas     *sparse.Vector
 }
 
 // ComputeOpt is one Compute option.
@@ -90,3 +99,79 @@ func WithIterations(n int) ComputeOpt {
 func WithCheckFreq(n int) ComputeOpt {
 	return func(o *ComputeOpts) { o.checkFreq = &n }
 }
+
+// WithNumWorkers tells Compute to use n parallel workers
+// for the matrix-vector multiplication in each iteration.
+//
+// The default (or n <= 0) is runtime.GOMAXPROCS(0).
+func WithNumWorkers(n int) ComputeOpt {
+	return func(o *ComputeOpts) { o.numWorkers = n }
+}
+
+// WithTransposedLocalTrust tells Compute to use the given operator (ct)
+// as the transpose of the canonicalized local trust,
+// instead of transposing the local trust itself.
+//
+// This saves the transpose cost if the caller computes repeatedly
+// over the same local trust, or keeps only its transpose to begin with;
+// in the latter case, the local trust argument to Compute may be nil.
+// ct may also be a *sparse.CompactMatrix.
+// See also CanonicalizeTransposedLocalTrust.
+//
+// Compute does not modify ct.
+func WithTransposedLocalTrust(ct sparse.Operator) ComputeOpt {
+	return func(o *ComputeOpts) { o.ct = ct }
+}
+
+// WithSparseOptions tells Compute to use the given sparse options
+// for the transposed local trust it creates.
+//
+// For example, spopt.Compact makes Compute build the transpose
+// directly as a sparse.CompactMatrix (8 bytes per nonzero entry
+// instead of 16), at a slight loss of precision.
+// The local trust itself stays in full storage;
+// to keep it compact as well, transpose it into a sparse.CompactMatrix
+// and pass that with WithTransposedLocalTrust instead.
+//
+// Compact storage is a library feature;
+// the CLI and the servers always use full storage.
+func WithSparseOptions(opts ...spopt.Option) ComputeOpt {
+	return func(o *ComputeOpts) { o.sparseOpts = append(o.sparseOpts, opts...) }
+}
+
+// WithLeakRedistribution tells Compute to redistribute trust
+// that leaks through dangling peers (peers without outbound local trust)
+// according to the pre-trust, in each iteration.
+//
+// This is equivalent to substituting the pre-trust vector
+// for zero rows in local trust (see CanonicalizeLocalTrust),
+// without materializing the substituted rows,
+// e.g. for local trust canonicalized with CanonicalizeBlockFile.
+func WithLeakRedistribution() ComputeOpt {
+	return func(o *ComputeOpts) { o.redistribute = true }
+}
+
+// WithDanglingStrategy tells Compute how to handle trust
+// that leaks through dangling peers (peers without outbound local trust),
+// in each iteration.
+//
+// It should match the strategy used to canonicalize the local trust
+// (see DanglingAs), as only DanglingUniform and DanglingRedistribute
+// require Compute-time handling; the others are materialized into local trust.
+// DanglingPreTrust (default) leaks trust,
+// unless WithLeakRedistribution is also given.
+func WithDanglingStrategy(s DanglingStrategy) ComputeOpt {
+	return func(o *ComputeOpts) { o.dangling = s }
+}
+
+// WithPeerAlphas tells Compute to use per-peer pre-trust bias (alpha):
+// in each iteration, each peer i sends alphas[i] of its trust
+// back to the pre-trust and passes the rest on along its local trust,
+// e.g. so that newer or less active peers teleport to pre-trust more often.
+//
+// Peers without an entry in alphas use the alpha (a) given to Compute.
+// alphas must have the same dimension as the local trust,
+// and its values must be in [0..1].
+func WithPeerAlphas(alphas *sparse.Vector) ComputeOpt {
+	return func(o *ComputeOpts) { o.peerAlphas = alphas }
+}
@@ -0,0 +1,69 @@
+package basic
+
+import "fmt"
+
+// DanglingStrategy decides how trust flows out of dangling peers,
+// i.e. peers without outbound local trust (zero rows in local trust).
+//
+// Left alone, zero rows leak trust in every iteration of Compute.
+// Some strategies are applied during canonicalization
+// (CanonicalizeLocalTrust and CanonicalizeTransposedLocalTrust, see DanglingAs)
+// and others during Compute (see WithDanglingStrategy),
+// so pass the same strategy to both.
+type DanglingStrategy int
+
+const (
+	// DanglingPreTrust (pre-trust teleport) makes dangling peers
+	// distribute their trust according to the pre-trust,
+	// by substituting the pre-trust vector for zero rows.
+	DanglingPreTrust DanglingStrategy = iota
+
+	// DanglingUniform (uniform teleport) makes dangling peers
+	// distribute their trust equally onto all peers.
+	//
+	// Zero rows are left as they are, instead of being substituted with
+	// dense rows; Compute redistributes the leaked trust in each iteration.
+	DanglingUniform
+
+	// DanglingSelfLoop makes dangling peers keep their trust to themselves,
+	// by substituting a self-loop for zero rows.
+	DanglingSelfLoop
+
+	// DanglingRedistribute leaves zero rows as they are;
+	// Compute redistributes the leaked trust in each iteration
+	// onto all peers in proportion to their current trust,
+	// i.e. renormalizes the trust vector.
+	DanglingRedistribute
+)
+
+// ParseDanglingStrategy returns the dangling strategy with the given name,
+// as returned by DanglingStrategy.String.
+func ParseDanglingStrategy(name string) (DanglingStrategy, error) {
+	switch name {
+	case "pretrust":
+		return DanglingPreTrust, nil
+	case "uniform":
+		return DanglingUniform, nil
+	case "selfloop":
+		return DanglingSelfLoop, nil
+	case "redistribute":
+		return DanglingRedistribute, nil
+	default:
+		return 0, fmt.Errorf("unknown dangling strategy %#v", name)
+	}
+}
+
+func (s DanglingStrategy) String() string {
+	switch s {
+	case DanglingPreTrust:
+		return "pretrust"
+	case DanglingUniform:
+		return "uniform"
+	case DanglingSelfLoop:
+		return "selfloop"
+	case DanglingRedistribute:
+		return "redistribute"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,133 @@
+package basic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// DecayFunc returns the factor, between 0 and 1,
+// by which to scale local trust of the given age; see DecayLocalTrust.
+type DecayFunc func(age time.Duration) float64
+
+// ExponentialDecay returns a DecayFunc that halves local trust
+// every halfLife.
+func ExponentialDecay(halfLife time.Duration) DecayFunc {
+	return func(age time.Duration) float64 {
+		return math.Exp2(-float64(age) / float64(halfLife))
+	}
+}
+
+// LinearDecay returns a DecayFunc that scales local trust linearly
+// from 1 (brand new) down to 0 (window old or older).
+func LinearDecay(window time.Duration) DecayFunc {
+	return func(age time.Duration) float64 {
+		return math.Max(0, 1-float64(age)/float64(window))
+	}
+}
+
+// StepDecay returns a DecayFunc that keeps local trust up to cutoff old
+// and drops older local trust.
+func StepDecay(cutoff time.Duration) DecayFunc {
+	return func(age time.Duration) float64 {
+		if age > cutoff {
+			return 0
+		}
+		return 1
+	}
+}
+
+// DecayLocalTrust scales each local trust value in-place
+// by the decay factor for its age, as of the reference time (now).
+//
+// edgeTimes holds the time of each local trust entry, in Unix seconds,
+// at the same location as the entry;
+// it must have the same orientation as localTrust, e.g. both transposed.
+// Entries without a time (or with a zero time) are left as they are.
+// Entries newer than now are treated as brand new.
+// Entries that decay to zero are dropped.
+func DecayLocalTrust(
+	ctx context.Context, localTrust *sparse.Matrix, edgeTimes *sparse.Matrix,
+	now time.Time, decay DecayFunc,
+) error {
+	nowSec := float64(now.UnixNano()) / 1e9
+	for major, span := range localTrust.Entries {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if major >= len(edgeTimes.Entries) {
+			break
+		}
+		times := edgeTimes.Entries[major]
+		if len(times) == 0 {
+			continue
+		}
+		kept := span[:0]
+		for _, e := range span {
+			for len(times) > 0 && times[0].Index < e.Index {
+				times = times[1:]
+			}
+			if len(times) > 0 && times[0].Index == e.Index &&
+				times[0].Value != 0 {
+				age := math.Max(0, nowSec-times[0].Value)
+				e.Value *= decay(time.Duration(age * 1e9))
+			}
+			if e.Value != 0 {
+				kept = append(kept, e)
+			}
+		}
+		if len(kept) == 0 {
+			kept = nil
+		}
+		localTrust.Entries[major] = kept
+	}
+	return nil
+}
+
+// ParseDecay returns the DecayFunc named by the given spec:
+//
+//   - "exp:HALF-LIFE": ExponentialDecay(HALF-LIFE)
+//   - "linear:WINDOW": LinearDecay(WINDOW)
+//   - "step:CUTOFF": StepDecay(CUTOFF)
+//
+// Durations are as in time.ParseDuration, e.g. "72h",
+// or a number of days with the "d" suffix, e.g. "30d".
+func ParseDecay(spec string) (DecayFunc, error) {
+	name, arg, _ := strings.Cut(spec, ":")
+	var f func(time.Duration) DecayFunc
+	switch name {
+	case "exp":
+		f = ExponentialDecay
+	case "linear":
+		f = LinearDecay
+	case "step":
+		f = StepDecay
+	default:
+		return nil, fmt.Errorf("unknown decay %#v", spec)
+	}
+	d, err := parseDays(arg)
+	if err != nil || d <= 0 {
+		return nil, fmt.Errorf("invalid %#v duration %#v", name, arg)
+	}
+	return f(d), nil
+}
+
+// parseDays parses a duration as time.ParseDuration does,
+// also accepting a number of days with the "d" suffix.
+func parseDays(s string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(s, "d"); found {
+		n, err := strconv.ParseFloat(days, 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
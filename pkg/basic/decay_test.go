@@ -0,0 +1,113 @@
+package basic
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestDecayLocalTrust(t *testing.T) {
+	const day = 86400
+	now := time.Unix(100*day, 0)
+	edgeTimes := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 100 * day}, // brand new
+		{Row: 0, Column: 2, Value: 90 * day},  // 10 days old
+		{Row: 1, Column: 0, Value: 70 * day},  // 30 days old
+		{Row: 1, Column: 2, Value: 101 * day}, // in the future
+		{Row: 2, Column: 1, Value: 40 * day},  // 60 days old
+	}, false)
+	newLocalTrust := func() *sparse.Matrix {
+		return &sparse.Matrix{
+			CSMatrix: sparse.CSMatrix{
+				MajorDim: 3,
+				MinorDim: 3,
+				Entries: [][]sparse.Entry{
+					/* 0 */ {
+						{Index: 0, Value: 8}, // no time
+						{Index: 1, Value: 8},
+						{Index: 2, Value: 8},
+					},
+					/* 1 */ {
+						{Index: 0, Value: -8},
+						{Index: 2, Value: 8},
+					},
+					/* 2 */ {{Index: 1, Value: 8}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name string
+		spec string
+		want [][]sparse.Entry
+	}{
+		{
+			name: "exponential",
+			spec: "exp:10d",
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 8},
+					{Index: 1, Value: 8},
+					{Index: 2, Value: 4},
+				},
+				{{Index: 0, Value: -1}, {Index: 2, Value: 8}},
+				{{Index: 1, Value: 0.125}},
+			},
+		},
+		{
+			name: "linear",
+			spec: "linear:40d",
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 8},
+					{Index: 1, Value: 8},
+					{Index: 2, Value: 6},
+				},
+				{{Index: 0, Value: -2}, {Index: 2, Value: 8}},
+				nil,
+			},
+		},
+		{
+			name: "step",
+			spec: "step:720h",
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 8},
+					{Index: 1, Value: 8},
+					{Index: 2, Value: 8},
+				},
+				{{Index: 0, Value: -8}, {Index: 2, Value: 8}},
+				nil,
+			},
+		},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decay, err := ParseDecay(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseDecay() error = %v", err)
+			}
+			c := newLocalTrust()
+			if err = DecayLocalTrust(ctx, c, edgeTimes, now, decay); err != nil {
+				t.Fatalf("DecayLocalTrust() error = %v", err)
+			}
+			if !reflect.DeepEqual(c.Entries, tt.want) {
+				t.Errorf("DecayLocalTrust() = %v, want %v", c.Entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecay(t *testing.T) {
+	for _, spec := range []string{
+		"bogus:1d", "exp", "exp:", "exp:0", "linear:-1h", "step:x",
+	} {
+		if _, err := ParseDecay(spec); err == nil {
+			t.Errorf("ParseDecay(%#v) succeeded, want error", spec)
+		}
+	}
+}
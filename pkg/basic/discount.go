@@ -0,0 +1,175 @@
+package basic
+
+import (
+	"context"
+	"fmt"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// DiscountMode decides how distrust (negative local trust)
+// adjusts the global trust computed from positive local trust.
+type DiscountMode int
+
+const (
+	// DiscountOneStep subtracts each distruster's distrust, scaled by
+	// their own global trust, from the global trust, exactly once.
+	// Adjusted global trust may be negative.
+	// See DiscountTrustVector.
+	DiscountOneStep DiscountMode = iota
+
+	// DiscountClamped is DiscountOneStep,
+	// followed by clamping negative global trust at zero.
+	DiscountClamped
+
+	// DiscountPropagated propagates distrust through trust:
+	// peers also distrust whom their trusted peers distrust,
+	// in proportion to their local trust, for the given number of hops.
+	// See WithDistrustPropagation.
+	DiscountPropagated
+)
+
+// ParseDiscountMode returns the discount mode with the given name,
+// as returned by DiscountMode.String.
+func ParseDiscountMode(name string) (DiscountMode, error) {
+	switch name {
+	case "onestep":
+		return DiscountOneStep, nil
+	case "clamped":
+		return DiscountClamped, nil
+	case "propagated":
+		return DiscountPropagated, nil
+	default:
+		return 0, fmt.Errorf("unknown discount mode %#v", name)
+	}
+}
+
+func (m DiscountMode) String() string {
+	switch m {
+	case DiscountOneStep:
+		return "onestep"
+	case DiscountClamped:
+		return "clamped"
+	case DiscountPropagated:
+		return "propagated"
+	default:
+		return "unknown"
+	}
+}
+
+// DiscountOpts contains options for the Discount function.
+type DiscountOpts struct {
+	mode   DiscountMode
+	weight *float64
+	ct     sparse.Operator
+	hops   int
+
+	antiTrust       *sparse.Vector
+	antiTrustWeight float64
+}
+
+// DiscountOpt is one Discount option.
+type DiscountOpt func(*DiscountOpts)
+
+// WithDiscountMode tells Discount to use the given mode.
+//
+// Defaults to DiscountOneStep.
+func WithDiscountMode(mode DiscountMode) DiscountOpt {
+	return func(o *DiscountOpts) { o.mode = mode }
+}
+
+// WithDistrustWeight tells Discount to scale all distrust by w,
+// e.g. 2 punishes distrusted peers twice as hard, 0 ignores distrust.
+//
+// Defaults to 1.
+func WithDistrustWeight(w float64) DiscountOpt {
+	return func(o *DiscountOpts) { o.weight = &w }
+}
+
+// WithDistrustPropagation tells Discount (in DiscountPropagated mode)
+// to propagate distrust through the given transposed canonical local trust
+// (ct, as for WithTransposedLocalTrust) for the given number of hops.
+//
+// With one hop, peer i's distrust weighs t[i] (its own global trust)
+// plus the sum of t[j]*c[j][i] over its trusters j.
+// Each additional hop adds another step of trust flow.
+func WithDistrustPropagation(ct sparse.Operator, hops int) DiscountOpt {
+	return func(o *DiscountOpts) { o.ct, o.hops = ct, hops }
+}
+
+// WithAntiTrust tells Discount to also subtract the given
+// Anti-TrustRank scores (b, see ComputeAntiTrust), scaled by weight,
+// from the global trust, after discounting distrust
+// and before clamping (DiscountClamped).
+func WithAntiTrust(b *sparse.Vector, weight float64) DiscountOpt {
+	return func(o *DiscountOpts) { o.antiTrust, o.antiTrustWeight = b, weight }
+}
+
+// Discount adjusts the given global trust vector in-place
+// by the negative trust given in the discounts matrix,
+// according to the options (opts).
+//
+// The caller shall ensure that the discounts matrix is canonicalized.
+func Discount(
+	ctx context.Context, t *sparse.Vector, discounts *sparse.Matrix,
+	opts ...DiscountOpt,
+) error {
+	o := DiscountOpts{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	weights := t.Clone()
+	if o.mode == DiscountPropagated {
+		if o.ct == nil {
+			return fmt.Errorf("missing local trust for distrust propagation")
+		}
+		if o.hops < 1 {
+			return fmt.Errorf("distrust propagation hops=%d must be positive",
+				o.hops)
+		}
+		flow := t.Clone()
+		for hop := 0; hop < o.hops; hop++ {
+			if err := flow.MulVec(ctx, o.ct, flow); err != nil {
+				return err
+			}
+			if err := weights.AddVec(weights, flow); err != nil {
+				return err
+			}
+		}
+	}
+	if o.weight != nil {
+		if *o.weight < 0 {
+			return fmt.Errorf("distrust weight %v is negative", *o.weight)
+		}
+		weights.ScaleVec(*o.weight, weights)
+	}
+	if err := discountTrustVectorBy(t, weights, discounts); err != nil {
+		return err
+	}
+	if o.antiTrust != nil {
+		if o.antiTrustWeight < 0 {
+			return fmt.Errorf("anti-trust weight %v is negative",
+				o.antiTrustWeight)
+		}
+		b := &sparse.Vector{}
+		b.ScaleVec(o.antiTrustWeight, o.antiTrust)
+		if err := t.SubVec(t, b); err != nil {
+			return err
+		}
+	}
+	if o.mode == DiscountClamped {
+		clampAtZero(t)
+	}
+	return nil
+}
+
+// clampAtZero drops negative entries from v.
+func clampAtZero(v *sparse.Vector) {
+	kept := v.Entries[:0]
+	for _, e := range v.Entries {
+		if e.Value > 0 {
+			kept = append(kept, e)
+		}
+	}
+	v.Entries = kept
+}
@@ -0,0 +1,122 @@
+package basic
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestDiscount(t *testing.T) {
+	newTrust := func() *sparse.Vector {
+		return sparse.NewVector(3, []sparse.Entry{
+			{Index: 0, Value: 0.5},
+			{Index: 1, Value: 0.25},
+			{Index: 2, Value: 0.25},
+		})
+	}
+	// peer 0 distrusts peer 2
+	discounts := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 2, Value: 1},
+	}, false)
+	// peers 1 and 2 trust peer 0, peer 0 trusts peer 1
+	ct := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 0, Column: 2, Value: 1},
+		{Row: 1, Column: 0, Value: 1},
+	}, false)
+	tests := []struct {
+		name    string
+		opts    []DiscountOpt
+		want    []sparse.Entry
+		wantErr bool
+	}{
+		{
+			name: "one-step",
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.25},
+				{Index: 2, Value: -0.25},
+			},
+		},
+		{
+			name: "clamped",
+			opts: []DiscountOpt{WithDiscountMode(DiscountClamped)},
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.25},
+			},
+		},
+		{
+			name: "weighted",
+			opts: []DiscountOpt{WithDistrustWeight(0.25)},
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.25},
+				{Index: 2, Value: 0.125},
+			},
+		},
+		{
+			name: "propagated",
+			opts: []DiscountOpt{
+				WithDiscountMode(DiscountPropagated),
+				WithDistrustPropagation(ct, 1),
+			},
+			// peer 0 weighs 0.5 (own) + 0.25 + 0.25 (from trusters 1 and 2)
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.25},
+				{Index: 2, Value: -0.75},
+			},
+		},
+		{
+			name: "propagated two hops",
+			opts: []DiscountOpt{
+				WithDiscountMode(DiscountPropagated),
+				WithDistrustPropagation(ct, 2),
+			},
+			// second hop: peer 0 gets 0.5 (from peer 1, who got it from 0)
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.25},
+				{Index: 2, Value: -1.25},
+			},
+		},
+		{
+			name: "anti-trust",
+			opts: []DiscountOpt{
+				WithDiscountMode(DiscountClamped),
+				WithAntiTrust(sparse.NewVector(3, []sparse.Entry{
+					{Index: 1, Value: 0.5},
+				}), 0.25),
+			},
+			want: []sparse.Entry{
+				{Index: 0, Value: 0.5},
+				{Index: 1, Value: 0.125},
+			},
+		},
+		{
+			name:    "propagated without local trust",
+			opts:    []DiscountOpt{WithDiscountMode(DiscountPropagated)},
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			opts:    []DiscountOpt{WithDistrustWeight(-1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tv := newTrust()
+			err := Discount(context.Background(), tv, discounts, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Discount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(tv.Entries, tt.want) {
+				t.Errorf("Discount() = %v, want %v", tv.Entries, tt.want)
+			}
+		})
+	}
+}
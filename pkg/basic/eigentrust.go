@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"math"
 	"reflect"
-	"runtime"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -96,6 +95,9 @@ type FlatTailChecker struct {
 }
 
 // NewFlatTailChecker creates a new flat tail checker.
+//
+// It watches the ranking of the numLeaders top-ranking peers;
+// 0 means all peers.
 func NewFlatTailChecker(
 	length int, numLeaders int, stats *FlatTailStats, logger *zerolog.Logger,
 ) *FlatTailChecker {
@@ -118,15 +120,11 @@ func NewFlatTailChecker(
 //
 // d is the delta between t and its predecessor.
 func (c *FlatTailChecker) Update(t *sparse.Vector, d float64) {
-	entries := sparse.SortEntriesByValue(
-		append(t.Entries[:0:0], t.Entries...))
+	entries := t.TopK(c.numLeaders)
 	ranking := make([]int, 0, len(entries))
 	for _, entry := range entries {
 		ranking = append(ranking, entry.Index)
 	}
-	if len(ranking) > c.numLeaders {
-		ranking = ranking[:c.numLeaders]
-	}
 	if reflect.DeepEqual(ranking, c.stats.Ranking) {
 		c.stats.Length++
 	} else {
@@ -159,7 +157,8 @@ func (c *FlatTailChecker) Stats() *FlatTailStats {
 // Local trust (c) and pre-trust (p) must have already been canonicalized.
 //
 // Alpha (a) and epsilon (e) are the pre-trust bias and iteration threshold,
-// as defined in the EigenTrust paper.
+// as defined in the EigenTrust paper;
+// WithPeerAlphas overrides alpha for individual peers.
 //
 // Compute accepts options (opts) which modifies its behavior.
 // See their documentation for details.
@@ -186,7 +185,17 @@ func Compute(
 	numLeaders := o.numLeaders
 	logger := zerolog.Ctx(ctx)
 	tm0 := time.Now()
-	n, err := c.Dim()
+	ct := o.ct
+	var n int
+	var err error
+	switch {
+	case ct != nil:
+		n, err = ct.Dim()
+	case c != nil:
+		n, err = c.Dim()
+	default:
+		return nil, errors.New("missing local trust")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +213,12 @@ func Compute(
 	if e <= 0 {
 		return nil, fmt.Errorf("epsilon %#v is not positive", e)
 	}
+	var peerAlphas []float64
+	if o.peerAlphas != nil {
+		if peerAlphas, err = densePeerAlphas(o.peerAlphas, n, a); err != nil {
+			return nil, err
+		}
+	}
 	if numLeaders == 0 {
 		numLeaders = n
 	}
@@ -212,9 +227,11 @@ func Compute(
 	}
 	t1 := t0.Clone()
 
-	ct, err := c.Transpose(ctx)
-	if err != nil {
-		return nil, err
+	if ct == nil {
+		ct, err = sparse.NewTransposedOperator(ctx, c, o.sparseOpts...)
+		if err != nil {
+			return nil, err
+		}
 	}
 	ap := &sparse.Vector{}
 	ap.ScaleVec(a, p)
@@ -245,6 +262,8 @@ func Compute(
 	if minIters <= 0 {
 		return nil, fmt.Errorf("minIters=%d must be at least 1", minIters)
 	}
+	leaky := o.redistribute ||
+		o.dangling == DanglingUniform || o.dangling == DanglingRedistribute
 	convChecker := NewConvergenceChecker(t0, e, logger)
 	flatTailChecker := NewFlatTailChecker(
 		flatTail, numLeaders, o.flatTailStats, logger)
@@ -270,16 +289,32 @@ func Compute(
 				}
 			}
 		}
-		err = t1.MulVec(ctx, ct, t1)
+		var teleport float64
+		if peerAlphas != nil {
+			teleport = dampByPeerAlphas(t1, peerAlphas)
+		}
+		var sum float64
+		if leaky {
+			sum = t1.Sum()
+		}
+		err = t1.MulVec(ctx, ct, t1, sparse.WithNumWorkers(o.numWorkers))
 		if err != nil {
 			return nil, err
 		}
-		t1.ScaleVec(1-a, t1)
+		if leaky {
+			if err = redistributeLeak(t1, sum, p, o.dangling); err != nil {
+				return nil, err
+			}
+		}
+		if peerAlphas != nil {
+			ap.ScaleVec(teleport, p)
+		} else {
+			t1.ScaleVec(1-a, t1)
+		}
 		err = t1.AddVec(t1, ap)
 		if err != nil {
 			return nil, err
 		}
-		runtime.GC()
 	}
 	tm1 = time.Now()
 	durIter, tm0 := tm1.Sub(tm0), tm1
@@ -308,6 +343,74 @@ func Compute(
 	return t, nil
 }
 
+// densePeerAlphas validates and expands the per-peer alphas
+// into n values, using a for peers without an entry.
+func densePeerAlphas(
+	alphas *sparse.Vector, n int, a float64,
+) ([]float64, error) {
+	if alphas.Dim != n {
+		return nil, sparse.ErrDimensionMismatch
+	}
+	dense := make([]float64, n)
+	for i := range dense {
+		dense[i] = a
+	}
+	for _, e := range alphas.Entries {
+		if !(e.Value >= 0 && e.Value <= 1) {
+			return nil, fmt.Errorf("peer %d alpha %#v out of range [0..1]",
+				e.Index, e.Value)
+		}
+		dense[e.Index] = e.Value
+	}
+	return dense, nil
+}
+
+// dampByPeerAlphas scales each peer's trust in t in-place by 1-alphas[i],
+// and returns the total trust thus taken away,
+// i.e. to be teleported to the pre-trust.
+func dampByPeerAlphas(t *sparse.Vector, alphas []float64) float64 {
+	var summer sparse.KBNSummer
+	kept := t.Entries[:0]
+	for _, e := range t.Entries {
+		a := alphas[e.Index]
+		summer.Add(a * e.Value)
+		if e.Value *= 1 - a; e.Value != 0 {
+			kept = append(kept, e)
+		}
+	}
+	t.Entries = kept
+	return summer.Sum()
+}
+
+// redistributeLeak redistributes trust leaked by one iteration
+// (the shortfall of t from sum) according to the dangling strategy:
+// uniformly for DanglingUniform,
+// in proportion to t itself for DanglingRedistribute,
+// and according to the pre-trust (p) otherwise.
+func redistributeLeak(
+	t *sparse.Vector, sum float64, p *sparse.Vector, s DanglingStrategy,
+) error {
+	leak := sum - t.Sum()
+	switch s {
+	case DanglingUniform:
+		share := leak / float64(t.Dim)
+		entries := make([]sparse.Entry, t.Dim)
+		for i := range entries {
+			entries[i] = sparse.Entry{Index: i, Value: share}
+		}
+		return t.AddVec(t, &sparse.Vector{Dim: t.Dim, Entries: entries})
+	case DanglingRedistribute:
+		if remaining := sum - leak; remaining != 0 {
+			t.ScaleVec(sum/remaining, t)
+		}
+		return nil
+	default:
+		leaked := &sparse.Vector{}
+		leaked.ScaleVec(leak, p)
+		return t.AddVec(t, leaked)
+	}
+}
+
 // DiscountTrustVector adjusts the given global trust vector
 // by the negative trust given in the discounts vector.
 //
@@ -318,8 +421,16 @@ func Compute(
 // The caller shall ensure that the discounts vector is canonicalized.
 func DiscountTrustVector(t *sparse.Vector, discounts *sparse.Matrix) error {
 	// t is adjusted in place, so take the unadjusted clone for discount weight.
+	return discountTrustVectorBy(t, t.Clone(), discounts)
+}
+
+// discountTrustVectorBy adjusts the given global trust vector
+// by the negative trust given in the discounts vector,
+// scaling non-zero discount rows with the distruster's weight in t1.
+func discountTrustVectorBy(
+	t *sparse.Vector, t1 *sparse.Vector, discounts *sparse.Matrix,
+) error {
 	i1 := 0
-	t1 := t.Clone()
 	// find distrusters with nonzero reps in t1 by merge-matching
 DiscountsLoop:
 	for distruster, distrusts := range discounts.Entries {
@@ -1,10 +1,16 @@
 package basic
 
 import (
+	"context"
+	"math"
+	"math/rand"
+	"path/filepath"
 	"testing"
 
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
 )
 
 func TestDiscountTrustVector(t *testing.T) {
@@ -79,3 +85,336 @@ func TestDiscountTrustVector(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatTailChecker(t *testing.T) {
+	var stats FlatTailStats
+	c := NewFlatTailChecker(2, 2, &stats, &zerolog.Logger{})
+	t1 := sparse.NewVector(4, []sparse.Entry{
+		{Index: 0, Value: 0.1}, {Index: 1, Value: 0.4},
+		{Index: 2, Value: 0.3}, {Index: 3, Value: 0.2},
+	})
+	// Only the leaders (1, 2) matter; the tail (3, 0) is reshuffled.
+	t2 := sparse.NewVector(4, []sparse.Entry{
+		{Index: 0, Value: 0.2}, {Index: 1, Value: 0.4},
+		{Index: 2, Value: 0.3}, {Index: 3, Value: 0.1},
+	})
+	c.Update(t1, 0.5)
+	assert.Equal(t, []int{1, 2}, stats.Ranking)
+	assert.False(t, c.Reached())
+	c.Update(t2, 0.25)
+	c.Update(t1, 0.125)
+	assert.Equal(t, 2, stats.Length)
+	assert.Equal(t, 0.5, stats.DeltaNorm)
+	assert.True(t, c.Reached())
+}
+
+func TestCompute_WithTransposedLocalTrust(t *testing.T) {
+	ctx := context.Background()
+	c := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 1, Column: 0, Value: 0.5},
+		{Row: 1, Column: 2, Value: 0.5},
+		{Row: 2, Column: 0, Value: 1},
+	}, false)
+	p := sparse.NewVector(3, []sparse.Entry{{Index: 0, Value: 1}})
+	want, err := Compute(ctx, c, p, 0.1, 1e-9)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ct, err := c.Transpose(ctx)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got, err := Compute(ctx, nil, p, 0.1, 1e-9, WithTransposedLocalTrust(ct))
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, got)
+	}
+	_, err = Compute(ctx, nil, p, 0.1, 1e-9)
+	assert.Error(t, err)
+}
+
+// TestCompute_Compact compares the accuracy of the compact storage path
+// (float32 values, float64 accumulation) against the float64 path.
+//
+// Canonicalized local trust values are in [0..1] and get rounded to float32,
+// i.e. each carries a relative error of at most 2^-24 (~6e-8).
+// Because every iteration accumulates in float64 and the trust vector
+// itself stays in float64, the rounding errors do not compound
+// across iterations; the resulting global trust differs from the float64
+// path by about the same relative magnitude.
+// On the random graph below, the L1 difference is well within 1e-6
+// (observed ~2e-8) and the per-peer relative difference within 1e-6
+// (observed ~8e-8), i.e. comparable to float32 rounding itself.
+func TestCompute_Compact(t *testing.T) {
+	const (
+		n            = 2000
+		edgesPerPeer = 10
+	)
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(42))
+	var entries []sparse.CooEntry
+	for i := 0; i < n; i++ {
+		for k := 0; k < edgesPerPeer; k++ {
+			entries = append(entries, sparse.CooEntry{
+				Row:    i,
+				Column: rng.Intn(n),
+				Value:  rng.ExpFloat64(),
+			})
+		}
+	}
+	newLocalTrust := func() *sparse.Matrix {
+		c, err := sparse.NewCSRMatrixFromEntries(ctx, entries,
+			spopt.FixedDim(n, n), spopt.SumDuplicates)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		p := sparse.NewVector(n, nil)
+		CanonicalizeTrustVector(p)
+		if !assert.NoError(t, CanonicalizeLocalTrust(c, p)) {
+			t.FailNow()
+		}
+		return c
+	}
+	p := sparse.NewVector(n, []sparse.Entry{{Index: 0, Value: 1}})
+	want, err := Compute(ctx, newLocalTrust(), p, 0.1, 1e-12)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got, err := Compute(ctx, newLocalTrust(), p, 0.1, 1e-12,
+		WithSparseOptions(spopt.Compact))
+	if !assert.NoError(t, err) {
+		return
+	}
+	d := &sparse.Vector{}
+	if !assert.NoError(t, d.SubVec(got, want)) {
+		return
+	}
+	var l1, maxRel float64
+	for _, e := range d.Entries {
+		l1 += math.Abs(e.Value)
+	}
+	wantDense := make([]float64, n)
+	for _, e := range want.Entries {
+		wantDense[e.Index] = e.Value
+	}
+	for _, e := range d.Entries {
+		if w := wantDense[e.Index]; w != 0 {
+			maxRel = max(maxRel, math.Abs(e.Value/w))
+		}
+	}
+	t.Logf("L1 difference %g, max relative difference %g", l1, maxRel)
+	assert.Less(t, l1, 1e-6)
+	assert.Less(t, maxRel, 1e-6)
+}
+
+func TestCompute_BlockFile(t *testing.T) {
+	const n = 500
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(7))
+	var entries []sparse.CooEntry
+	for i := 0; i < n; i++ {
+		if i%10 == 0 {
+			continue // dangling
+		}
+		for k := 0; k < 5; k++ {
+			entries = append(entries, sparse.CooEntry{
+				Row:    i,
+				Column: rng.Intn(n),
+				Value:  rng.Float64(),
+			})
+		}
+	}
+	p := sparse.NewVector(n, []sparse.Entry{
+		{Index: 1, Value: 0.5}, {Index: 2, Value: 0.5},
+	})
+
+	c, err := sparse.NewCSRMatrixFromEntries(ctx, entries,
+		spopt.FixedDim(n, n), spopt.SumDuplicates)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, CanonicalizeLocalTrust(c, p)) {
+		return
+	}
+	want, err := Compute(ctx, c, p, 0.2, 1e-12)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "localtrust.blk")
+	ch := make(chan sparse.CooEntry, len(entries))
+	for _, e := range entries {
+		ch <- e
+	}
+	close(ch)
+	err = sparse.BuildBlockFile(ctx, path, ch,
+		spopt.FixedDim(n, n), spopt.SumDuplicates, spopt.ColumnMajor)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ct, err := sparse.OpenBlockFile(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = ct.Close() }()
+	if !assert.NoError(t, CanonicalizeBlockFile(ctx, ct)) {
+		return
+	}
+	got, err := Compute(ctx, nil, p, 0.2, 1e-12,
+		WithTransposedLocalTrust(ct), WithLeakRedistribution())
+	if !assert.NoError(t, err) {
+		return
+	}
+	d := &sparse.Vector{}
+	if assert.NoError(t, d.SubVec(got, want)) {
+		assert.Less(t, d.Norm2(), 1e-9)
+	}
+}
+
+func TestCompute_Dangling(t *testing.T) {
+	const n = 300
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(11))
+	var entries []sparse.CooEntry
+	for i := 0; i < n; i++ {
+		if i%3 == 0 {
+			continue // dangling
+		}
+		for k := 0; k < 4; k++ {
+			entries = append(entries, sparse.CooEntry{
+				Row:    i,
+				Column: rng.Intn(n),
+				Value:  rng.Float64(),
+			})
+		}
+	}
+	newLocalTrust := func() *sparse.Matrix {
+		c, err := sparse.NewCSRMatrixFromEntries(ctx, entries,
+			spopt.FixedDim(n, n), spopt.SumDuplicates)
+		if err != nil {
+			t.Fatalf("NewCSRMatrixFromEntries() error = %v", err)
+		}
+		return c
+	}
+	p := sparse.NewVector(n, []sparse.Entry{
+		{Index: 1, Value: 0.5}, {Index: 2, Value: 0.5},
+	})
+
+	t.Run("uniform", func(t *testing.T) {
+		// Uniform teleport is pre-trust teleport with uniform substitute rows.
+		uniform := make([]sparse.Entry, n)
+		for i := range uniform {
+			uniform[i] = sparse.Entry{Index: i, Value: 1.0 / n}
+		}
+		c := newLocalTrust()
+		err := CanonicalizeLocalTrust(c, sparse.NewVector(n, uniform))
+		if !assert.NoError(t, err) {
+			return
+		}
+		want, err := Compute(ctx, c, p, 0.2, 1e-12)
+		if !assert.NoError(t, err) {
+			return
+		}
+		c = newLocalTrust()
+		err = CanonicalizeLocalTrust(c, p, DanglingAs(DanglingUniform))
+		if !assert.NoError(t, err) {
+			return
+		}
+		got, err := Compute(ctx, c, p, 0.2, 1e-12,
+			WithDanglingStrategy(DanglingUniform))
+		if !assert.NoError(t, err) {
+			return
+		}
+		d := &sparse.Vector{}
+		if assert.NoError(t, d.SubVec(got, want)) {
+			assert.Less(t, d.Norm2(), 1e-9)
+		}
+	})
+
+	t.Run("redistribute", func(t *testing.T) {
+		c := newLocalTrust()
+		err := CanonicalizeLocalTrust(c, p, DanglingAs(DanglingRedistribute))
+		if !assert.NoError(t, err) {
+			return
+		}
+		leaky, err := Compute(ctx, c, p, 0.2, 1e-12)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Less(t, leaky.Sum(), 0.99)
+		got, err := Compute(ctx, c, p, 0.2, 1e-12,
+			WithDanglingStrategy(DanglingRedistribute))
+		if assert.NoError(t, err) {
+			assert.InDelta(t, 1, got.Sum(), 1e-9)
+		}
+	})
+}
+
+func TestCompute_WithPeerAlphas(t *testing.T) {
+	ctx := context.Background()
+	c := sparse.NewCSRMatrix(4, 4, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 1, Column: 0, Value: 0.5},
+		{Row: 1, Column: 2, Value: 0.5},
+		{Row: 2, Column: 3, Value: 1},
+		{Row: 3, Column: 0, Value: 1},
+	}, false)
+	p := sparse.NewVector(4, []sparse.Entry{{Index: 0, Value: 1}})
+
+	t.Run("uniform", func(t *testing.T) {
+		want, err := Compute(ctx, c, p, 0.2, 1e-12)
+		if !assert.NoError(t, err) {
+			return
+		}
+		alphas := sparse.NewVector(4, []sparse.Entry{
+			{Index: 1, Value: 0.2}, {Index: 3, Value: 0.2},
+		})
+		got, err := Compute(ctx, c, p, 0.2, 1e-12, WithPeerAlphas(alphas))
+		if !assert.NoError(t, err) {
+			return
+		}
+		d := &sparse.Vector{}
+		if assert.NoError(t, d.SubVec(got, want)) {
+			assert.Less(t, d.Norm2(), 1e-9)
+		}
+	})
+
+	t.Run("fixed point", func(t *testing.T) {
+		alphas := sparse.NewVector(4, []sparse.Entry{
+			{Index: 2, Value: 0.9}, {Index: 3, Value: 0},
+		})
+		got, err := Compute(ctx, c, p, 0.2, 1e-12, WithPeerAlphas(alphas))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.InDelta(t, 1, got.Sum(), 1e-9)
+		// t = C^T((1-α)∘t) + (α·t)p
+		a := []float64{0.2, 0.2, 0.9, 0}
+		tv := make([]float64, 4)
+		for _, e := range got.Entries {
+			tv[e.Index] = e.Value
+		}
+		var teleport float64
+		for i := range tv {
+			teleport += a[i] * tv[i]
+		}
+		want := []float64{
+			teleport + 0.5*(1-a[1])*tv[1] + (1-a[3])*tv[3],
+			(1 - a[0]) * tv[0],
+			0.5 * (1 - a[1]) * tv[1],
+			(1 - a[2]) * tv[2],
+		}
+		assert.InDeltaSlice(t, want, tv, 1e-9)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, alphas := range []*sparse.Vector{
+			sparse.NewVector(3, nil),
+			sparse.NewVector(4, []sparse.Entry{{Index: 1, Value: 1.5}}),
+			sparse.NewVector(4, []sparse.Entry{{Index: 1, Value: -0.1}}),
+		} {
+			_, err := Compute(ctx, c, p, 0.2, 1e-12, WithPeerAlphas(alphas))
+			assert.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,322 @@
+package basic
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// Contribution is one peer's contribution to (or deduction from)
+// another peer's global trust.
+type Contribution struct {
+	Peer  int     `json:"peer"`
+	Value float64 `json:"value"`
+}
+
+// TrustPath is a chain of local trust from a pre-trusted peer.
+type TrustPath struct {
+	// Peers lists the peers along the path,
+	// from the pre-trusted peer to the explained peer.
+	Peers []int `json:"peers"`
+
+	// Strength is the pre-trust of the first peer
+	// times the local trust along the path,
+	// i.e. the probability that a random walk from pre-trust follows it.
+	Strength float64 `json:"strength"`
+}
+
+// Explanation breaks down a peer's global trust; see Explain.
+type Explanation struct {
+	// Peer is the explained peer.
+	Peer int `json:"peer"`
+
+	// Score is the global trust of the peer, as given to Explain.
+	Score float64 `json:"score"`
+
+	// Inbound lists the top contributors, i.e. peers i
+	// with the largest (1-a)·t_i·c_ij terms, in descending order.
+	Inbound []Contribution `json:"inbound"`
+
+	// InboundTotal is the sum of all inbound contributions,
+	// including those not listed in Inbound.
+	InboundTotal float64 `json:"inboundTotal"`
+
+	// PreTrust is the share of the score teleported from pre-trust,
+	// i.e. a·p_j for the total trust.
+	PreTrust float64 `json:"preTrust"`
+
+	// Distrust lists the top distrusters, i.e. peers i
+	// with the largest t_i·d_ij deductions, as in DiscountTrustVector,
+	// in descending order.
+	// Empty unless WithDistrust is given.
+	Distrust []Contribution `json:"distrust"`
+
+	// DistrustTotal is the sum of all distrust deductions,
+	// including those not listed in Distrust.
+	DistrustTotal float64 `json:"distrustTotal"`
+
+	// Paths lists the strongest trust paths from pre-trusted peers,
+	// in descending order of strength.
+	Paths []TrustPath `json:"paths"`
+}
+
+// ExplainOpts contains options for the Explain function.
+type ExplainOpts struct {
+	topContributors int
+	topPaths        int
+	maxPathLength   int
+	discounts       *sparse.Matrix
+	peerAlphas      *sparse.Vector
+}
+
+// ExplainOpt is one Explain option.
+type ExplainOpt func(*ExplainOpts)
+
+// WithTopContributors tells Explain to list n top contributors
+// (and distrusters); 0 lists all.
+//
+// Defaults to 10.
+func WithTopContributors(n int) ExplainOpt {
+	return func(o *ExplainOpts) { o.topContributors = n }
+}
+
+// WithTopPaths tells Explain to find k strongest trust paths;
+// 0 finds none.
+//
+// Defaults to 3.
+func WithTopPaths(k int) ExplainOpt {
+	return func(o *ExplainOpts) { o.topPaths = k }
+}
+
+// WithMaxPathLength tells Explain to consider trust paths
+// of up to the given number of hops.
+//
+// Defaults to 6.
+func WithMaxPathLength(hops int) ExplainOpt {
+	return func(o *ExplainOpts) { o.maxPathLength = hops }
+}
+
+// WithDistrust tells Explain to also break down distrust deductions,
+// given the canonicalized discounts matrix as passed to Discount.
+func WithDistrust(discounts *sparse.Matrix) ExplainOpt {
+	return func(o *ExplainOpts) { o.discounts = discounts }
+}
+
+// WithExplainedPeerAlphas tells Explain the per-peer alphas
+// that were passed to Compute with WithPeerAlphas.
+func WithExplainedPeerAlphas(alphas *sparse.Vector) ExplainOpt {
+	return func(o *ExplainOpts) { o.peerAlphas = alphas }
+}
+
+// maxPathSearchSteps caps the number of partial paths Explain examines,
+// so that paths in large, dense graphs do not take forever
+// or run out of memory, e.g. when the pre-trust substituted for
+// the local trust of many dangling peers lists many peers.
+// Explain returns the paths found by then.
+const maxPathSearchSteps = 1_000_000
+
+// Explain breaks down the global trust (t) of the given peer,
+// as computed by Compute with the given local trust (c), pre-trust (p),
+// and alpha (a).
+//
+// c and p must be canonicalized, as passed to Compute,
+// with trusters in rows.
+// t is the result of Compute, before Discount.
+//
+// At convergence, Score = InboundTotal + PreTrust
+// (give or take the leak redistributed by some DanglingStrategy),
+// and Discount in DiscountOneStep mode subtracts DistrustTotal.
+func Explain(
+	ctx context.Context, c *sparse.Matrix, p *sparse.Vector, t *sparse.Vector,
+	a float64, peer int, opts ...ExplainOpt,
+) (*Explanation, error) {
+	o := ExplainOpts{topContributors: 10, topPaths: 3, maxPathLength: 6}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	n, err := c.Dim()
+	if err != nil {
+		return nil, err
+	}
+	if p.Dim != n || t.Dim != n {
+		return nil, sparse.ErrDimensionMismatch
+	}
+	if peer < 0 || peer >= n {
+		return nil, fmt.Errorf("peer %d out of range [0..%d)", peer, n)
+	}
+	if o.topContributors < 0 || o.topPaths < 0 || o.maxPathLength < 0 {
+		return nil, fmt.Errorf("negative explain limit")
+	}
+	alphas := make([]float64, n)
+	for i := range alphas {
+		alphas[i] = a
+	}
+	if o.peerAlphas != nil {
+		if alphas, err = densePeerAlphas(o.peerAlphas, n, a); err != nil {
+			return nil, err
+		}
+	}
+	x := &Explanation{Peer: peer, Score: entryAt(t.Entries, peer)}
+	var inbound, teleport sparse.KBNSummer
+	for _, e := range t.Entries {
+		teleport.Add(alphas[e.Index] * e.Value)
+		if cij := entryAt(c.Entries[e.Index], peer); cij != 0 {
+			value := (1 - alphas[e.Index]) * e.Value * cij
+			inbound.Add(value)
+			x.Inbound = append(x.Inbound, Contribution{e.Index, value})
+		}
+	}
+	x.Inbound = topContributions(x.Inbound, o.topContributors)
+	x.InboundTotal = inbound.Sum()
+	x.PreTrust = teleport.Sum() * entryAt(p.Entries, peer)
+	if o.discounts != nil {
+		var distrust sparse.KBNSummer
+		for _, e := range t.Entries {
+			if e.Index >= len(o.discounts.Entries) {
+				break
+			}
+			if dij := entryAt(o.discounts.Entries[e.Index], peer); dij != 0 {
+				value := e.Value * dij
+				distrust.Add(value)
+				x.Distrust = append(x.Distrust, Contribution{e.Index, value})
+			}
+		}
+		x.Distrust = topContributions(x.Distrust, o.topContributors)
+		x.DistrustTotal = distrust.Sum()
+	}
+	x.Paths, err = strongestPaths(ctx, c, p, peer, o.topPaths, o.maxPathLength,
+		maxPathSearchSteps)
+	if err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// entryAt returns the value at the given index of the sorted span,
+// or 0 if not found.
+func entryAt(span []sparse.Entry, index int) float64 {
+	i := sort.Search(len(span), func(i int) bool {
+		return span[i].Index >= index
+	})
+	if i < len(span) && span[i].Index == index {
+		return span[i].Value
+	}
+	return 0
+}
+
+// topContributions sorts the given contributions in descending order
+// and returns the top n of them (all if n is 0).
+func topContributions(contribs []Contribution, n int) []Contribution {
+	slices.SortStableFunc(contribs, func(c1, c2 Contribution) int {
+		return cmp.Compare(c2.Value, c1.Value)
+	})
+	if n != 0 && len(contribs) > n {
+		contribs = contribs[:n]
+	}
+	return contribs
+}
+
+// partialPath is a trust path being searched,
+// linked backward to its prefix.
+type partialPath struct {
+	peer     int
+	prev     *partialPath
+	hops     int
+	strength float64
+}
+
+func (pp *partialPath) visits(peer int) bool {
+	for ; pp != nil; pp = pp.prev {
+		if pp.peer == peer {
+			return true
+		}
+	}
+	return false
+}
+
+func (pp *partialPath) peers() []int {
+	peers := make([]int, pp.hops+1)
+	for i := pp.hops; pp != nil; i, pp = i-1, pp.prev {
+		peers[i] = pp.peer
+	}
+	return peers
+}
+
+// partialPathHeap is a max-heap of partial paths by strength.
+type partialPathHeap []*partialPath
+
+func (h partialPathHeap) Len() int           { return len(h) }
+func (h partialPathHeap) Less(i, j int) bool { return h[i].strength > h[j].strength }
+func (h partialPathHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *partialPathHeap) Push(x any)        { *h = append(*h, x.(*partialPath)) }
+func (h *partialPathHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// strongestPaths finds the k strongest acyclic trust paths
+// of up to maxHops from pre-trusted peers to the target peer.
+//
+// Extending a path never makes it stronger,
+// so a best-first search finds paths in descending order of strength.
+// The search creates at most maxPaths partial paths (besides those
+// starting at pre-trusted peers), which bounds both its time and memory.
+func strongestPaths(
+	ctx context.Context, c *sparse.Matrix, p *sparse.Vector,
+	target int, k int, maxHops int, maxPaths int,
+) ([]TrustPath, error) {
+	if k == 0 {
+		return nil, nil
+	}
+	h := &partialPathHeap{}
+	for _, e := range p.Entries {
+		if e.Value > 0 {
+			*h = append(*h, &partialPath{peer: e.Index, strength: e.Value})
+		}
+	}
+	heap.Init(h)
+	var paths []TrustPath
+	created := 0
+	for steps := 0; h.Len() > 0 && len(paths) < k; steps++ {
+		if steps%1024 == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		pp := heap.Pop(h).(*partialPath)
+		if pp.peer == target {
+			paths = append(paths, TrustPath{
+				Peers: pp.peers(), Strength: pp.strength,
+			})
+			continue
+		}
+		if pp.hops == maxHops || created >= maxPaths {
+			continue
+		}
+		for _, e := range c.Entries[pp.peer] {
+			if created >= maxPaths {
+				break
+			}
+			if e.Value <= 0 || pp.visits(e.Index) {
+				continue
+			}
+			created++
+			heap.Push(h, &partialPath{
+				peer:     e.Index,
+				prev:     pp,
+				hops:     pp.hops + 1,
+				strength: pp.strength * e.Value,
+			})
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,97 @@
+package basic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	// 0 trusts 1 (mostly) and 2, 1 trusts 2, 2 trusts 0; 0 is pre-trusted.
+	c := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 0.75},
+		{Row: 0, Column: 2, Value: 0.25},
+		{Row: 1, Column: 2, Value: 1},
+		{Row: 2, Column: 0, Value: 1},
+	}, false)
+	p := sparse.NewVector(3, []sparse.Entry{{Index: 0, Value: 1}})
+	// 1 distrusts 0.
+	discounts := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 1, Column: 0, Value: 1},
+	}, false)
+	const a = 0.2
+	tv, err := Compute(ctx, c, p, a, 1e-12)
+	if !assert.NoError(t, err) {
+		return
+	}
+	t0, t1 := entryAt(tv.Entries, 0), entryAt(tv.Entries, 1)
+
+	x, err := Explain(ctx, c, p, tv, a, 2,
+		WithTopContributors(1), WithDistrust(discounts))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.InDelta(t, x.Score, x.InboundTotal+x.PreTrust, 1e-9)
+	assert.Equal(t, 0.0, x.PreTrust)
+	assert.Equal(t, []Contribution{{Peer: 1, Value: (1 - a) * t1}}, x.Inbound)
+	assert.InDelta(t, (1-a)*(0.25*t0+t1), x.InboundTotal, 1e-12)
+	assert.Empty(t, x.Distrust)
+	assert.Equal(t, []TrustPath{
+		{Peers: []int{0, 1, 2}, Strength: 0.75},
+		{Peers: []int{0, 2}, Strength: 0.25},
+	}, x.Paths)
+
+	x, err = Explain(ctx, c, p, tv, a, 0,
+		WithDistrust(discounts), WithMaxPathLength(2))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.InDelta(t, x.Score, x.InboundTotal+x.PreTrust, 1e-9)
+	assert.InDelta(t, a, x.PreTrust, 1e-12)
+	assert.Equal(t, []Contribution{{Peer: 1, Value: t1}}, x.Distrust)
+	assert.Equal(t, t1, x.DistrustTotal)
+	// Only the pre-trusted peer itself;
+	// cycles through it are not paths, and 0-1-2-0 is too long anyway.
+	assert.Equal(t, []TrustPath{{Peers: []int{0}, Strength: 1}}, x.Paths)
+
+	_, err = Explain(ctx, c, p, tv, a, 3)
+	assert.Error(t, err)
+}
+
+func TestExplain_ManyDanglingPeers(t *testing.T) {
+	ctx := context.Background()
+	// Only 0 trusts anyone (1); everyone else is dangling,
+	// so canonicalization gives them the dense pre-trust as local trust.
+	// Nobody trusts the target (n-1), so the path search never ends early.
+	const n = 1000
+	target := n - 1
+	c := sparse.NewCSRMatrix(n, n, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+	}, false)
+	var entries []sparse.Entry
+	for i := 0; i < target; i++ {
+		entries = append(entries, sparse.Entry{Index: i, Value: 1})
+	}
+	p := sparse.NewVector(n, entries)
+	CanonicalizeTrustVector(p)
+	if !assert.NoError(t, CanonicalizeLocalTrust(c, p)) {
+		return
+	}
+	x, err := Explain(ctx, c, p, p, 0.5, target)
+	if assert.NoError(t, err) {
+		assert.Empty(t, x.Paths)
+	}
+	// Without budget for partial paths, only pre-trusted peers are paths.
+	paths, err := strongestPaths(ctx, c, p, 1, 3, 6, 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []TrustPath{{Peers: []int{1}, Strength: 1.0 / (n - 1)}},
+			paths)
+	}
+	paths, err = strongestPaths(ctx, c, p, 1, 3, 6, n)
+	if assert.NoError(t, err) {
+		assert.Len(t, paths, 3)
+	}
+}
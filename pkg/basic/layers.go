@@ -0,0 +1,94 @@
+package basic
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// LocalTrustLayer is one layer of local trust, i.e. one type of trust signal
+// such as follows or payments, to blend with others; see BlendLocalTrust.
+type LocalTrustLayer struct {
+	// LocalTrust is the local trust of this layer.
+	// It may contain distrust (negative values).
+	LocalTrust *sparse.Matrix
+
+	// Weight is the (non-negative) factor for this layer.
+	Weight float64
+
+	// Raw, if true, uses LocalTrust values as they are.
+	// By default, each truster's row is first scaled
+	// so that the absolute values sum to one,
+	// so that layers on different scales contribute by Weight only.
+	Raw bool
+}
+
+// BlendLocalTrust returns the weighted sum of the given local trust layers.
+//
+// The result has the largest dimension of the layers;
+// smaller layers are treated as if grown.
+// Entries that cancel out to zero are dropped.
+// The layers are not modified.
+//
+// The result is not canonicalized; see CanonicalizeLocalTrust.
+func BlendLocalTrust(
+	ctx context.Context, layers ...LocalTrustLayer,
+) (*sparse.Matrix, error) {
+	n := 0
+	for i, layer := range layers {
+		if !(layer.Weight >= 0) || math.IsInf(layer.Weight, 1) {
+			return nil, fmt.Errorf("layer %d weight %#v is invalid",
+				i, layer.Weight)
+		}
+		dim, err := layer.LocalTrust.Dim()
+		if err != nil {
+			return nil, fmt.Errorf("layer %d: %w", i, err)
+		}
+		n = max(n, dim)
+	}
+	blended := &sparse.Matrix{CSMatrix: sparse.CSMatrix{
+		MajorDim: n, MinorDim: n, Entries: make([][]sparse.Entry, n),
+	}}
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		row := &sparse.Vector{Dim: n}
+		for _, layer := range layers {
+			if i >= len(layer.LocalTrust.Entries) || layer.Weight == 0 {
+				continue
+			}
+			span := layer.LocalTrust.Entries[i]
+			if len(span) == 0 {
+				continue
+			}
+			scale := layer.Weight
+			if !layer.Raw {
+				var summer sparse.KBNSummer
+				for _, e := range span {
+					summer.Add(math.Abs(e.Value))
+				}
+				scale /= summer.Sum()
+			}
+			scaled := &sparse.Vector{}
+			scaled.ScaleVec(scale, &sparse.Vector{Dim: n, Entries: span})
+			if err := row.AddVec(row, scaled); err != nil {
+				return nil, err
+			}
+		}
+		kept := row.Entries[:0]
+		for _, e := range row.Entries {
+			if e.Value != 0 {
+				kept = append(kept, e)
+			}
+		}
+		if len(kept) != 0 {
+			blended.Entries[i] = kept
+		}
+	}
+	return blended, nil
+}
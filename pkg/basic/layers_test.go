@@ -0,0 +1,116 @@
+package basic
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestBlendLocalTrust(t *testing.T) {
+	follows := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 0, Column: 2, Value: 3},
+		{Row: 1, Column: 0, Value: 2},
+	}, false)
+	payments := &sparse.Matrix{
+		CSMatrix: sparse.CSMatrix{
+			MajorDim: 4,
+			MinorDim: 4,
+			Entries: [][]sparse.Entry{
+				/* 0 */ {
+					{Index: 1, Value: 300},
+					{Index: 2, Value: -100},
+				},
+				/* 1 */ nil,
+				/* 2 */ nil,
+				/* 3 */ {{Index: 0, Value: 50}},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		layers  []LocalTrustLayer
+		want    [][]sparse.Entry
+		wantErr bool
+	}{
+		{
+			name: "canonicalized",
+			layers: []LocalTrustLayer{
+				{LocalTrust: follows, Weight: 1},
+				{LocalTrust: payments, Weight: 2},
+			},
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 0.25 + 1.5}, {Index: 2, Value: 0.75 - 0.5}},
+				{{Index: 0, Value: 1}},
+				nil,
+				{{Index: 0, Value: 2}},
+			},
+		},
+		{
+			name: "raw",
+			layers: []LocalTrustLayer{
+				{LocalTrust: follows, Weight: 100, Raw: true},
+				{LocalTrust: payments, Weight: 1, Raw: true},
+			},
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 400}, {Index: 2, Value: 200}},
+				{{Index: 0, Value: 200}},
+				nil,
+				{{Index: 0, Value: 50}},
+			},
+		},
+		{
+			name: "cancel out",
+			layers: []LocalTrustLayer{
+				{LocalTrust: follows, Weight: 0.5},
+				{LocalTrust: payments, Weight: 1.5},
+			},
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 0.125 + 1.125}},
+				{{Index: 0, Value: 0.5}},
+				nil,
+				{{Index: 0, Value: 1.5}},
+			},
+		},
+		{
+			name: "zero weight",
+			layers: []LocalTrustLayer{
+				{LocalTrust: follows, Weight: 0},
+				{LocalTrust: payments, Weight: 1, Raw: true},
+			},
+			want: payments.Entries,
+		},
+		{
+			name: "negative weight",
+			layers: []LocalTrustLayer{
+				{LocalTrust: follows, Weight: -1},
+			},
+			wantErr: true,
+		},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BlendLocalTrust(ctx, tt.layers...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BlendLocalTrust() error = %v, wantErr %v",
+					err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("BlendLocalTrust() = %v, want %v",
+					got.Entries, tt.want)
+			}
+		})
+	}
+	// Layers must be left intact.
+	if !reflect.DeepEqual(follows.Entries[0], []sparse.Entry{
+		{Index: 1, Value: 1}, {Index: 2, Value: 3},
+	}) {
+		t.Errorf("BlendLocalTrust() modified a layer: %v", follows.Entries)
+	}
+}
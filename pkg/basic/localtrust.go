@@ -1,23 +1,47 @@
 package basic
 
 import (
+	"context"
 	"errors"
+	"slices"
 
 	"k3l.io/go-eigentrust/pkg/sparse"
 )
 
+// CanonicalizeOpts contains options for local trust canonicalization.
+type CanonicalizeOpts struct {
+	dangling DanglingStrategy
+}
+
+// CanonicalizeOpt is one local trust canonicalization option.
+type CanonicalizeOpt func(*CanonicalizeOpts)
+
+// DanglingAs tells canonicalization to handle zero rows
+// (dangling peers) using the given strategy; see DanglingStrategy.
+//
+// Defaults to DanglingPreTrust.
+func DanglingAs(s DanglingStrategy) CanonicalizeOpt {
+	return func(o *CanonicalizeOpts) { o.dangling = s }
+}
+
 // CanonicalizeLocalTrust canonicalizes localTrust in-place,
 // i.e. scales each row so that its entries sum to one.
 //
-// If a non-nil preTrust vector is given,
+// By default, if a non-nil preTrust vector is given,
 // CanonicalizeLocalTrust substitutes it for zero rows in localTrust,
 // i.e. the preTrust vector serves as the default outbound trust
 // for peers without trust opinions.
+// DanglingAs selects another strategy.
 //
 // If preTrust is not nil, it must have the same dimension as localTrust.
 func CanonicalizeLocalTrust(
 	localTrust *sparse.Matrix, preTrust *sparse.Vector,
+	opts ...CanonicalizeOpt,
 ) error {
+	o := CanonicalizeOpts{}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	n, err := localTrust.Dim()
 	if err != nil {
 		return err
@@ -31,8 +55,12 @@ func CanonicalizeLocalTrust(
 		switch {
 		case err == nil:
 		case errors.Is(err, sparse.ErrZeroSum):
-			if preTrust != nil {
+			switch {
+			case o.dangling == DanglingPreTrust && preTrust != nil:
 				localTrust.SetRowVector(i, preTrust)
+			case o.dangling == DanglingSelfLoop:
+				localTrust.SetRowVector(i, sparse.NewVector(n,
+					[]sparse.Entry{{Index: i, Value: 1}}))
 			}
 		default:
 			return err
@@ -41,6 +69,121 @@ func CanonicalizeLocalTrust(
 	return nil
 }
 
+// CanonicalizeTransposedLocalTrust canonicalizes the transposed local trust
+// (ct) in-place, i.e. scales each column so that its entries sum to one.
+//
+// The result is the same as canonicalizing the local trust
+// with CanonicalizeLocalTrust and then transposing it,
+// which lets callers that keep ct around skip the transpose,
+// e.g. with WithTransposedLocalTrust.
+//
+// By default, if a non-nil preTrust vector is given,
+// CanonicalizeTransposedLocalTrust substitutes it for zero columns in ct.
+// DanglingAs selects another strategy.
+//
+// If preTrust is not nil, it must have the same dimension as ct.
+func CanonicalizeTransposedLocalTrust(
+	ct *sparse.Matrix, preTrust *sparse.Vector, opts ...CanonicalizeOpt,
+) error {
+	o := CanonicalizeOpts{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	n, err := ct.Dim()
+	if err != nil {
+		return err
+	}
+	if preTrust != nil && n != preTrust.Dim {
+		return sparse.ErrDimensionMismatch
+	}
+	summers := make([]sparse.KBNSummer, n)
+	for _, span := range ct.Entries {
+		for _, e := range span {
+			summers[e.Index].Add(e.Value)
+		}
+	}
+	sums := make([]float64, n)
+	dangling := make([]bool, n)
+	var danglingEntries []sparse.Entry
+	for i := range summers {
+		sums[i] = summers[i].Sum()
+		if sums[i] == 0 {
+			dangling[i] = true
+			danglingEntries = append(danglingEntries, sparse.Entry{Index: i})
+		}
+	}
+	usePreTrust := o.dangling == DanglingPreTrust && preTrust != nil
+	substitute := usePreTrust || o.dangling == DanglingSelfLoop
+	for j, span := range ct.Entries {
+		zeros := 0
+		for k, e := range span {
+			switch {
+			case !dangling[e.Index]:
+				span[k-zeros] = sparse.Entry{
+					Index: e.Index, Value: e.Value / sums[e.Index],
+				}
+			case substitute:
+				zeros++ // to be substituted below
+			}
+		}
+		if zeros > 0 {
+			ct.Entries[j] = span[:len(span)-zeros]
+		}
+	}
+	if o.dangling == DanglingSelfLoop {
+		for _, de := range danglingEntries {
+			i := de.Index
+			span := ct.Entries[i]
+			k, _ := slices.BinarySearchFunc(span, i,
+				func(e sparse.Entry, i int) int { return e.Index - i })
+			ct.Entries[i] = slices.Insert(span, k,
+				sparse.Entry{Index: i, Value: 1})
+		}
+		return nil
+	}
+	if !usePreTrust || len(danglingEntries) == 0 {
+		return nil
+	}
+	for _, pe := range preTrust.Entries {
+		for k := range danglingEntries {
+			danglingEntries[k].Value = pe.Value
+		}
+		row := ct.RowVector(pe.Index)
+		if err = row.AddVec(row, &sparse.Vector{
+			Dim:     n,
+			Entries: danglingEntries,
+		}); err != nil {
+			return err
+		}
+		ct.SetRowVector(pe.Index, row)
+	}
+	return nil
+}
+
+// CanonicalizeBlockFile canonicalizes the transposed local trust
+// stored in a block file (ct),
+// i.e. makes ct scale each column so that its entries sum to one.
+//
+// ct is not modified on disk; see sparse.BlockFile.ScaleMinor.
+// Zero columns stay zero;
+// use WithLeakRedistribution to substitute pre-trust for them,
+// or WithDanglingStrategy for other strategies computed without materializing
+// (DanglingUniform and DanglingRedistribute).
+// Negative (distrust) entries are not supported.
+func CanonicalizeBlockFile(ctx context.Context, ct *sparse.BlockFile) error {
+	sums, err := ct.MinorSums(ctx)
+	if err != nil {
+		return err
+	}
+	for i, sum := range sums {
+		if sum != 0 {
+			sums[i] = 1 / sum
+		}
+	}
+	ct.ScaleMinor(sums)
+	return nil
+}
+
 // ExtractDistrust extracts negative local trust from the given
 // local trust, leaving only positive ones in the original.
 // Extracted negative values are sign reversed, i.e. they are positive.
@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -81,3 +82,104 @@ func TestExtractDistrust(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalizeTransposedLocalTrust(t *testing.T) {
+	newLocalTrust := func() *sparse.Matrix {
+		return sparse.NewCSRMatrix(4, 4, []sparse.CooEntry{
+			{Row: 0, Column: 1, Value: 1},
+			{Row: 0, Column: 2, Value: 3},
+			{Row: 1, Column: 0, Value: 2},
+			// row 2 is dangling
+			{Row: 3, Column: 0, Value: 5},
+			{Row: 3, Column: 2, Value: 5},
+			{Row: 3, Column: 3, Value: 10},
+		}, false)
+	}
+	preTrust := sparse.NewVector(4, []sparse.Entry{
+		{Index: 1, Value: 0.25},
+		{Index: 2, Value: 0.75},
+	})
+	strategies := []DanglingStrategy{
+		DanglingPreTrust, DanglingUniform, DanglingSelfLoop,
+		DanglingRedistribute,
+	}
+	for _, s := range strategies {
+		for _, p := range []*sparse.Vector{nil, preTrust} {
+			c := newLocalTrust()
+			if err := CanonicalizeLocalTrust(c, p, DanglingAs(s)); err != nil {
+				t.Fatalf("CanonicalizeLocalTrust() error = %v", err)
+			}
+			want, err := c.Transpose(context.Background())
+			if err != nil {
+				t.Fatalf("Transpose() error = %v", err)
+			}
+			ct, err := newLocalTrust().Transpose(context.Background())
+			if err != nil {
+				t.Fatalf("Transpose() error = %v", err)
+			}
+			err = CanonicalizeTransposedLocalTrust(ct, p, DanglingAs(s))
+			if err != nil {
+				t.Fatalf("CanonicalizeTransposedLocalTrust() error = %v", err)
+			}
+			if !reflect.DeepEqual(ct.Entries, want.Entries) {
+				t.Errorf("CanonicalizeTransposedLocalTrust(ct, %v, %v) "+
+					"= %v, want %v", p, s, ct.Entries, want.Entries)
+			}
+		}
+	}
+}
+
+func TestCanonicalizeLocalTrust_Dangling(t *testing.T) {
+	preTrust := sparse.NewVector(3, []sparse.Entry{{Index: 0, Value: 1}})
+	tests := []struct {
+		name     string
+		strategy DanglingStrategy
+		want     []sparse.Entry
+	}{
+		{"pretrust", DanglingPreTrust, []sparse.Entry{{Index: 0, Value: 1}}},
+		{"uniform", DanglingUniform, nil},
+		{"selfloop", DanglingSelfLoop, []sparse.Entry{{Index: 1, Value: 1}}},
+		{"redistribute", DanglingRedistribute, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+				{Row: 0, Column: 1, Value: 2},
+				{Row: 0, Column: 2, Value: 2},
+				// row 1 is dangling
+				{Row: 2, Column: 0, Value: 3},
+			}, false)
+			err := CanonicalizeLocalTrust(c, preTrust, DanglingAs(tt.strategy))
+			if err != nil {
+				t.Fatalf("CanonicalizeLocalTrust() error = %v", err)
+			}
+			if got := c.Entries[1]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CanonicalizeLocalTrust() dangling row = %v, want %v",
+					got, tt.want)
+			}
+			want0 := []sparse.Entry{
+				{Index: 1, Value: 0.5}, {Index: 2, Value: 0.5},
+			}
+			if got := c.Entries[0]; !reflect.DeepEqual(got, want0) {
+				t.Errorf("CanonicalizeLocalTrust() row 0 = %v, want %v",
+					got, want0)
+			}
+		})
+	}
+}
+
+func TestParseDanglingStrategy(t *testing.T) {
+	for _, s := range []DanglingStrategy{
+		DanglingPreTrust, DanglingUniform, DanglingSelfLoop,
+		DanglingRedistribute,
+	} {
+		got, err := ParseDanglingStrategy(s.String())
+		if err != nil || got != s {
+			t.Errorf("ParseDanglingStrategy(%#v) = %v, %v; want %v",
+				s.String(), got, err, s)
+		}
+	}
+	if _, err := ParseDanglingStrategy("bogus"); err == nil {
+		t.Errorf("ParseDanglingStrategy(\"bogus\") succeeded, want error")
+	}
+}
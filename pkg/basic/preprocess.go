@@ -0,0 +1,359 @@
+package basic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// PreprocessStep transforms local trust in-place before canonicalization.
+//
+// If transposed is true, localTrust is the transpose of the local trust,
+// i.e. trusters are columns, e.g. one for WithTransposedLocalTrust.
+//
+// Steps assume non-negative local trust;
+// extract distrust first, with ExtractDistrust.
+type PreprocessStep func(
+	ctx context.Context, localTrust *sparse.Matrix, transposed bool,
+) error
+
+// PreprocessLocalTrust applies the given preprocessing steps in order
+// to localTrust (or its transpose if transposed is true) in-place.
+func PreprocessLocalTrust(
+	ctx context.Context, localTrust *sparse.Matrix, transposed bool,
+	steps ...PreprocessStep,
+) error {
+	for _, step := range steps {
+		if err := step(ctx, localTrust, transposed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapEntries replaces each entry of m with f(major, e),
+// dropping it if f returns false.
+func mapEntries(
+	ctx context.Context, m *sparse.Matrix,
+	f func(major int, e sparse.Entry) (sparse.Entry, bool),
+) error {
+	for major, span := range m.Entries {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		kept := span[:0]
+		for _, e := range span {
+			if e, ok := f(major, e); ok {
+				kept = append(kept, e)
+			}
+		}
+		if len(kept) == 0 {
+			kept = nil
+		}
+		m.Entries[major] = kept
+	}
+	return nil
+}
+
+// mapTrusterRows replaces each truster's outbound local trust with f(span),
+// transposing back and forth if transposed.
+// f may reorder or shrink span in-place, but must return it sorted by index.
+func mapTrusterRows(
+	ctx context.Context, m *sparse.Matrix, transposed bool,
+	f func(span []sparse.Entry) []sparse.Entry,
+) error {
+	rows := m
+	if transposed {
+		var err error
+		if rows, err = m.Transpose(ctx); err != nil {
+			return err
+		}
+	}
+	for i, span := range rows.Entries {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if len(span) != 0 {
+			rows.Entries[i] = f(span)
+		}
+	}
+	if transposed {
+		return rows.TransposeInto(ctx, &m.CSMatrix)
+	}
+	return nil
+}
+
+// sortByValueDesc sorts entries in descending order of value,
+// breaking ties by ascending index.
+func sortByValueDesc(span []sparse.Entry) {
+	slices.SortFunc(span, func(a, b sparse.Entry) int {
+		switch {
+		case a.Value > b.Value:
+			return -1
+		case a.Value < b.Value:
+			return 1
+		default:
+			return a.Index - b.Index
+		}
+	})
+}
+
+func sortByIndex(span []sparse.Entry) {
+	slices.SortFunc(span, func(a, b sparse.Entry) int {
+		return a.Index - b.Index
+	})
+}
+
+// LogScale is a PreprocessStep that dampens local trust values
+// by replacing each value v with log(1+v).
+func LogScale(
+	ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+) error {
+	return mapEntries(ctx, localTrust, func(
+		_ int, e sparse.Entry,
+	) (sparse.Entry, bool) {
+		e.Value = math.Log1p(e.Value)
+		return e, true
+	})
+}
+
+// SqrtScale is a PreprocessStep that dampens local trust values
+// by replacing each value with its square root.
+func SqrtScale(
+	ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+) error {
+	return mapEntries(ctx, localTrust, func(
+		_ int, e sparse.Entry,
+	) (sparse.Entry, bool) {
+		e.Value = math.Sqrt(e.Value)
+		return e, true
+	})
+}
+
+// CapEdges returns a PreprocessStep that caps each local trust value at max.
+func CapEdges(max float64) PreprocessStep {
+	return func(
+		ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+	) error {
+		return mapEntries(ctx, localTrust, func(
+			_ int, e sparse.Entry,
+		) (sparse.Entry, bool) {
+			e.Value = math.Min(e.Value, max)
+			return e, true
+		})
+	}
+}
+
+// Threshold returns a PreprocessStep that drops local trust values below min.
+func Threshold(min float64) PreprocessStep {
+	return func(
+		ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+	) error {
+		return mapEntries(ctx, localTrust, func(
+			_ int, e sparse.Entry,
+		) (sparse.Entry, bool) {
+			return e, e.Value >= min
+		})
+	}
+}
+
+// TopK returns a PreprocessStep that keeps only the k largest
+// outbound local trust values of each truster,
+// breaking ties in favor of lower trustee indices.
+func TopK(k int) PreprocessStep {
+	return func(
+		ctx context.Context, localTrust *sparse.Matrix, transposed bool,
+	) error {
+		return mapTrusterRows(ctx, localTrust, transposed, func(
+			span []sparse.Entry,
+		) []sparse.Entry {
+			if len(span) <= k {
+				return span
+			}
+			sortByValueDesc(span)
+			span = span[:k]
+			sortByIndex(span)
+			return span
+		})
+	}
+}
+
+// RankWeights is a PreprocessStep that replaces each truster's
+// outbound local trust values with weights based upon their rank:
+// 1 for the largest, 1/2 for the second largest, and so on.
+// Ties are ranked in favor of lower trustee indices.
+//
+// This keeps only the truster's order of preference,
+// discarding the magnitudes.
+func RankWeights(
+	ctx context.Context, localTrust *sparse.Matrix, transposed bool,
+) error {
+	return mapTrusterRows(ctx, localTrust, transposed, func(
+		span []sparse.Entry,
+	) []sparse.Entry {
+		sortByValueDesc(span)
+		for rank := range span {
+			span[rank].Value = 1 / float64(rank+1)
+		}
+		sortByIndex(span)
+		return span
+	})
+}
+
+// RemoveSelfLoops is a PreprocessStep that drops self-trust.
+func RemoveSelfLoops(
+	ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+) error {
+	return mapEntries(ctx, localTrust, func(
+		major int, e sparse.Entry,
+	) (sparse.Entry, bool) {
+		return e, e.Index != major
+	})
+}
+
+// DiscountReciprocity returns a PreprocessStep that scales
+// reciprocated local trust, i.e. i→j where j→i also exists, by factor,
+// e.g. to dampen mutual trust rings.  Self-trust is not affected.
+func DiscountReciprocity(factor float64) PreprocessStep {
+	return func(
+		ctx context.Context, localTrust *sparse.Matrix, _ /*transposed*/ bool,
+	) error {
+		// Decide first, as discounting with factor=0 drops entries.
+		reciprocated := make([][]bool, len(localTrust.Entries))
+		for i, span := range localTrust.Entries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			for k, e := range span {
+				j := e.Index
+				if j == i || j >= len(localTrust.Entries) {
+					continue
+				}
+				_, found := slices.BinarySearchFunc(localTrust.Entries[j], i,
+					func(e sparse.Entry, i int) int { return e.Index - i })
+				if found {
+					if reciprocated[i] == nil {
+						reciprocated[i] = make([]bool, len(span))
+					}
+					reciprocated[i][k] = true
+				}
+			}
+		}
+		for i, span := range localTrust.Entries {
+			if reciprocated[i] == nil {
+				continue
+			}
+			kept := span[:0]
+			for k, e := range span {
+				if reciprocated[i][k] {
+					e.Value *= factor
+				}
+				if e.Value != 0 {
+					kept = append(kept, e)
+				}
+			}
+			if len(kept) == 0 {
+				kept = nil
+			}
+			localTrust.Entries[i] = kept
+		}
+		return nil
+	}
+}
+
+// ParsePreprocessStep returns the PreprocessStep named by the given spec:
+//
+//   - "log": LogScale
+//   - "sqrt": SqrtScale
+//   - "cap:MAX": CapEdges(MAX)
+//   - "threshold:MIN": Threshold(MIN)
+//   - "top-k:K": TopK(K)
+//   - "rank": RankWeights
+//   - "no-self-loops": RemoveSelfLoops
+//   - "reciprocity:FACTOR": DiscountReciprocity(FACTOR)
+func ParsePreprocessStep(spec string) (PreprocessStep, error) {
+	name, arg, hasArg := strings.Cut(spec, ":")
+	parseFloat := func() (float64, error) {
+		if !hasArg {
+			return 0, fmt.Errorf("preprocessing step %#v needs an argument",
+				name)
+		}
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil || math.IsNaN(v) || v < 0 {
+			return 0, fmt.Errorf("invalid %#v argument %#v", name, arg)
+		}
+		return v, nil
+	}
+	switch name {
+	case "log", "sqrt", "rank", "no-self-loops":
+		if hasArg {
+			return nil, fmt.Errorf("preprocessing step %#v takes no argument",
+				name)
+		}
+	}
+	switch name {
+	case "log":
+		return LogScale, nil
+	case "sqrt":
+		return SqrtScale, nil
+	case "cap":
+		max, err := parseFloat()
+		if err != nil {
+			return nil, err
+		}
+		return CapEdges(max), nil
+	case "threshold":
+		min, err := parseFloat()
+		if err != nil {
+			return nil, err
+		}
+		return Threshold(min), nil
+	case "top-k":
+		k, err := strconv.Atoi(arg)
+		if err != nil || k < 1 {
+			return nil, fmt.Errorf("invalid %#v argument %#v", name, arg)
+		}
+		return TopK(k), nil
+	case "rank":
+		return RankWeights, nil
+	case "no-self-loops":
+		return RemoveSelfLoops, nil
+	case "reciprocity":
+		factor, err := parseFloat()
+		if err != nil {
+			return nil, err
+		}
+		if factor > 1 {
+			return nil, fmt.Errorf("reciprocity factor %v out of range [0..1]",
+				factor)
+		}
+		return DiscountReciprocity(factor), nil
+	default:
+		return nil, fmt.Errorf("unknown preprocessing step %#v", spec)
+	}
+}
+
+// ParsePreprocessSteps returns the preprocessing steps
+// named by the given specs; see ParsePreprocessStep.
+func ParsePreprocessSteps(specs ...string) ([]PreprocessStep, error) {
+	steps := make([]PreprocessStep, 0, len(specs))
+	for _, spec := range specs {
+		step, err := ParsePreprocessStep(spec)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
@@ -0,0 +1,163 @@
+package basic
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestPreprocessLocalTrust(t *testing.T) {
+	newLocalTrust := func() *sparse.Matrix {
+		return sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+			{Row: 0, Column: 0, Value: 5},
+			{Row: 0, Column: 1, Value: 3},
+			{Row: 0, Column: 2, Value: 8},
+			{Row: 1, Column: 0, Value: 1},
+			{Row: 2, Column: 1, Value: 4},
+		}, false)
+	}
+	tests := []struct {
+		name  string
+		specs []string
+		want  [][]sparse.Entry
+	}{
+		{
+			name:  "sqrt",
+			specs: []string{"sqrt"},
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: math.Sqrt(5)},
+					{Index: 1, Value: math.Sqrt(3)},
+					{Index: 2, Value: math.Sqrt(8)},
+				},
+				{{Index: 0, Value: 1}},
+				{{Index: 1, Value: 2}},
+			},
+		},
+		{
+			name:  "log",
+			specs: []string{"log"},
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: math.Log(6)},
+					{Index: 1, Value: math.Log(4)},
+					{Index: 2, Value: math.Log(9)},
+				},
+				{{Index: 0, Value: math.Log(2)}},
+				{{Index: 1, Value: math.Log(5)}},
+			},
+		},
+		{
+			name:  "cap and threshold",
+			specs: []string{"cap:4", "threshold:2"},
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 4},
+					{Index: 1, Value: 3},
+					{Index: 2, Value: 4},
+				},
+				nil,
+				{{Index: 1, Value: 4}},
+			},
+		},
+		{
+			name:  "top-k",
+			specs: []string{"top-k:2"},
+			want: [][]sparse.Entry{
+				{{Index: 0, Value: 5}, {Index: 2, Value: 8}},
+				{{Index: 0, Value: 1}},
+				{{Index: 1, Value: 4}},
+			},
+		},
+		{
+			name:  "rank",
+			specs: []string{"rank"},
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 1. / 2},
+					{Index: 1, Value: 1. / 3},
+					{Index: 2, Value: 1},
+				},
+				{{Index: 0, Value: 1}},
+				{{Index: 1, Value: 1}},
+			},
+		},
+		{
+			name:  "no self-loops then rank",
+			specs: []string{"no-self-loops", "rank"},
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 1. / 2}, {Index: 2, Value: 1}},
+				{{Index: 0, Value: 1}},
+				{{Index: 1, Value: 1}},
+			},
+		},
+		{
+			name:  "reciprocity",
+			specs: []string{"reciprocity:0.5"},
+			want: [][]sparse.Entry{
+				{
+					{Index: 0, Value: 5},
+					{Index: 1, Value: 1.5},
+					{Index: 2, Value: 8},
+				},
+				{{Index: 0, Value: 0.5}},
+				{{Index: 1, Value: 4}},
+			},
+		},
+		{
+			name:  "reciprocity drop",
+			specs: []string{"reciprocity:0"},
+			want: [][]sparse.Entry{
+				{{Index: 0, Value: 5}, {Index: 2, Value: 8}},
+				nil,
+				{{Index: 1, Value: 4}},
+			},
+		},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, err := ParsePreprocessSteps(tt.specs...)
+			if err != nil {
+				t.Fatalf("ParsePreprocessSteps() error = %v", err)
+			}
+			c := newLocalTrust()
+			if err = PreprocessLocalTrust(ctx, c, false, steps...); err != nil {
+				t.Fatalf("PreprocessLocalTrust() error = %v", err)
+			}
+			if !reflect.DeepEqual(c.Entries, tt.want) {
+				t.Errorf("PreprocessLocalTrust() = %v, want %v",
+					c.Entries, tt.want)
+			}
+			ct, err := newLocalTrust().Transpose(ctx)
+			if err != nil {
+				t.Fatalf("Transpose() error = %v", err)
+			}
+			if err = PreprocessLocalTrust(ctx, ct, true, steps...); err != nil {
+				t.Fatalf("PreprocessLocalTrust(transposed) error = %v", err)
+			}
+			got, err := ct.Transpose(ctx)
+			if err != nil {
+				t.Fatalf("Transpose() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("PreprocessLocalTrust(transposed) = %v, want %v",
+					got.Entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePreprocessStep(t *testing.T) {
+	for _, spec := range []string{
+		"bogus", "log:1", "cap", "cap:x", "threshold:-1", "top-k:0",
+		"reciprocity:2",
+	} {
+		if _, err := ParsePreprocessStep(spec); err == nil {
+			t.Errorf("ParsePreprocessStep(%#v) succeeded, want error", spec)
+		}
+	}
+}
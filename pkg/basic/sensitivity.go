@@ -0,0 +1,261 @@
+package basic
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// EdgeInfluence is the influence of one local trust edge
+// on a peer's global trust; see AnalyzeSensitivity.
+type EdgeInfluence struct {
+	Truster int `json:"truster"`
+	Trustee int `json:"trustee"`
+
+	// Value is the (canonicalized) local trust c_ij of the edge.
+	Value float64 `json:"value"`
+
+	// Sensitivity is the partial derivative ∂t_k/∂c_ij
+	// of the peer's global trust t_k,
+	// with the rest of the local trust held fixed.
+	Sensitivity float64 `json:"sensitivity"`
+
+	// Influence is the first-order change in t_k
+	// if the truster's raw local trust in the trustee were scaled up
+	// by a factor of (1+ε), divided by ε,
+	// with the truster's local trust re-canonicalized.
+	// Positive influence means the edge lifts t_k
+	// compared to the truster's other edges; negative, the opposite.
+	Influence float64 `json:"influence"`
+}
+
+// Sensitivity breaks down how a peer's global trust
+// depends on the local trust; see AnalyzeSensitivity.
+type Sensitivity struct {
+	// Peer is the analyzed peer.
+	Peer int `json:"peer"`
+
+	// Score is the global trust of the peer, as given to AnalyzeSensitivity.
+	Score float64 `json:"score"`
+
+	// Edges lists the most influential local trust edges,
+	// in descending order of absolute influence.
+	Edges []EdgeInfluence `json:"edges"`
+
+	// Trusters lists the most influential trusters i,
+	// in descending order of Σ_j c_ij·∂t_k/∂c_ij,
+	// i.e. the first-order change in t_k if all of i's local trust
+	// were scaled up by a factor of (1+ε) without re-canonicalization,
+	// divided by ε.
+	// Loosely, it is the part of t_k that flows through i.
+	Trusters []Contribution `json:"trusters"`
+}
+
+// SensitivityOpts contains options for the AnalyzeSensitivity function.
+type SensitivityOpts struct {
+	topEdges      int
+	topTrusters   int
+	maxIterations int
+}
+
+// SensitivityOpt is one AnalyzeSensitivity option.
+type SensitivityOpt func(*SensitivityOpts)
+
+// WithTopEdges tells AnalyzeSensitivity to list n most influential edges;
+// 0 lists all.
+//
+// Defaults to 10.
+func WithTopEdges(n int) SensitivityOpt {
+	return func(o *SensitivityOpts) { o.topEdges = n }
+}
+
+// WithTopTrusters tells AnalyzeSensitivity to list n most influential
+// trusters; 0 lists all.
+//
+// Defaults to 10.
+func WithTopTrusters(n int) SensitivityOpt {
+	return func(o *SensitivityOpts) { o.topTrusters = n }
+}
+
+// WithSensitivityMaxIterations tells AnalyzeSensitivity
+// to fail if the adjoint iteration does not converge
+// within the given number of iterations; 0 means unlimited.
+//
+// Defaults to 0 (unlimited).
+func WithSensitivityMaxIterations(n int) SensitivityOpt {
+	return func(o *SensitivityOpts) { o.maxIterations = n }
+}
+
+// AnalyzeSensitivity estimates how the global trust (t) of the given peer
+// depends on each local trust edge,
+// where t is computed by Compute with the given local trust (c) and alpha (a).
+//
+// c must be canonicalized, as passed to Compute,
+// with trusters in rows.
+// t is the result of Compute, before Discount.
+//
+// At the fixed point t = (1-a)·cᵀ·t + a·p, so
+// ∂t_k/∂c_ij = (1-a)·t_i·y_j, where y = (I - (1-a)·c)⁻¹·e_k
+// is the adjoint of the target peer k.
+// AnalyzeSensitivity finds y by the reverse power iteration
+// y ← e_k + (1-a)·c·y, which runs on c itself rather than its transpose,
+// until y changes by no more than e (in L2 norm).
+// a must be positive for the iteration to converge.
+//
+// The analysis assumes the plain EigenTrust model:
+// per-peer alphas and leaked trust redistribution are not accounted for.
+func AnalyzeSensitivity(
+	ctx context.Context, c *sparse.Matrix, t *sparse.Vector,
+	a float64, e float64, peer int, opts ...SensitivityOpt,
+) (*Sensitivity, error) {
+	o := SensitivityOpts{topEdges: 10, topTrusters: 10}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	n, err := c.Dim()
+	if err != nil {
+		return nil, err
+	}
+	if t.Dim != n {
+		return nil, sparse.ErrDimensionMismatch
+	}
+	if peer < 0 || peer >= n {
+		return nil, fmt.Errorf("peer %d out of range [0..%d)", peer, n)
+	}
+	if !(a > 0 && a <= 1) {
+		return nil, fmt.Errorf("alpha=%f out of range (0..1]", a)
+	}
+	if !(e > 0) {
+		return nil, fmt.Errorf("epsilon=%f must be positive", e)
+	}
+	if o.topEdges < 0 || o.topTrusters < 0 || o.maxIterations < 0 {
+		return nil, fmt.Errorf("negative sensitivity limit")
+	}
+	y, cy, err := adjointTrust(ctx, c, a, e, peer, o.maxIterations)
+	if err != nil {
+		return nil, err
+	}
+	s := &Sensitivity{Peer: peer, Score: entryAt(t.Entries, peer)}
+	edges := &edgeInfluenceHeap{}
+	for _, te := range t.Entries {
+		i, ti := te.Index, te.Value
+		if ti == 0 {
+			continue
+		}
+		s.Trusters = append(s.Trusters,
+			Contribution{Peer: i, Value: (1 - a) * ti * cy[i]})
+		for _, ce := range c.Entries[i] {
+			j := ce.Index
+			edges.add(EdgeInfluence{
+				Truster:     i,
+				Trustee:     j,
+				Value:       ce.Value,
+				Sensitivity: (1 - a) * ti * y[j],
+				Influence:   ce.Value * (1 - a) * ti * (y[j] - cy[i]),
+			}, o.topEdges)
+		}
+	}
+	s.Edges = edges.sorted()
+	s.Trusters = topContributions(s.Trusters, o.topTrusters)
+	return s, nil
+}
+
+// adjointTrust solves y = e_k + (1-a)·c·y by iteration,
+// and returns y and c·y, both dense.
+func adjointTrust(
+	ctx context.Context, c *sparse.Matrix, a float64, e float64, peer int,
+	maxIterations int,
+) (y, cy []float64, err error) {
+	n, err := c.Dim()
+	if err != nil {
+		return nil, nil, err
+	}
+	ek := sparse.NewVector(n, []sparse.Entry{{Index: peer, Value: 1}})
+	y1 := ek.Clone()
+	y0 := &sparse.Vector{}
+	d := &sparse.Vector{}
+	v := &sparse.Vector{}
+	for iter := 0; ; iter++ {
+		if maxIterations != 0 && iter >= maxIterations {
+			return nil, nil, fmt.Errorf(
+				"adjoint did not converge in %d iterations", maxIterations)
+		}
+		y0.Assign(y1)
+		if err = v.MulVec(ctx, c, y0); err != nil {
+			return nil, nil, err
+		}
+		v.ScaleVec(1-a, v)
+		if err = y1.AddVec(ek, v); err != nil {
+			return nil, nil, err
+		}
+		if err = d.SubVec(y1, y0); err != nil {
+			return nil, nil, err
+		}
+		if d.Norm2() <= e {
+			break
+		}
+	}
+	y = make([]float64, n)
+	for _, entry := range y1.Entries {
+		y[entry.Index] = entry.Value
+	}
+	// c·y = (y - e_k) / (1-a) at the fixed point,
+	// but a may be 1, so multiply instead.
+	if err = v.MulVec(ctx, c, y1); err != nil {
+		return nil, nil, err
+	}
+	cy = make([]float64, n)
+	for _, entry := range v.Entries {
+		cy[entry.Index] = entry.Value
+	}
+	return y, cy, nil
+}
+
+// edgeInfluenceHeap is a min-heap of edges by absolute influence,
+// keeping the most influential edges seen.
+type edgeInfluenceHeap []EdgeInfluence
+
+func (h edgeInfluenceHeap) Len() int { return len(h) }
+func (h edgeInfluenceHeap) Less(i, j int) bool {
+	return math.Abs(h[i].Influence) < math.Abs(h[j].Influence)
+}
+func (h edgeInfluenceHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *edgeInfluenceHeap) Push(x any)   { *h = append(*h, x.(EdgeInfluence)) }
+func (h *edgeInfluenceHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// add adds the given edge, keeping at most n edges (all if n is 0).
+func (h *edgeInfluenceHeap) add(edge EdgeInfluence, n int) {
+	switch {
+	case n == 0 || h.Len() < n:
+		heap.Push(h, edge)
+	case math.Abs(edge.Influence) > math.Abs((*h)[0].Influence):
+		(*h)[0] = edge
+		heap.Fix(h, 0)
+	}
+}
+
+// sorted returns the edges in descending order of absolute influence.
+func (h *edgeInfluenceHeap) sorted() []EdgeInfluence {
+	edges := slices.Clone(*h)
+	slices.SortStableFunc(edges, func(e1, e2 EdgeInfluence) int {
+		if c := cmp.Compare(math.Abs(e2.Influence),
+			math.Abs(e1.Influence)); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(e1.Truster, e2.Truster); c != 0 {
+			return c
+		}
+		return cmp.Compare(e1.Trustee, e2.Trustee)
+	})
+	return edges
+}
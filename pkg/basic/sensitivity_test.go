@@ -0,0 +1,95 @@
+package basic
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestAnalyzeSensitivity(t *testing.T) {
+	ctx := context.Background()
+	const (
+		a       = 0.2
+		e       = 1e-13
+		peer    = 2
+		epsilon = 1e-6
+	)
+	l := sparse.NewCSRMatrix(4, 4, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 3},
+		{Row: 0, Column: 3, Value: 1},
+		{Row: 1, Column: 2, Value: 1},
+		{Row: 1, Column: 3, Value: 1},
+		{Row: 2, Column: 0, Value: 1},
+		{Row: 3, Column: 0, Value: 1},
+		{Row: 3, Column: 2, Value: 2},
+	}, false)
+	p := sparse.NewVector(4, []sparse.Entry{{Index: 0, Value: 1}})
+	canonicalized := func(l *sparse.Matrix) *sparse.Matrix {
+		c := l.Clone()
+		assert.NoError(t, CanonicalizeLocalTrust(c, p))
+		return c
+	}
+	score := func(c *sparse.Matrix) float64 {
+		tv, err := Compute(ctx, c, p, a, e)
+		assert.NoError(t, err)
+		return entryAt(tv.Entries, peer)
+	}
+	c := canonicalized(l)
+	tv, err := Compute(ctx, c, p, a, e)
+	if !assert.NoError(t, err) {
+		return
+	}
+	tk := entryAt(tv.Entries, peer)
+
+	s, err := AnalyzeSensitivity(ctx, c, tv, a, e, peer, WithTopEdges(0),
+		WithTopTrusters(2))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, peer, s.Peer)
+	assert.Equal(t, tk, s.Score)
+	assert.Len(t, s.Edges, 7)
+	for k, edge := range s.Edges {
+		if k > 0 {
+			assert.GreaterOrEqual(t,
+				math.Abs(s.Edges[k-1].Influence), math.Abs(edge.Influence))
+		}
+		// Sensitivity: nudge the canonicalized value only.
+		c1 := c.Clone()
+		for n, ce := range c1.Entries[edge.Truster] {
+			if ce.Index == edge.Trustee {
+				c1.Entries[edge.Truster][n].Value += epsilon
+			}
+		}
+		assert.InDelta(t, (score(c1)-tk)/epsilon, edge.Sensitivity, 1e-4,
+			"sensitivity of %d->%d", edge.Truster, edge.Trustee)
+		// Influence: scale the raw value, then re-canonicalize.
+		l1 := l.Clone()
+		for n, le := range l1.Entries[edge.Truster] {
+			if le.Index == edge.Trustee {
+				l1.Entries[edge.Truster][n].Value *= 1 + epsilon
+			}
+		}
+		assert.InDelta(t, (score(canonicalized(l1))-tk)/epsilon,
+			edge.Influence, 1e-4,
+			"influence of %d->%d", edge.Truster, edge.Trustee)
+	}
+	if assert.Len(t, s.Trusters, 2) {
+		assert.GreaterOrEqual(t, s.Trusters[0].Value, s.Trusters[1].Value)
+	}
+
+	s, err = AnalyzeSensitivity(ctx, c, tv, a, e, peer)
+	if assert.NoError(t, err) {
+		assert.Len(t, s.Trusters, 4)
+	}
+	_, err = AnalyzeSensitivity(ctx, c, tv, 0, e, peer)
+	assert.Error(t, err)
+	_, err = AnalyzeSensitivity(ctx, c, tv, a, e, 4)
+	assert.Error(t, err)
+	_, err = AnalyzeSensitivity(ctx, c, tv, a, e, peer,
+		WithSensitivityMaxIterations(1))
+	assert.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"math/big"
 	"runtime"
+	"strconv"
+	"time"
 
 	"github.com/mohae/deepcopy"
 	"github.com/rs/zerolog"
@@ -24,42 +26,220 @@ type ComputeServer struct {
 func (svr *ComputeServer) BasicCompute(
 	ctx context.Context, request *computepb.BasicComputeRequest,
 ) (*computepb.BasicComputeResponse, error) {
+	logger := util.LoggerWithCaller(*zerolog.Ctx(ctx))
+	r, err := svr.computeGlobalTrust(ctx, request.Params, trustChanges{})
+	if err != nil {
+		return nil, err
+	}
+	if request.Params.PositiveGlobalTrustId != "" {
+		if gtp, ok := svr.core.StoredTrustVectors.Load(request.Params.PositiveGlobalTrustId); ok {
+			_ = gtp.LockAndRun(func(
+				tp *sparse.Vector, timestamp *big.Int,
+			) error {
+				tp.Assign(r.positive)
+				if timestamp.Cmp(r.ts) < 0 {
+					timestamp.Set(r.ts)
+				}
+				return nil
+			})
+		} else {
+			logger.Warn().
+				Str("id", request.Params.PositiveGlobalTrustId).
+				Msg("positive global trust vector not found")
+		}
+	}
+	_ = r.gt.LockAndRun(func(t1 *sparse.Vector, timestamp *big.Int) error {
+		t1.Assign(r.t)
+		if timestamp.Cmp(r.ts) < 0 {
+			timestamp.Set(r.ts)
+		}
+		return nil
+	})
+	return &computepb.BasicComputeResponse{}, nil
+}
+
+func (svr *ComputeServer) Simulate(
+	ctx context.Context, request *computepb.SimulateRequest,
+) (*computepb.SimulateResponse, error) {
+	var changes trustChanges
+	for _, entry := range request.LocalTrustChanges {
+		i, err := strconv.Atoi(entry.Truster)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid truster %#v: %s", entry.Truster, err.Error())
+		}
+		j, err := strconv.Atoi(entry.Trustee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid trustee %#v: %s", entry.Trustee, err.Error())
+		}
+		changes.localTrust = append(changes.localTrust,
+			sparse.CooEntry{Row: i, Column: j, Value: entry.Value})
+	}
+	for _, entry := range request.PreTrustChanges {
+		i, err := strconv.Atoi(entry.Trustee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"invalid pre-trusted peer %#v: %s", entry.Trustee, err.Error())
+		}
+		if entry.Value < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"pre-trust=%f of peer %d is negative", entry.Value, i)
+		}
+		changes.preTrust = append(changes.preTrust,
+			sparse.Entry{Index: i, Value: entry.Value})
+	}
+	if request.MinChange < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"min_change=%f is negative", request.MinChange)
+	}
+	r, err := svr.computeGlobalTrust(ctx, request.Params, changes)
+	if err != nil {
+		return nil, err
+	}
+	scoreChanges := basic.CompareTrustVectors(r.initial, r.t,
+		request.MinChange)
+	response := &computepb.SimulateResponse{
+		NumChanged: uint64(len(scoreChanges)),
+	}
+	if limit := int(request.Limit); limit != 0 && len(scoreChanges) > limit {
+		scoreChanges = scoreChanges[:limit]
+	}
+	for _, sc := range scoreChanges {
+		response.Changes = append(response.Changes, &computepb.ScoreChange{
+			Peer:       strconv.Itoa(sc.Peer),
+			Before:     sc.Before,
+			After:      sc.After,
+			RankBefore: uint64(sc.RankBefore),
+			RankAfter:  uint64(sc.RankAfter),
+		})
+	}
+	return response, nil
+}
+
+// trustChanges are hypothetical local trust and pre-trust changes
+// to simulate; see basic.OverlayLocalTrust and basic.OverlayTrustVector.
+type trustChanges struct {
+	localTrust []sparse.CooEntry
+	preTrust   []sparse.Entry
+}
+
+// computeResult is the result of computeGlobalTrust.
+type computeResult struct {
+	// gt is the global trust vector given as global_trust_id.
+	gt *server.TrustVector
+
+	// initial is the contents of gt before the compute.
+	initial *sparse.Vector
+
+	// positive is the global trust before discounts,
+	// if positive_global_trust_id is given; nil otherwise.
+	positive *sparse.Vector
+
+	// t is the computed global trust.
+	t *sparse.Vector
+
+	// ts is the latest timestamp of the inputs.
+	ts *big.Int
+}
+
+// computeGlobalTrust computes global trust as requested in params,
+// with the given hypothetical changes applied to the inputs.
+//
+// It stores nothing: neither the inputs nor gt are modified.
+func (svr *ComputeServer) computeGlobalTrust(
+	ctx context.Context, params *computepb.Params, changes trustChanges,
+) (*computeResult, error) {
 	// TODO(ek): Copied from OpenAPI-side code; refactor.
 	logger := util.LoggerWithCaller(*zerolog.Ctx(ctx))
 	var (
-		c   *sparse.Matrix
-		p   *sparse.Vector
-		t   *sparse.Vector
-		gt  *server.TrustVector
-		ts  = &big.Int{}
-		ok  bool
-		err error
+		c       *sparse.Matrix
+		p       *sparse.Vector
+		t       *sparse.Vector
+		gt      *server.TrustVector
+		initial *sparse.Vector
+		ts      = &big.Int{}
+		ok      bool
+		err     error
 	)
+	if params == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing params")
+	}
 	opts := []basic.ComputeOpt{}
-	if lt, ok := svr.core.StoredTrustMatrices.Load(request.Params.LocalTrustId); ok {
-		_ = lt.LockAndRun(func(c1 *sparse.Matrix, timestamp *big.Int) error {
+	if lt, ok := svr.core.StoredTrustMatrices.Load(params.LocalTrustId); !ok {
+		return nil, status.Error(codes.NotFound, "local trust not found")
+	} else if len(params.LocalTrustLayers) != 0 || params.Decay != "" {
+		c, err = svr.loadBlendedLocalTrust(ctx, lt, params, ts)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes.localTrust) != 0 {
+			// c is the transpose; so are the changes.
+			ct := make([]sparse.CooEntry, 0, len(changes.localTrust))
+			for _, e := range changes.localTrust {
+				ct = append(ct, sparse.CooEntry{
+					Row: e.Column, Column: e.Row, Value: e.Value,
+				})
+			}
+			if c, err = basic.OverlayLocalTrust(c, ct); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	} else if len(changes.localTrust) != 0 {
+		// Transpose the overlay of the stored local trust,
+		// instead of copying the whole cached transpose.
+		err = lt.LockAndRun(func(
+			c1 *sparse.Matrix, timestamp *big.Int,
+		) error {
 			logger.Info().
-				Str("id", request.Params.LocalTrustId).
-				Interface("c1", c1).
+				Str("id", params.LocalTrustId).
 				Interface("timestamp", timestamp).
+				Int("changes", len(changes.localTrust)).
 				Msg("local trust")
-			c = deepcopy.Copy(c1).(*sparse.Matrix)
+			overlay, err := basic.OverlayLocalTrust(c1, changes.localTrust)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			if c, err = overlay.Transpose(ctx); err != nil {
+				return status.Errorf(codes.Internal,
+					"cannot transpose local trust: %s", err.Error())
+			}
 			if ts.Cmp(timestamp) < 0 {
 				ts.Set(timestamp)
 			}
 			return nil
 		})
+		if err != nil {
+			return nil, err
+		}
 	} else {
-		return nil, status.Error(codes.NotFound, "local trust not found")
+		// Use the cached transpose (in c), saving the transpose every time.
+		err = lt.LockAndRunTransposed(ctx, func(
+			c1 *sparse.Matrix, timestamp *big.Int,
+		) error {
+			logger.Info().
+				Str("id", params.LocalTrustId).
+				Interface("timestamp", timestamp).
+				Msg("local trust")
+			c = c1.Clone()
+			if ts.Cmp(timestamp) < 0 {
+				ts.Set(timestamp)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"cannot transpose local trust: %s", err.Error())
+		}
 	}
 	cDim, err := c.Dim()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "c is not square: %#v*%#v",
 			c.MajorDim, c.MinorDim)
 	}
-	if request.Params.PreTrustId == "" {
+	if params.PreTrustId == "" {
 		p = sparse.NewVector(cDim, nil)
-	} else if pt, ok := svr.core.StoredTrustVectors.Load(request.Params.PreTrustId); ok {
+	} else if pt, ok := svr.core.StoredTrustVectors.Load(params.PreTrustId); ok {
 		_ = pt.LockAndRun(func(p1 *sparse.Vector, timestamp *big.Int) error {
 			p = deepcopy.Copy(p1).(*sparse.Vector)
 			if ts.Cmp(timestamp) < 0 {
@@ -67,21 +247,27 @@ func (svr *ComputeServer) BasicCompute(
 			}
 			return nil
 		})
-		switch {
-		case p.Dim < cDim:
-			p.SetDim(cDim)
-		case cDim < p.Dim:
-			cDim = p.Dim
-			c.SetDim(p.Dim, p.Dim)
-		}
 	} else {
 		return nil, status.Error(codes.NotFound, "pre-trust not found")
 	}
-	if gt, ok = svr.core.StoredTrustVectors.Load(request.Params.GlobalTrustId); ok {
+	if len(changes.preTrust) != 0 {
+		if p, err = basic.OverlayTrustVector(p, changes.preTrust); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	switch {
+	case p.Dim < cDim:
+		p.SetDim(cDim)
+	case cDim < p.Dim:
+		cDim = p.Dim
+		c.SetDim(p.Dim, p.Dim)
+	}
+	if gt, ok = svr.core.StoredTrustVectors.Load(params.GlobalTrustId); ok {
 		_ = gt.LockAndRun(func(
 			t1 *sparse.Vector, timestamp *big.Int,
 		) error {
 			t = deepcopy.Copy(t1).(*sparse.Vector)
+			initial = t1.Clone()
 			if ts.Cmp(timestamp) < 0 {
 				ts.Set(timestamp)
 			}
@@ -98,6 +284,66 @@ func (svr *ComputeServer) BasicCompute(
 	} else {
 		return nil, status.Error(codes.NotFound, "global trust not found")
 	}
+	var q *sparse.Vector
+	if params.PreDistrustId != "" {
+		qt, ok := svr.core.StoredTrustVectors.Load(params.PreDistrustId)
+		if !ok {
+			return nil, status.Error(codes.NotFound, "pre-distrust not found")
+		}
+		_ = qt.LockAndRun(func(q1 *sparse.Vector, timestamp *big.Int) error {
+			q = deepcopy.Copy(q1).(*sparse.Vector)
+			if ts.Cmp(timestamp) < 0 {
+				ts.Set(timestamp)
+			}
+			return nil
+		})
+		if len(q.Entries) == 0 {
+			return nil, status.Error(codes.InvalidArgument,
+				"pre-distrust is empty")
+		}
+		switch {
+		case q.Dim < t.Dim:
+			q.SetDim(t.Dim)
+		case t.Dim < q.Dim:
+			t.SetDim(q.Dim)
+			p.SetDim(q.Dim)
+			cDim = q.Dim
+			c.SetDim(q.Dim, q.Dim)
+		}
+	}
+	if params.PeerAlphaId != "" {
+		at, ok := svr.core.StoredTrustVectors.Load(params.PeerAlphaId)
+		if !ok {
+			return nil, status.Error(codes.NotFound, "peer alpha not found")
+		}
+		var peerAlphas *sparse.Vector
+		_ = at.LockAndRun(func(a1 *sparse.Vector, timestamp *big.Int) error {
+			peerAlphas = deepcopy.Copy(a1).(*sparse.Vector)
+			if ts.Cmp(timestamp) < 0 {
+				ts.Set(timestamp)
+			}
+			return nil
+		})
+		for _, e := range peerAlphas.Entries {
+			if e.Value < 0 || e.Value > 1 {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"peer %d alpha=%f out of range [0..1]", e.Index, e.Value)
+			}
+		}
+		switch {
+		case peerAlphas.Dim < t.Dim:
+			peerAlphas.SetDim(t.Dim)
+		case t.Dim < peerAlphas.Dim:
+			t.SetDim(peerAlphas.Dim)
+			p.SetDim(peerAlphas.Dim)
+			cDim = peerAlphas.Dim
+			c.SetDim(peerAlphas.Dim, peerAlphas.Dim)
+			if q != nil {
+				q.SetDim(peerAlphas.Dim)
+			}
+		}
+		opts = append(opts, basic.WithPeerAlphas(peerAlphas))
+	}
 	opts = append(opts, basic.WithInitialTrust(t), basic.WithResultIn(t))
 	logger.Info().Int("dim", cDim).Int("nnz", c.NNZ()).
 		Msg("local trust loaded")
@@ -105,7 +351,7 @@ func (svr *ComputeServer) BasicCompute(
 		Msg("pre-trust loaded")
 	logger.Info().Int("dim", t.Dim).Int("nnz", t.NNZ()).
 		Msg("global/initial trust loaded")
-	alpha := request.Params.Alpha
+	alpha := params.Alpha
 	if alpha == nil {
 		a := 0.5
 		alpha = &a
@@ -113,7 +359,7 @@ func (svr *ComputeServer) BasicCompute(
 		return nil, status.Errorf(codes.InvalidArgument,
 			"alpha=%f out of range [0..1]", *alpha)
 	}
-	epsilon := request.Params.Epsilon
+	epsilon := params.Epsilon
 	if epsilon == nil {
 		e := 1e-6 / float64(cDim)
 		epsilon = &e
@@ -121,6 +367,57 @@ func (svr *ComputeServer) BasicCompute(
 		return nil, status.Errorf(codes.InvalidArgument,
 			"epsilon=%f out of range (0..1]", *epsilon)
 	}
+	var dangling basic.DanglingStrategy
+	switch params.DanglingStrategy {
+	case computepb.DanglingStrategy_DANGLING_STRATEGY_PRE_TRUST:
+		dangling = basic.DanglingPreTrust
+	case computepb.DanglingStrategy_DANGLING_STRATEGY_UNIFORM:
+		dangling = basic.DanglingUniform
+	case computepb.DanglingStrategy_DANGLING_STRATEGY_SELF_LOOP:
+		dangling = basic.DanglingSelfLoop
+	case computepb.DanglingStrategy_DANGLING_STRATEGY_REDISTRIBUTE:
+		dangling = basic.DanglingRedistribute
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown dangling strategy %v", params.DanglingStrategy)
+	}
+	opts = append(opts, basic.WithDanglingStrategy(dangling))
+	steps, err := basic.ParsePreprocessSteps(params.Preprocess...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var discountOpts []basic.DiscountOpt
+	switch params.DiscountMode {
+	case computepb.DiscountMode_DISCOUNT_MODE_ONE_STEP:
+		discountOpts = append(discountOpts,
+			basic.WithDiscountMode(basic.DiscountOneStep))
+	case computepb.DiscountMode_DISCOUNT_MODE_CLAMPED:
+		discountOpts = append(discountOpts,
+			basic.WithDiscountMode(basic.DiscountClamped))
+	case computepb.DiscountMode_DISCOUNT_MODE_PROPAGATED:
+		hops := int(max(params.DistrustHops, 1))
+		// c is the transposed local trust, canonicalized in-place below.
+		discountOpts = append(discountOpts,
+			basic.WithDiscountMode(basic.DiscountPropagated),
+			basic.WithDistrustPropagation(c, hops))
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown discount mode %v", params.DiscountMode)
+	}
+	antiTrustWeight := 1.0
+	if w := params.AntiTrustWeight; w != nil {
+		if antiTrustWeight = *w; antiTrustWeight < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"anti_trust_weight=%f is negative", antiTrustWeight)
+		}
+	}
+	if w := params.DistrustWeight; w != nil {
+		if *w < 0 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"distrust_weight=%f is negative", *w)
+		}
+		discountOpts = append(discountOpts, basic.WithDistrustWeight(*w))
+	}
 	basic.CanonicalizeTrustVector(p)
 	basic.CanonicalizeTrustVector(t)
 	discounts, err := basic.ExtractDistrust(c)
@@ -128,7 +425,37 @@ func (svr *ComputeServer) BasicCompute(
 		return nil, status.Errorf(codes.Internal,
 			"cannot extract discounts: %s", err.Error())
 	}
-	err = basic.CanonicalizeLocalTrust(c, p)
+	// Discounts extracted from the transpose are also transposed.
+	if discounts, err = discounts.Transpose(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"cannot transpose discounts: %s", err.Error())
+	}
+	if err = basic.PreprocessLocalTrust(ctx, c, true, steps...); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"cannot preprocess local trust: %s", err.Error())
+	}
+	if q != nil {
+		// c is the transposed local trust; reverse propagation needs c itself.
+		r, err := c.Transpose(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"cannot transpose local trust: %s", err.Error())
+		}
+		basic.CanonicalizeTrustVector(q)
+		if err = basic.CanonicalizeReverseLocalTrust(r, q); err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"cannot canonicalize reverse local trust: %s", err.Error())
+		}
+		b, err := basic.ComputeAntiTrust(ctx, r, q, *alpha, *epsilon)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable,
+				"cannot compute Anti-TrustRank: %s", err.Error())
+		}
+		discountOpts = append(discountOpts,
+			basic.WithAntiTrust(b, antiTrustWeight))
+	}
+	err = basic.CanonicalizeTransposedLocalTrust(c, p,
+		basic.DanglingAs(dangling))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal,
 			"cannot canonicalize local trust: %s", err.Error())
@@ -138,6 +465,7 @@ func (svr *ComputeServer) BasicCompute(
 		return nil, status.Errorf(codes.Internal,
 			"cannot canonicalize discounts: %s", err.Error())
 	}
+	opts = append(opts, basic.WithTransposedLocalTrust(c))
 	_, err = basic.Compute(ctx, c, p, *alpha, *epsilon, opts...)
 	c = nil
 	p = nil
@@ -146,32 +474,21 @@ func (svr *ComputeServer) BasicCompute(
 		return nil, status.Errorf(codes.Unavailable,
 			"cannot compute EigenTrust: %s", err.Error())
 	}
-	if request.Params.PositiveGlobalTrustId != "" {
-		if gtp, ok := svr.core.StoredTrustVectors.Load(request.Params.PositiveGlobalTrustId); ok {
-			_ = gtp.LockAndRun(func(
-				tp *sparse.Vector, timestamp *big.Int,
-			) error {
-				tp.Assign(t)
-				if timestamp.Cmp(ts) < 0 {
-					timestamp.Set(ts)
-				}
-				return nil
-			})
-		} else {
-			logger.Warn().
-				Str("id", request.Params.PositiveGlobalTrustId).
-				Msg("positive global trust vector not found")
-		}
+	var positive *sparse.Vector
+	if params.PositiveGlobalTrustId != "" {
+		positive = t.Clone()
 	}
-	basic.DiscountTrustVector(t, discounts)
-	_ = gt.LockAndRun(func(t1 *sparse.Vector, timestamp *big.Int) error {
-		t1.Assign(t)
-		if timestamp.Cmp(ts) < 0 {
-			timestamp.Set(ts)
-		}
-		return nil
-	})
-	return &computepb.BasicComputeResponse{}, nil
+	if err = basic.Discount(ctx, t, discounts, discountOpts...); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"cannot apply local trust discounts: %s", err.Error())
+	}
+	return &computeResult{
+		gt:       gt,
+		initial:  initial,
+		positive: positive,
+		t:        t,
+		ts:       ts,
+	}, nil
 }
 
 func (svr *ComputeServer) CreateJob(
@@ -191,3 +508,90 @@ func (svr *ComputeServer) DeleteJob(
 func NewGrpcServer(core *server.Core) *ComputeServer {
 	return &ComputeServer{core: core}
 }
+
+// loadBlendedLocalTrust loads the given stored local trust (lt),
+// decays it and blends it with the local trust layers in params,
+// as requested, and returns the transpose of the result.
+//
+// ts is updated with the latest timestamp of the layers.
+func (svr *ComputeServer) loadBlendedLocalTrust(
+	ctx context.Context, lt *server.TrustMatrix, params *computepb.Params,
+	ts *big.Int,
+) (*sparse.Matrix, error) {
+	var decay basic.DecayFunc
+	now := time.Now()
+	if params.Decay != "" {
+		var err error
+		if decay, err = basic.ParseDecay(params.Decay); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if params.DecayReference != 0 {
+			now = time.Unix(params.DecayReference, 0)
+		}
+	}
+	load := func(tm *server.TrustMatrix) (c *sparse.Matrix, err error) {
+		noEdgeTimes := false
+		_ = tm.LockAndRunWithEdgeTimes(func(
+			c1, edgeTimes *sparse.Matrix, timestamp *big.Int,
+		) error {
+			c = c1.Clone()
+			switch {
+			case decay == nil:
+			case edgeTimes == nil:
+				noEdgeTimes = true
+			default:
+				err = basic.DecayLocalTrust(ctx, c, edgeTimes, now, decay)
+			}
+			if ts.Cmp(timestamp) < 0 {
+				ts.Set(timestamp)
+			}
+			return nil
+		})
+		if noEdgeTimes {
+			return nil, status.Error(codes.InvalidArgument,
+				"decay requested but trust matrix has no edge times")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"cannot decay local trust: %s", err.Error())
+		}
+		return c, nil
+	}
+	c, err := load(lt)
+	if err != nil {
+		return nil, err
+	}
+	if len(params.LocalTrustLayers) != 0 {
+		layer0 := basic.LocalTrustLayer{LocalTrust: c, Weight: 1}
+		if params.LocalTrustWeight != nil {
+			layer0.Weight = *params.LocalTrustWeight
+		}
+		layers := []basic.LocalTrustLayer{layer0}
+		for i, l := range params.LocalTrustLayers {
+			tm, ok := svr.core.StoredTrustMatrices.Load(l.LocalTrustId)
+			if !ok {
+				return nil, status.Errorf(codes.NotFound,
+					"local trust layer %d not found", i)
+			}
+			layer := basic.LocalTrustLayer{Weight: 1, Raw: l.Raw}
+			if layer.LocalTrust, err = load(tm); err != nil {
+				return nil, err
+			}
+			if l.Weight != nil {
+				layer.Weight = *l.Weight
+			}
+			layers = append(layers, layer)
+		}
+		c, err = basic.BlendLocalTrust(ctx, layers...)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"cannot blend local trust layers: %s", err.Error())
+		}
+	}
+	ct, err := c.Transpose(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"cannot transpose local trust: %s", err.Error())
+	}
+	return ct, nil
+}
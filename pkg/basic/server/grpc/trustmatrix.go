@@ -47,7 +47,9 @@ func (svr *TrustMatrixServer) Get(
 	if !ok {
 		return status.Error(codes.NotFound, "matrix not found")
 	}
-	return tm.LockAndRun(func(c *sparse.Matrix, timestamp *big.Int) error {
+	return tm.LockAndRunWithEdgeTimes(func(
+		c, edgeTimes *sparse.Matrix, timestamp *big.Int,
+	) error {
 		if err := server.Send(&trustmatrixpb.GetResponse{
 			Part: &trustmatrixpb.GetResponse_Header{
 				Header: &trustmatrixpb.Header{
@@ -60,16 +62,29 @@ func (svr *TrustMatrixServer) Get(
 		}
 		for i, row := range c.Entries {
 			truster := strconv.Itoa(i)
+			var times []sparse.Entry
+			if edgeTimes != nil && i < len(edgeTimes.Entries) {
+				times = edgeTimes.Entries[i]
+			}
 			for _, entry := range row {
 				if entry.Value == 0 {
 					continue
 				}
+				// both sorted by index; find the time by merge-matching
+				for len(times) > 0 && times[0].Index < entry.Index {
+					times = times[1:]
+				}
+				var unixTime int64
+				if len(times) > 0 && times[0].Index == entry.Index {
+					unixTime = int64(times[0].Value)
+				}
 				if err := server.Send(&trustmatrixpb.GetResponse{
 					Part: &trustmatrixpb.GetResponse_Entry{
 						Entry: &trustmatrixpb.Entry{
-							Truster: truster,
-							Trustee: strconv.Itoa(entry.Index),
-							Value:   entry.Value,
+							Truster:  truster,
+							Trustee:  strconv.Itoa(entry.Index),
+							Value:    entry.Value,
+							UnixTime: unixTime,
 						},
 					},
 				}); err != nil {
@@ -90,9 +105,14 @@ func (svr *TrustMatrixServer) Update(
 	if !ok {
 		return nil, status.Error(codes.NotFound, "matrix not found")
 	}
-	err = tm.LockAndRun(func(c *sparse.Matrix, timestamp *big.Int) error {
+	err = tm.LockAndUpdateWithEdgeTimes(func(
+		c, edgeTimes *sparse.Matrix, timestamp *big.Int,
+	) error {
 		var rows, cols int
 		entries := make([]sparse.CooEntry, 0, len(request.Entries))
+		// Entries without time get zero (none), overriding when merged.
+		times := make([]sparse.CooEntry, 0, len(request.Entries))
+		hasTimes := false
 		for _, entry := range request.Entries {
 			var (
 				i, j int
@@ -111,6 +131,12 @@ func (svr *TrustMatrixServer) Update(
 				Column: j,
 				Value:  entry.Value,
 			})
+			times = append(times, sparse.CooEntry{
+				Row:    i,
+				Column: j,
+				Value:  float64(entry.UnixTime),
+			})
+			hasTimes = hasTimes || entry.UnixTime != 0
 			if rows <= i {
 				rows = i + 1
 			}
@@ -125,7 +151,14 @@ func (svr *TrustMatrixServer) Update(
 			cols = rows
 		}
 		c2 := sparse.NewCSRMatrix(rows, cols, entries, true)
-		c.Merge(&c2.CSMatrix)
+		var edgeTimes2 *sparse.Matrix
+		if hasTimes {
+			edgeTimes2 = sparse.NewCSRMatrix(rows, cols, times, true)
+		}
+		err := server.MergeWithEdgeTimes(c, edgeTimes, c2, edgeTimes2)
+		if err != nil {
+			return err
+		}
 		if e := c.Mmap(ctx); e != nil {
 			zerolog.Ctx(ctx).Err(e).Msg("cannot mmap")
 		}
@@ -155,8 +188,11 @@ func (svr *TrustMatrixServer) Flush(
 	if !ok {
 		return nil, status.Error(codes.NotFound, "matrix not found")
 	}
-	_ = tm.LockAndRun(func(c *sparse.Matrix, timestamp *big.Int) error {
+	_ = tm.LockAndUpdateWithEdgeTimes(func(
+		c, edgeTimes *sparse.Matrix, timestamp *big.Int,
+	) error {
 		c.Reset()
+		edgeTimes.Reset()
 		timestamp.SetUint64(0)
 		return nil
 	})
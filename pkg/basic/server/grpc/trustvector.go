@@ -104,7 +104,9 @@ func (svr *TrustVectorServer) Update(
 				size = i + 1
 			}
 		}
-		v.Merge(sparse.NewVector(size, entries))
+		if err1 = v.Merge(sparse.NewVector(size, entries)); err1 != nil {
+			return err1
+		}
 		updateTimestamp := Qwords2BigUint(request.Header.TimestampQwords)
 		switch cmp := updateTimestamp.Cmp(timestamp); {
 		case cmp > 0:
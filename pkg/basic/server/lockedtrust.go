@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"math/big"
 	"sync"
 
@@ -30,9 +31,11 @@ func (m *TrustVector) LockAndRun(
 }
 
 type TrustMatrix struct {
-	matrix    *sparse.Matrix
-	timestamp big.Int
-	mutex     sync.Mutex
+	matrix     *sparse.Matrix
+	transposed *sparse.Matrix // cached transpose of matrix, nil if stale
+	edgeTimes  *sparse.Matrix // Unix time of each entry, nil if none
+	timestamp  big.Int
+	mutex      sync.Mutex
 }
 
 func NewTrustMatrixWithContents(c *sparse.Matrix) *TrustMatrix {
@@ -43,6 +46,9 @@ func NewTrustMatrix() *TrustMatrix {
 	return NewTrustMatrixWithContents(sparse.NewCSRMatrix(0, 0, nil, false))
 }
 
+// LockAndRun runs f with the matrix locked.
+//
+// f must not modify the matrix contents; use LockAndUpdate for that.
 func (m *TrustMatrix) LockAndRun(
 	f func(matrix *sparse.Matrix, timestamp *big.Int) error,
 ) error {
@@ -50,3 +56,71 @@ func (m *TrustMatrix) LockAndRun(
 	defer m.mutex.Unlock()
 	return f(m.matrix, &m.timestamp)
 }
+
+// LockAndUpdate runs f with the matrix locked, letting f modify the matrix.
+//
+// LockAndUpdate invalidates the cached transpose.
+func (m *TrustMatrix) LockAndUpdate(
+	f func(matrix *sparse.Matrix, timestamp *big.Int) error,
+) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.transposed = nil
+	return f(m.matrix, &m.timestamp)
+}
+
+// LockAndRunTransposed runs f with the transpose of the matrix, locked.
+//
+// The transpose is computed upon first use, then cached
+// until the next LockAndUpdate.
+// f must not modify the transposed matrix.
+func (m *TrustMatrix) LockAndRunTransposed(
+	ctx context.Context,
+	f func(transposed *sparse.Matrix, timestamp *big.Int) error,
+) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.transposed == nil {
+		transposed, err := m.matrix.Transpose(ctx)
+		if err != nil {
+			return err
+		}
+		m.transposed = transposed
+	}
+	return f(m.transposed, &m.timestamp)
+}
+
+// LockAndRunWithEdgeTimes runs f with the matrix and its edge times locked.
+//
+// edgeTimes holds the Unix time (in seconds) of each matrix entry
+// at the same location, or nil if the matrix has no edge times.
+// f must modify neither.
+func (m *TrustMatrix) LockAndRunWithEdgeTimes(
+	f func(matrix, edgeTimes *sparse.Matrix, timestamp *big.Int) error,
+) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return f(m.matrix, m.edgeTimes, &m.timestamp)
+}
+
+// LockAndUpdateWithEdgeTimes runs f with the matrix and its edge times locked,
+// letting f modify both; see LockAndRunWithEdgeTimes for edge times.
+// edgeTimes passed to f is never nil;
+// edge times left empty by f are discarded.
+//
+// LockAndUpdateWithEdgeTimes invalidates the cached transpose.
+func (m *TrustMatrix) LockAndUpdateWithEdgeTimes(
+	f func(matrix, edgeTimes *sparse.Matrix, timestamp *big.Int) error,
+) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.transposed = nil
+	if m.edgeTimes == nil {
+		m.edgeTimes = sparse.NewCSRMatrix(0, 0, nil, false)
+	}
+	err := f(m.matrix, m.edgeTimes, &m.timestamp)
+	if m.edgeTimes.NNZ() == 0 {
+		m.edgeTimes = nil
+	}
+	return err
+}
@@ -44,34 +44,70 @@ func (ntms *NamedTrustMatrices) NewNamed(id string) error {
 	return nil
 }
 
-// Set stores c into the stored local trust.
-// It takes ownership of c; caller must not use c anymore.
+// Set stores c into the stored local trust,
+// along with its edge times (nil if none).
+// It takes ownership of c and edgeTimes; caller must not use them anymore.
 func (ntms *NamedTrustMatrices) Set(
-	id string, c *sparse.Matrix,
+	id string, c, edgeTimes *sparse.Matrix,
 ) (tm *TrustMatrix, created bool) {
 	tm = NewTrustMatrixWithContents(c)
+	tm.edgeTimes = edgeTimes
 	_, loaded := ntms.Swap(id, tm)
 	created = !loaded
 	return
 }
 
-// Merge merges c into the stored local trust.
-// It takes ownership of c; caller must not use c anymore.
+// Merge merges c into the stored local trust,
+// along with its edge times (nil if none).
+// Merged entries without edge times lose their stored edge times.
+// It takes ownership of c and edgeTimes; caller must not use them anymore.
 func (ntms *NamedTrustMatrices) Merge(
-	id string, c *sparse.Matrix,
+	id string, c, edgeTimes *sparse.Matrix,
 ) (tm2 *TrustMatrix, created bool) {
 	tm1 := NewTrustMatrixWithContents(c)
+	tm1.edgeTimes = edgeTimes
 	tm2, loaded := ntms.LoadOrStore(id, tm1)
 	if tm2 != tm1 {
-		_ = tm2.LockAndRun(func(c2 *sparse.Matrix, timestamp *big.Int) error {
-			c2.Merge(&c.CSMatrix)
-			return nil
+		_ = tm2.LockAndUpdateWithEdgeTimes(func(
+			c2, edgeTimes2 *sparse.Matrix, timestamp *big.Int,
+		) error {
+			return MergeWithEdgeTimes(c2, edgeTimes2, c, edgeTimes)
 		})
 		c.Reset()
 	}
 	return tm2, !loaded
 }
 
+// MergeWithEdgeTimes merges c and its edge times (nil if none)
+// into c2 and its edge times (edgeTimes2).
+// Merged entries without edge times lose their edge times in edgeTimes2.
+//
+// c and edgeTimes are reset after merge.
+func MergeWithEdgeTimes(c2, edgeTimes2, c, edgeTimes *sparse.Matrix) error {
+	if edgeTimes == nil && edgeTimes2.NNZ() != 0 {
+		// Zero edge times remove stored ones upon merge.
+		edgeTimes = &sparse.Matrix{CSMatrix: sparse.CSMatrix{
+			MajorDim: c.MajorDim,
+			MinorDim: c.MinorDim,
+			Entries:  make([][]sparse.Entry, len(c.Entries)),
+		}}
+		for i, span := range c.Entries {
+			zeros := make([]sparse.Entry, len(span))
+			for k, e := range span {
+				zeros[k].Index = e.Index
+			}
+			edgeTimes.Entries[i] = zeros
+		}
+	}
+	if err := c2.Merge(&c.CSMatrix); err != nil {
+		return err
+	}
+	if edgeTimes != nil {
+		return edgeTimes2.Merge(&edgeTimes.CSMatrix)
+	}
+	return nil
+}
+
 func (ntms *NamedTrustMatrices) Delete(id string) (deleted bool) {
 	_, deleted = ntms.LoadAndDelete(id)
 	return
@@ -126,8 +162,7 @@ func (ntvs *NamedTrustVectors) Merge(
 	tv2, loaded := ntvs.LoadOrStore(id, tv1)
 	if tv2 != tv1 {
 		_ = tv2.LockAndRun(func(v2 *sparse.Vector, timestamp *big.Int) error {
-			v2.Merge(v)
-			return nil
+			return v2.Merge(v)
 		})
 		v.Reset()
 	}
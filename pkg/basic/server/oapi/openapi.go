@@ -4,23 +4,24 @@ import "C"
 
 import (
 	"context"
-	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"net/url"
 	"os"
-	"reflect"
 	"runtime"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mohae/deepcopy"
 	"github.com/rs/zerolog"
 	"k3l.io/go-eigentrust/pkg/api/openapi"
 	"k3l.io/go-eigentrust/pkg/basic"
 	"k3l.io/go-eigentrust/pkg/basic/server"
+	"k3l.io/go-eigentrust/pkg/graph"
 	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
 	"k3l.io/go-eigentrust/pkg/util"
 )
 
@@ -51,25 +52,123 @@ func (svr *StrictServerImpl) GetStatus(
 }
 
 func (svr *StrictServerImpl) compute(
-	ctx context.Context, localTrustRef *openapi.TrustRef,
-	initialTrust *openapi.TrustRef, preTrust *openapi.TrustRef,
-	alpha *float64, epsilon *float64,
-	flatTail *int, numLeaders *int,
-	maxIterations *int, minIterations *int, checkFreq *int,
+	ctx context.Context, req *openapi.ComputeRequestBody,
 ) (tv openapi.TrustRef, flatTailStats openapi.FlatTailStats, err error) {
+	t, flatTailStats, err := svr.computeTrustVector(ctx, req,
+		trustChanges{}, nil)
+	if err != nil {
+		return
+	}
+	itv := openapi.InlineTrustRef{Size: t.Dim}
+	for _, e := range t.Entries {
+		entry := openapi.InlineTrustEntry{V: e.Value}
+		err = entry.FromTrustVectorEntryIndex(openapi.TrustVectorEntryIndex{I: e.Index})
+		if err != nil {
+			err = fmt.Errorf("cannot build entry: %w", err)
+			return
+		}
+		itv.Entries = append(itv.Entries, entry)
+	}
+	if err = tv.FromInlineTrustRef(itv); err != nil {
+		err = fmt.Errorf("cannot create response: %w", err)
+		return
+	}
+	itv2, err := tv.AsInlineTrustRef()
+	itv2.Entries = itv.Entries[:0]
+	return tv, flatTailStats, nil
+}
+
+// computeState holds the canonicalized inputs and the intermediate result
+// of a compute request, for explaining the result.
+type computeState struct {
+	c          *sparse.Matrix // local trust, trusters in rows
+	p          *sparse.Vector
+	alpha      float64
+	peerAlphas *sparse.Vector
+	discounts  *sparse.Matrix
+	t          *sparse.Vector // before discounts
+}
+
+// trustChanges are hypothetical local trust and pre-trust changes
+// to simulate; see basic.OverlayLocalTrust and basic.OverlayTrustVector.
+type trustChanges struct {
+	localTrust []sparse.CooEntry
+	preTrust   []sparse.Entry
+}
+
+// computeTrustVector computes the global trust vector
+// for the given compute request,
+// with the given hypothetical changes applied to the inputs.
+// If state is not nil, it is filled for explaining the result.
+func (svr *StrictServerImpl) computeTrustVector(
+	ctx context.Context, req *openapi.ComputeRequestBody,
+	changes trustChanges, state *computeState,
+) (t *sparse.Vector, flatTailStats openapi.FlatTailStats, err error) {
 	logger := util.LoggerWithCaller(*zerolog.Ctx(ctx))
+	localTrustRef := &req.LocalTrust
+	initialTrust, preTrust := req.InitialTrust, req.PreTrust
+	alpha, epsilon := req.Alpha, req.Epsilon
 	var (
 		c  *sparse.Matrix
 		p  *sparse.Vector
 		t0 *sparse.Vector
 	)
 	opts := []basic.ComputeOpt{basic.WithFlatTailStats(&flatTailStats)}
-	if c, err = svr.loadTrustMatrix(ctx, localTrustRef); err != nil {
+	var decay basic.DecayFunc
+	decayNow := time.Now()
+	if req.Decay != nil {
+		if decay, err = basic.ParseDecay(*req.Decay); err != nil {
+			err = server.HTTPError{Code: 400, Inner: err}
+			return
+		}
+		if req.DecayReference != nil {
+			decayNow = time.Unix(*req.DecayReference, 0)
+		}
+	}
+	// For stored local trust, use its cached transpose instead (in c),
+	// saving the transpose on every compute.
+	// Blending and decay need trusters in rows and edge times,
+	// so local trust is not transposed for them.
+	transposed := localTrustRef.Scheme == openapi.Stored &&
+		req.LocalTrustLayers == nil && decay == nil
+	if transposed {
+		c, err = svr.loadTransposedTrustMatrix(ctx, localTrustRef)
+	} else {
+		c, err = svr.loadDecayedTrustMatrix(ctx, localTrustRef,
+			decay, decayNow)
+	}
+	if err != nil {
 		err = server.HTTPError{
 			Code: 400, Inner: fmt.Errorf("cannot load local trust: %w", err),
 		}
 		return
 	}
+	if req.LocalTrustLayers != nil {
+		c, err = svr.blendLocalTrust(ctx, c, req, decay, decayNow)
+		if err != nil {
+			return
+		}
+	}
+	if len(changes.localTrust) != 0 {
+		lt := changes.localTrust
+		if transposed {
+			// c is the transpose; so are the changes.
+			lt = make([]sparse.CooEntry, 0, len(changes.localTrust))
+			for _, e := range changes.localTrust {
+				lt = append(lt, sparse.CooEntry{
+					Row: e.Column, Column: e.Row, Value: e.Value,
+				})
+			}
+		}
+		// c is a disposable copy, so the overlay may share its rows.
+		if c, err = basic.OverlayLocalTrust(c, lt); err != nil {
+			err = server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("invalid local trust change: %w", err),
+			}
+			return
+		}
+	}
 	cDim, err := c.Dim()
 	if err != nil {
 		return
@@ -86,16 +185,24 @@ func (svr *StrictServerImpl) compute(
 			Code: 400, Inner: fmt.Errorf("cannot load pre-trust: %w", err),
 		}
 		return
-	} else {
-		// align dimensions
-		switch {
-		case p.Dim < cDim:
-			p.SetDim(cDim)
-		case cDim < p.Dim:
-			cDim = p.Dim
-			c.SetDim(p.Dim, p.Dim)
+	}
+	if len(changes.preTrust) != 0 {
+		if p, err = basic.OverlayTrustVector(p, changes.preTrust); err != nil {
+			err = server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("invalid pre-trust change: %w", err),
+			}
+			return
 		}
 	}
+	// align dimensions
+	switch {
+	case p.Dim < cDim:
+		p.SetDim(cDim)
+	case cDim < p.Dim:
+		cDim = p.Dim
+		c.SetDim(p.Dim, p.Dim)
+	}
 	logger.Trace().
 		Int("dim", p.Dim).
 		Int("nnz", p.NNZ()).
@@ -123,6 +230,79 @@ func (svr *StrictServerImpl) compute(
 			Msg("initial trust loaded")
 		opts = append(opts, basic.WithInitialTrust(t0))
 	}
+	var q *sparse.Vector
+	if req.PreDistrust != nil {
+		if q, err = svr.loadTrustVector(ctx, req.PreDistrust); err != nil {
+			err = server.HTTPError{
+				Code: 400, Inner: fmt.Errorf("cannot load pre-distrust: %w", err),
+			}
+			return
+		}
+		if len(q.Entries) == 0 {
+			err = server.HTTPError{
+				Code: 400, Inner: errors.New("pre-distrust is empty"),
+			}
+			return
+		}
+		// align dimensions
+		switch {
+		case q.Dim < cDim:
+			q.SetDim(cDim)
+		case cDim < q.Dim:
+			cDim = q.Dim
+			c.SetDim(q.Dim, q.Dim)
+			p.SetDim(q.Dim)
+			if t0 != nil {
+				t0.SetDim(q.Dim)
+			}
+		}
+		logger.Trace().
+			Int("dim", q.Dim).
+			Int("nnz", q.NNZ()).
+			Msg("pre-distrust loaded")
+	}
+	var peerAlphas *sparse.Vector
+	if req.PeerAlpha != nil {
+		// Zero alpha is valid; out-of-range alphas are checked below.
+		peerAlphas, err = svr.loadTrustVector(ctx, req.PeerAlpha,
+			spopt.IncludeZero, spopt.AllowNegative)
+		if err != nil {
+			err = server.HTTPError{
+				Code: 400, Inner: fmt.Errorf("cannot load peer alpha: %w", err),
+			}
+			return
+		}
+		for _, e := range peerAlphas.Entries {
+			if e.Value < 0 || e.Value > 1 {
+				err = server.HTTPError{
+					Code: 400,
+					Inner: fmt.Errorf("peer %d alpha=%f out of range [0..1]",
+						e.Index, e.Value),
+				}
+				return
+			}
+		}
+		// align dimensions
+		switch {
+		case peerAlphas.Dim < cDim:
+			peerAlphas.SetDim(cDim)
+		case cDim < peerAlphas.Dim:
+			cDim = peerAlphas.Dim
+			c.SetDim(peerAlphas.Dim, peerAlphas.Dim)
+			p.SetDim(peerAlphas.Dim)
+			if t0 != nil {
+				t0.SetDim(peerAlphas.Dim)
+			}
+			if q != nil {
+				q.SetDim(peerAlphas.Dim)
+			}
+		}
+		logger.Trace().
+			Int("dim", peerAlphas.Dim).
+			Int("nnz", peerAlphas.NNZ()).
+			Msg("peer alpha loaded")
+		opts = append(opts, basic.WithPeerAlphas(peerAlphas))
+	}
 	if alpha == nil {
 		a := 0.5
 		alpha = &a
@@ -143,20 +323,80 @@ func (svr *StrictServerImpl) compute(
 		}
 		return
 	}
-	if flatTail != nil {
-		opts = append(opts, basic.WithFlatTail(*flatTail))
+	if req.FlatTail != nil {
+		opts = append(opts, basic.WithFlatTail(*req.FlatTail))
 	}
-	if numLeaders != nil {
-		opts = append(opts, basic.WithFlatTailNumLeaders(*numLeaders))
+	if req.NumLeaders != nil {
+		opts = append(opts, basic.WithFlatTailNumLeaders(*req.NumLeaders))
 	}
-	if maxIterations != nil {
-		opts = append(opts, basic.WithMaxIterations(*maxIterations))
+	if req.MaxIterations != nil {
+		opts = append(opts, basic.WithMaxIterations(*req.MaxIterations))
 	}
-	if minIterations != nil {
-		opts = append(opts, basic.WithMinIterations(*minIterations))
+	if req.MinIterations != nil {
+		opts = append(opts, basic.WithMinIterations(*req.MinIterations))
 	}
-	if checkFreq != nil {
-		opts = append(opts, basic.WithCheckFreq(*checkFreq))
+	if req.CheckFreq != nil {
+		opts = append(opts, basic.WithCheckFreq(*req.CheckFreq))
+	}
+	danglingStrategy := basic.DanglingPreTrust
+	if req.Dangling != nil {
+		danglingStrategy, err = basic.ParseDanglingStrategy(
+			string(*req.Dangling))
+		if err != nil {
+			err = server.HTTPError{Code: 400, Inner: err}
+			return
+		}
+	}
+	opts = append(opts, basic.WithDanglingStrategy(danglingStrategy))
+	var steps []basic.PreprocessStep
+	if req.Preprocess != nil {
+		steps, err = basic.ParsePreprocessSteps(*req.Preprocess...)
+		if err != nil {
+			err = server.HTTPError{Code: 400, Inner: err}
+			return
+		}
+	}
+	discountMode := basic.DiscountOneStep
+	if req.DiscountMode != nil {
+		discountMode, err = basic.ParseDiscountMode(string(*req.DiscountMode))
+		if err != nil {
+			err = server.HTTPError{Code: 400, Inner: err}
+			return
+		}
+	}
+	discountOpts := []basic.DiscountOpt{basic.WithDiscountMode(discountMode)}
+	if req.DistrustWeight != nil {
+		if *req.DistrustWeight < 0 {
+			err = server.HTTPError{
+				Code: 400,
+				Inner: fmt.Errorf("distrustWeight=%f is negative",
+					*req.DistrustWeight),
+			}
+			return
+		}
+		discountOpts = append(discountOpts,
+			basic.WithDistrustWeight(*req.DistrustWeight))
+	}
+	distrustHops := 1
+	if req.DistrustHops != nil {
+		if distrustHops = *req.DistrustHops; distrustHops < 1 {
+			err = server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("distrustHops=%d is not positive", distrustHops),
+			}
+			return
+		}
+	}
+	antiTrustWeight := 1.0
+	if req.AntiTrustWeight != nil {
+		if antiTrustWeight = *req.AntiTrustWeight; antiTrustWeight < 0 {
+			err = server.HTTPError{
+				Code: 400,
+				Inner: fmt.Errorf("antiTrustWeight=%f is negative",
+					antiTrustWeight),
+			}
+			return
+		}
 	}
 	basic.CanonicalizeTrustVector(p)
 	if t0 != nil {
@@ -169,7 +409,36 @@ func (svr *StrictServerImpl) compute(
 		}
 		return
 	}
-	err = basic.CanonicalizeLocalTrust(c, p)
+	err = basic.PreprocessLocalTrust(ctx, c, transposed, steps...)
+	if err != nil {
+		err = server.HTTPError{
+			Code:  400,
+			Inner: fmt.Errorf("cannot preprocess local trust: %w", err),
+		}
+		return
+	}
+	if q != nil {
+		b, err := computeAntiTrust(ctx, c, transposed, q, *alpha, *epsilon)
+		if err != nil {
+			return nil, flatTailStats, fmt.Errorf(
+				"cannot compute Anti-TrustRank: %w", err)
+		}
+		discountOpts = append(discountOpts,
+			basic.WithAntiTrust(b, antiTrustWeight))
+	}
+	if transposed {
+		// Discounts extracted from the transpose are also transposed.
+		if discounts, err = discounts.Transpose(ctx); err != nil {
+			err = fmt.Errorf("cannot transpose discounts: %w", err)
+			return
+		}
+		err = basic.CanonicalizeTransposedLocalTrust(c, p,
+			basic.DanglingAs(danglingStrategy))
+		opts = append(opts, basic.WithTransposedLocalTrust(c))
+	} else {
+		err = basic.CanonicalizeLocalTrust(c, p,
+			basic.DanglingAs(danglingStrategy))
+	}
 	if err != nil {
 		err = server.HTTPError{
 			Code:  400,
@@ -185,7 +454,31 @@ func (svr *StrictServerImpl) compute(
 		}
 		return
 	}
-	t, err := basic.Compute(ctx, c, p, *alpha, *epsilon, opts...)
+	if discountMode == basic.DiscountPropagated {
+		ct := c
+		if !transposed {
+			if ct, err = c.Transpose(ctx); err != nil {
+				err = fmt.Errorf("cannot transpose local trust: %w", err)
+				return
+			}
+		}
+		discountOpts = append(discountOpts,
+			basic.WithDistrustPropagation(ct, distrustHops))
+	}
+	t, err = basic.Compute(ctx, c, p, *alpha, *epsilon, opts...)
+	if err == nil && state != nil {
+		if transposed {
+			// Explaining needs trusters in rows.
+			if c, err = c.Transpose(ctx); err != nil {
+				err = fmt.Errorf("cannot transpose local trust: %w", err)
+				return
+			}
+		}
+		state.c, state.p, state.alpha = c, p, *alpha
+		state.peerAlphas = peerAlphas
+		state.discounts = discounts
+		state.t = t.Clone()
+	}
 	c = nil
 	p = nil
 	runtime.GC()
@@ -193,27 +486,80 @@ func (svr *StrictServerImpl) compute(
 		err = fmt.Errorf("cannot compute EigenTrust: %w", err)
 		return
 	}
-	if err = basic.DiscountTrustVector(t, discounts); err != nil {
+	if err = basic.Discount(ctx, t, discounts, discountOpts...); err != nil {
 		err = fmt.Errorf("cannot apply local trust discounts: %w", err)
 		return
 	}
-	itv := openapi.InlineTrustRef{Size: t.Dim}
-	for _, e := range t.Entries {
-		entry := openapi.InlineTrustEntry{V: e.Value}
-		err = entry.FromTrustVectorEntryIndex(openapi.TrustVectorEntryIndex{I: e.Index})
+	return t, flatTailStats, nil
+}
+
+// blendLocalTrust blends the given local trust (c)
+// with the local trust layers of the request, weighted as requested;
+// decay, if not nil, applies to the layers as of now.
+func (svr *StrictServerImpl) blendLocalTrust(
+	ctx context.Context, c *sparse.Matrix, req *openapi.ComputeRequestBody,
+	decay basic.DecayFunc, now time.Time,
+) (*sparse.Matrix, error) {
+	layer0 := basic.LocalTrustLayer{LocalTrust: c, Weight: 1}
+	if req.LocalTrustWeight != nil {
+		layer0.Weight = *req.LocalTrustWeight
+	}
+	layers := []basic.LocalTrustLayer{layer0}
+	for i, l := range *req.LocalTrustLayers {
+		layer := basic.LocalTrustLayer{Weight: 1}
+		if l.Weight != nil {
+			layer.Weight = *l.Weight
+		}
+		if l.Raw != nil {
+			layer.Raw = *l.Raw
+		}
+		var err error
+		layer.LocalTrust, err = svr.loadDecayedTrustMatrix(ctx,
+			&l.LocalTrust, decay, now)
 		if err != nil {
-			err = fmt.Errorf("cannot build entry: %w", err)
-			return
+			return nil, server.HTTPError{
+				Code: 400,
+				Inner: fmt.Errorf("cannot load local trust layer %d: %w",
+					i, err),
+			}
 		}
-		itv.Entries = append(itv.Entries, entry)
+		layers = append(layers, layer)
 	}
-	if err = tv.FromInlineTrustRef(itv); err != nil {
-		err = fmt.Errorf("cannot create response: %w", err)
-		return
+	blended, err := basic.BlendLocalTrust(ctx, layers...)
+	if err != nil {
+		return nil, server.HTTPError{
+			Code:  400,
+			Inner: fmt.Errorf("cannot blend local trust layers: %w", err),
+		}
 	}
-	itv2, err := tv.AsInlineTrustRef()
-	itv2.Entries = itv.Entries[:0]
-	return tv, flatTailStats, nil
+	return blended, nil
+}
+
+// computeAntiTrust computes Anti-TrustRank scores
+// from the given (positive, uncanonicalized) local trust,
+// or its transpose if transposed is true, and pre-distrust (q).
+// c and q are not modified.
+func computeAntiTrust(
+	ctx context.Context, c *sparse.Matrix, transposed bool, q *sparse.Vector,
+	alpha float64, epsilon float64,
+) (*sparse.Vector, error) {
+	var (
+		r   *sparse.Matrix
+		err error
+	)
+	if transposed {
+		if r, err = c.Transpose(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		r = c.Clone()
+	}
+	q = q.Clone()
+	basic.CanonicalizeTrustVector(q)
+	if err = basic.CanonicalizeReverseLocalTrust(r, q); err != nil {
+		return nil, err
+	}
+	return basic.ComputeAntiTrust(ctx, r, q, alpha, epsilon)
 }
 
 func (svr *StrictServerImpl) Compute(
@@ -221,10 +567,7 @@ func (svr *StrictServerImpl) Compute(
 ) (openapi.ComputeResponseObject, error) {
 	req := request.Body
 
-	tv, _, err := svr.compute(ctx,
-		&req.LocalTrust, req.InitialTrust, req.PreTrust, req.Alpha, req.Epsilon,
-		req.FlatTail, req.NumLeaders,
-		req.MaxIterations, req.MinIterations, req.CheckFreq)
+	tv, _, err := svr.compute(ctx, req)
 	if err != nil {
 		var httpError server.HTTPError
 		if errors.As(err, &httpError) {
@@ -245,10 +588,7 @@ func (svr *StrictServerImpl) ComputeWithStats(
 	ctx context.Context, request openapi.ComputeWithStatsRequestObject,
 ) (openapi.ComputeWithStatsResponseObject, error) {
 	req := request.Body
-	tv, flatTailStats, err := svr.compute(ctx,
-		&req.LocalTrust, req.InitialTrust, req.PreTrust, req.Alpha, req.Epsilon,
-		req.FlatTail, req.NumLeaders,
-		req.MaxIterations, req.MinIterations, req.CheckFreq)
+	tv, flatTailStats, err := svr.compute(ctx, req)
 	if err != nil {
 		var httpError server.HTTPError
 		if errors.As(err, &httpError) {
@@ -270,6 +610,196 @@ func (svr *StrictServerImpl) ComputeWithStats(
 	return resp, nil
 }
 
+func (svr *StrictServerImpl) Explain(
+	ctx context.Context, request openapi.ExplainRequestObject,
+) (openapi.ExplainResponseObject, error) {
+	x, err := svr.explain(ctx, request.Body)
+	if err != nil {
+		var httpError server.HTTPError
+		if errors.As(err, &httpError) {
+			switch httpError.Code {
+			case 400:
+				var resp openapi.Explain400JSONResponse
+				resp.Message = httpError.Inner.Error()
+				return resp, nil
+			}
+		}
+		return nil, err
+	}
+	resp := openapi.ExplainResponseOKJSONResponse(*x)
+	return openapi.Explain200JSONResponse{ExplainResponseOKJSONResponse: resp}, nil
+}
+
+func (svr *StrictServerImpl) explain(
+	ctx context.Context, req *openapi.ExplainRequestBody,
+) (*openapi.Explanation, error) {
+	var opts []basic.ExplainOpt
+	for _, limit := range []struct {
+		name  string
+		value *int
+		opt   func(int) basic.ExplainOpt
+	}{
+		{"topContributors", req.TopContributors, basic.WithTopContributors},
+		{"topPaths", req.TopPaths, basic.WithTopPaths},
+		{"maxPathLength", req.MaxPathLength, basic.WithMaxPathLength},
+	} {
+		if limit.value == nil {
+			continue
+		}
+		if *limit.value < 0 {
+			return nil, server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("%s=%d is negative", limit.name, *limit.value),
+			}
+		}
+		opts = append(opts, limit.opt(*limit.value))
+	}
+	var state computeState
+	t, _, err := svr.computeTrustVector(ctx, &req.Compute,
+		trustChanges{}, &state)
+	if err != nil {
+		return nil, err
+	}
+	if req.Peer < 0 || req.Peer >= t.Dim {
+		return nil, server.HTTPError{
+			Code: 400,
+			Inner: fmt.Errorf("peer=%d out of range [0..%d)",
+				req.Peer, t.Dim),
+		}
+	}
+	opts = append(opts, basic.WithDistrust(state.discounts))
+	if state.peerAlphas != nil {
+		opts = append(opts, basic.WithExplainedPeerAlphas(state.peerAlphas))
+	}
+	x, err := basic.Explain(ctx, state.c, state.p, state.t, state.alpha,
+		req.Peer, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot explain: %w", err)
+	}
+	resp := &openapi.Explanation{
+		Peer:          x.Peer,
+		Score:         x.Score,
+		Inbound:       apiContributions(x.Inbound),
+		InboundTotal:  x.InboundTotal,
+		PreTrust:      x.PreTrust,
+		Distrust:      apiContributions(x.Distrust),
+		DistrustTotal: x.DistrustTotal,
+		Paths:         make([]openapi.TrustPath, 0, len(x.Paths)),
+	}
+	for _, e := range t.Entries {
+		if e.Index == req.Peer {
+			resp.DiscountedScore = e.Value
+		}
+	}
+	for _, path := range x.Paths {
+		resp.Paths = append(resp.Paths, openapi.TrustPath{
+			Peers: path.Peers, Strength: path.Strength,
+		})
+	}
+	return resp, nil
+}
+
+func (svr *StrictServerImpl) Simulate(
+	ctx context.Context, request openapi.SimulateRequestObject,
+) (openapi.SimulateResponseObject, error) {
+	sim, err := svr.simulate(ctx, request.Body)
+	if err != nil {
+		var httpError server.HTTPError
+		if errors.As(err, &httpError) {
+			switch httpError.Code {
+			case 400:
+				var resp openapi.Simulate400JSONResponse
+				resp.Message = httpError.Inner.Error()
+				return resp, nil
+			}
+		}
+		return nil, err
+	}
+	resp := openapi.SimulateResponseOKJSONResponse(*sim)
+	return openapi.Simulate200JSONResponse{SimulateResponseOKJSONResponse: resp}, nil
+}
+
+func (svr *StrictServerImpl) simulate(
+	ctx context.Context, req *openapi.SimulateRequestBody,
+) (*openapi.Simulation, error) {
+	var changes trustChanges
+	if req.LocalTrustChanges != nil {
+		for _, e := range *req.LocalTrustChanges {
+			changes.localTrust = append(changes.localTrust,
+				sparse.CooEntry{Row: e.I, Column: e.J, Value: e.V})
+		}
+	}
+	if req.PreTrustChanges != nil {
+		for _, e := range *req.PreTrustChanges {
+			if e.V < 0 {
+				return nil, server.HTTPError{
+					Code: 400,
+					Inner: fmt.Errorf("pre-trust=%f of peer %d is negative",
+						e.V, e.I),
+				}
+			}
+			changes.preTrust = append(changes.preTrust,
+				sparse.Entry{Index: e.I, Value: e.V})
+		}
+	}
+	var minChange float64
+	if req.MinChange != nil {
+		if minChange = *req.MinChange; minChange < 0 {
+			return nil, server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("minChange=%f is negative", minChange),
+			}
+		}
+	}
+	var limit int
+	if req.Limit != nil {
+		if limit = *req.Limit; limit < 0 {
+			return nil, server.HTTPError{
+				Code:  400,
+				Inner: fmt.Errorf("limit=%d is negative", limit),
+			}
+		}
+	}
+	// Compute the baseline with the same parameters, too,
+	// so that the differences are due to the changes alone.
+	before, _, err := svr.computeTrustVector(ctx, &req.Compute,
+		trustChanges{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	after, _, err := svr.computeTrustVector(ctx, &req.Compute, changes, nil)
+	if err != nil {
+		return nil, err
+	}
+	scoreChanges := basic.CompareTrustVectors(before, after, minChange)
+	resp := &openapi.Simulation{
+		NumChanged: len(scoreChanges),
+		Changes:    make([]openapi.ScoreChange, 0, len(scoreChanges)),
+	}
+	if limit != 0 && len(scoreChanges) > limit {
+		scoreChanges = scoreChanges[:limit]
+	}
+	for _, sc := range scoreChanges {
+		resp.Changes = append(resp.Changes, openapi.ScoreChange{
+			Peer:       sc.Peer,
+			Before:     sc.Before,
+			After:      sc.After,
+			RankBefore: sc.RankBefore,
+			RankAfter:  sc.RankAfter,
+		})
+	}
+	return resp, nil
+}
+
+func apiContributions(contribs []basic.Contribution) []openapi.Contribution {
+	apiContribs := make([]openapi.Contribution, 0, len(contribs))
+	for _, c := range contribs {
+		apiContribs = append(apiContribs,
+			openapi.Contribution{Peer: c.Peer, Value: c.Value})
+	}
+	return apiContribs
+}
+
 func (svr *StrictServerImpl) GetLocalTrust(
 	ctx context.Context, request openapi.GetLocalTrustRequestObject,
 ) (openapi.GetLocalTrustResponseObject, error) {
@@ -298,17 +828,108 @@ func (svr *StrictServerImpl) getLocalTrust(
 	var (
 		result *openapi.InlineTrustRef
 	)
-	if err := tm.LockAndRun(func(
-		c *sparse.Matrix, timestamp *big.Int,
+	if err := tm.LockAndRunWithEdgeTimes(func(
+		c, edgeTimes *sparse.Matrix, timestamp *big.Int,
 	) (err error) {
 		result, err = openapi.InlineFromMatrix(ctx, c)
-		return err
+		if err != nil || edgeTimes == nil {
+			return err
+		}
+		return openapi.SetInlineEntryTimes(result, edgeTimes)
 	}); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+func (svr *StrictServerImpl) GetLocalTrustStats(
+	ctx context.Context, request openapi.GetLocalTrustStatsRequestObject,
+) (openapi.GetLocalTrustStatsResponseObject, error) {
+	var preTrusted []int
+	if request.Params.PreTrusted != nil {
+		preTrusted = *request.Params.PreTrusted
+	}
+	stats, err := svr.getLocalTrustStats(ctx, request.Id, preTrusted)
+	if err != nil {
+		var httpError server.HTTPError
+		if errors.As(err, &httpError) {
+			switch httpError.Code {
+			case 400:
+				var resp openapi.GetLocalTrustStats400JSONResponse
+				resp.Message = httpError.Inner.Error()
+				return resp, nil
+			case 404:
+				return openapi.GetLocalTrustStats404Response{}, nil
+			}
+		}
+		return nil, err
+	}
+	resp := openapi.LocalTrustStatsResponseOKJSONResponse(*stats)
+	return openapi.GetLocalTrustStats200JSONResponse{LocalTrustStatsResponseOKJSONResponse: resp}, nil
+}
+
+func (svr *StrictServerImpl) getLocalTrustStats(
+	ctx context.Context, id openapi.StoredTrustId, preTrusted []int,
+) (*openapi.LocalTrustStats, error) {
+	tm, ok := svr.core.StoredTrustMatrices.Load(id)
+	if !ok {
+		return nil, server.HTTPError{Code: 404}
+	}
+	var report *graph.Report
+	if err := tm.LockAndRun(func(
+		c *sparse.Matrix, timestamp *big.Int,
+	) error {
+		n, err := c.Dim()
+		if err != nil {
+			return err
+		}
+		var p *sparse.Vector
+		if len(preTrusted) != 0 {
+			entries := make([]sparse.Entry, 0, len(preTrusted))
+			for _, i := range preTrusted {
+				if i < 0 || i >= n {
+					return server.HTTPError{
+						Code: 400,
+						Inner: fmt.Errorf(
+							"pre-trusted peer %d is out of range [0..%d)",
+							i, n),
+					}
+				}
+				entries = append(entries, sparse.Entry{Index: i, Value: 1})
+			}
+			p = sparse.NewVector(n, entries)
+		}
+		report, err = graph.Analyze(ctx, c, p)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &openapi.LocalTrustStats{
+		NumPeers:               report.NumPeers,
+		NumEdges:               report.NumEdges,
+		NumNegativeEdges:       report.NumNegativeEdges,
+		NumSelfLoops:           report.NumSelfLoops,
+		NumDangling:            report.NumDangling,
+		OutDegree:              apiDegreeStats(report.OutDegree),
+		InDegree:               apiDegreeStats(report.InDegree),
+		NumComponents:          report.NumComponents,
+		LargestComponent:       report.LargestComponent,
+		NumSingletonComponents: report.NumSingletonComponents,
+		NumUnreachable:         report.NumUnreachable,
+		UnreachableShare:       report.UnreachableShare,
+	}, nil
+}
+
+func apiDegreeStats(s graph.DegreeStats) openapi.DegreeStats {
+	return openapi.DegreeStats{
+		Min:       s.Min,
+		Max:       s.Max,
+		Mean:      s.Mean,
+		Median:    s.Median,
+		Histogram: s.Histogram,
+	}
+}
+
 func (svr *StrictServerImpl) HeadLocalTrust(
 	_ context.Context, request openapi.HeadLocalTrustRequestObject,
 ) (openapi.HeadLocalTrustResponseObject, error) {
@@ -324,11 +945,25 @@ func (svr *StrictServerImpl) UpdateLocalTrust(
 	ctx context.Context, request openapi.UpdateLocalTrustRequestObject,
 ) (openapi.UpdateLocalTrustResponseObject, error) {
 	logger := util.LoggerWithCaller(*zerolog.Ctx(ctx))
-	c, err := svr.loadTrustMatrix(ctx, request.Body)
+	var (
+		c         *sparse.CSRMatrix
+		edgeTimes *sparse.Matrix
+		err       error
+	)
+	switch {
+	case request.JSONBody != nil:
+		c, edgeTimes, err = svr.loadTrustMatrixWithEdgeTimes(ctx,
+			request.JSONBody)
+	case request.Body != nil:
+		c, edgeTimes, err = loadJSONLinesTrustMatrix(ctx, request.Body,
+			&openapi.JSONLinesFormat{Fields: request.Params.Fields})
+	default:
+		err = errors.New("unsupported request body type")
+	}
 	if err != nil {
-		return nil, server.HTTPError{
-			Code: 400, Inner: fmt.Errorf("cannot load local trust: %w", err),
-		}
+		var resp openapi.UpdateLocalTrust400JSONResponse
+		resp.Message = fmt.Sprintf("cannot load local trust: %v", err)
+		return resp, nil
 	}
 	cDim, err := c.Dim()
 	if err != nil {
@@ -343,15 +978,24 @@ func (svr *StrictServerImpl) UpdateLocalTrust(
 		created bool
 	)
 	if request.Params.Merge != nil && *request.Params.Merge {
-		tm, created = svr.core.StoredTrustMatrices.Merge(request.Id, c)
+		tm, created = svr.core.StoredTrustMatrices.Merge(request.Id, c,
+			edgeTimes)
 	} else {
-		tm, created = svr.core.StoredTrustMatrices.Set(request.Id, c)
+		tm, created = svr.core.StoredTrustMatrices.Set(request.Id, c,
+			edgeTimes)
 	}
-	_ = tm.LockAndRun(func(c *sparse.Matrix, timestamp *big.Int) error {
+	_ = tm.LockAndRunWithEdgeTimes(func(
+		c, edgeTimes *sparse.Matrix, timestamp *big.Int,
+	) error {
 		err1 := c.Mmap(ctx)
 		if err1 != nil {
 			logger.Err(err1).Msg("cannot swap out local trust")
 		}
+		if edgeTimes != nil {
+			if err1 = edgeTimes.Mmap(ctx); err1 != nil {
+				logger.Err(err1).Msg("cannot swap out edge times")
+			}
+		}
 		return nil
 	})
 	if created {
@@ -375,49 +1019,97 @@ func (svr *StrictServerImpl) loadTrustMatrix(
 	ctx context.Context,
 	ref *openapi.TrustRef,
 ) (*sparse.Matrix, error) {
+	c, _, err := svr.loadTrustMatrixWithEdgeTimes(ctx, ref)
+	return c, err
+}
+
+// loadTrustMatrixWithEdgeTimes loads the given trust matrix
+// along with its edge times (nil if none);
+// see server.TrustMatrix.LockAndRunWithEdgeTimes.
+func (svr *StrictServerImpl) loadTrustMatrixWithEdgeTimes(
+	ctx context.Context,
+	ref *openapi.TrustRef,
+) (c, edgeTimes *sparse.Matrix, err error) {
 	switch ref.Scheme {
 	case openapi.Inline:
 		inline, err := ref.AsInlineTrustRef()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		return svr.loadInlineTrustMatrix(&inline)
+		return svr.loadInlineTrustMatrix(ctx, &inline)
 	case openapi.Stored:
 		stored, err := ref.AsStoredTrustRef()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		return svr.loadStoredTrustMatrix(&stored)
 	case openapi.Objectstorage:
 		objectStorage, err := ref.AsObjectStorageTrustRef()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		return svr.loadObjectStorageTrustMatrix(ctx, &objectStorage)
 	default:
-		return nil, fmt.Errorf("unknown local trust ref type %#v", ref.Scheme)
+		return nil, nil, fmt.Errorf("unknown local trust ref type %#v",
+			ref.Scheme)
 	}
 }
 
-func (svr *StrictServerImpl) loadInlineTrustMatrix(
-	inline *openapi.InlineTrustRef,
+// loadDecayedTrustMatrix loads the given trust matrix,
+// then applies decay to it, if not nil, by its edge times as of now.
+// Decay of a trust matrix without edge times is an error.
+func (svr *StrictServerImpl) loadDecayedTrustMatrix(
+	ctx context.Context, ref *openapi.TrustRef,
+	decay basic.DecayFunc, now time.Time,
 ) (*sparse.Matrix, error) {
+	if decay == nil {
+		return svr.loadTrustMatrix(ctx, ref)
+	}
+	c, edgeTimes, err := svr.loadTrustMatrixWithEdgeTimes(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if edgeTimes == nil {
+		return nil, server.HTTPError{
+			Code: 400,
+			Inner: errors.New(
+				"decay requested but trust matrix has no edge times"),
+		}
+	}
+	err = basic.DecayLocalTrust(ctx, c, edgeTimes, now, decay)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decay local trust: %w", err)
+	}
+	return c, nil
+}
+
+// loadInlineTrustMatrix loads the given inline trust matrix
+// along with its edge times (nil if no entry has t).
+func (svr *StrictServerImpl) loadInlineTrustMatrix(
+	ctx context.Context, inline *openapi.InlineTrustRef,
+) (c, edgeTimes *sparse.Matrix, err error) {
 	if inline.Size <= 0 {
-		return nil, fmt.Errorf("invalid size=%#v", inline.Size)
+		return nil, nil, fmt.Errorf("invalid size=%#v", inline.Size)
 	}
-	var entries []sparse.CooEntry
+	var (
+		entries  []sparse.CooEntry
+		times    []sparse.CooEntry
+		hasTimes bool
+	)
 	for idx, entry := range inline.Entries {
 		ij, err := entry.AsTrustMatrixEntryIndices()
 		if err != nil {
-			return nil, fmt.Errorf("entry %d: invalid or missing i/j: %w",
-				idx, err)
+			return nil, nil, fmt.Errorf(
+				"entry %d: invalid or missing i/j: %w", idx, err)
 		}
 		if ij.I < 0 || ij.I >= inline.Size {
-			return nil, fmt.Errorf("entry %d: i=%d is out of range [0..%d)",
+			return nil, nil, fmt.Errorf(
+				"entry %d: i=%d is out of range [0..%d)",
 				idx, ij.I, inline.Size)
 		}
 		if ij.J < 0 || ij.J >= inline.Size {
-			return nil, fmt.Errorf("entry %d: j=%d is out of range [0..%d)",
+			return nil, nil, fmt.Errorf(
+				"entry %d: j=%d is out of range [0..%d)",
 				idx, ij.J, inline.Size)
 		}
 		entries = append(entries, sparse.CooEntry{
@@ -425,25 +1117,48 @@ func (svr *StrictServerImpl) loadInlineTrustMatrix(
 			Column: ij.J,
 			Value:  entry.V,
 		})
+		// Entries without t get zero (none), overriding when merged.
+		coo := sparse.CooEntry{Row: ij.I, Column: ij.J}
+		if entry.T != nil {
+			coo.Value = float64(*entry.T)
+			hasTimes = true
+		}
+		times = append(times, coo)
 	}
 	// reset after move
 	size := inline.Size
 	inline.Size = 0
 	inline.Entries = nil
-	return sparse.NewCSRMatrix(size, size, entries, false), nil
+	// Negative entries are distrust; see basic.ExtractDistrust.
+	c, err = sparse.NewCSRMatrixFromEntries(ctx, entries,
+		spopt.FixedDim(size, size), spopt.AllowNegative)
+	if err != nil || !hasTimes {
+		return c, nil, err
+	}
+	edgeTimes, err = sparse.NewCSRMatrixFromEntries(ctx, times,
+		spopt.FixedDim(size, size), spopt.IncludeZero)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid entry times: %w", err)
+	}
+	return c, edgeTimes, nil
 }
 
 func (svr *StrictServerImpl) loadStoredTrustMatrix(
 	stored *openapi.StoredTrustRef,
-) (c *sparse.Matrix, err error) {
+) (c, edgeTimes *sparse.Matrix, err error) {
 	tm0, ok := svr.core.StoredTrustMatrices.Load(stored.Id)
 	if ok {
 		// Caller may modify returned c in-place (canonicalize, size-match)
 		// so return a disposable copy, preserving the original.
 		// This is slow: It takes ~3s to copy 16M nonzero entries.
 		// TODO(ek): Implement on-demand canonicalization and remove this.
-		_ = tm0.LockAndRun(func(c0 *sparse.Matrix, timestamp *big.Int) error {
+		_ = tm0.LockAndRunWithEdgeTimes(func(
+			c0, edgeTimes0 *sparse.Matrix, timestamp *big.Int,
+		) error {
 			c = deepcopy.Copy(c0).(*sparse.Matrix)
+			if edgeTimes0 != nil {
+				edgeTimes = deepcopy.Copy(edgeTimes0).(*sparse.Matrix)
+			}
 			return nil
 		})
 	} else {
@@ -454,93 +1169,125 @@ func (svr *StrictServerImpl) loadStoredTrustMatrix(
 	return
 }
 
-func (svr *StrictServerImpl) loadObjectStorageTrustMatrix(
-	ctx context.Context, ref *openapi.ObjectStorageTrustRef,
-) (*sparse.Matrix, error) {
-	u, err := url.Parse(ref.Url)
+// loadTransposedTrustMatrix loads the transpose of the given stored matrix.
+//
+// The transpose is cached in the stored matrix;
+// the returned matrix is a disposable copy of it.
+func (svr *StrictServerImpl) loadTransposedTrustMatrix(
+	ctx context.Context, ref *openapi.TrustRef,
+) (ct *sparse.Matrix, err error) {
+	stored, err := ref.AsStoredTrustRef()
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse object storage URL: %w", err)
+		return nil, err
 	}
-	switch strings.ToLower(u.Scheme) {
-	case "s3":
-		bucket := u.Host
-		path := strings.TrimPrefix(u.Path, "/")
-		return svr.loadS3TrustMatrix(ctx, bucket, path)
-	case "file":
-		if !svr.UseFileURI {
-			return nil, fmt.Errorf("file: URI is disabled in this server")
+	tm0, ok := svr.core.StoredTrustMatrices.Load(stored.Id)
+	if !ok {
+		return nil, server.HTTPError{
+			Code: 400, Inner: errors.New("trust matrix not found"),
 		}
-		return svr.loadFileTrustMatrix(u.Path)
-	default:
-		return nil, fmt.Errorf("unknown object storage URL scheme %#v",
-			u.Scheme)
 	}
+	err = tm0.LockAndRunTransposed(ctx, func(
+		ct0 *sparse.Matrix, timestamp *big.Int,
+	) error {
+		ct = ct0.Clone()
+		return nil
+	})
+	return
 }
 
-func (svr *StrictServerImpl) loadS3TrustMatrix(
-	ctx context.Context, bucket string, key string,
-) (*sparse.Matrix, error) {
-	res, err := svr.core.LoadS3Object(ctx, bucket, key)
+// loadObjectStorageTrustMatrix loads the given object storage trust matrix
+// along with its edge times (nil if none),
+// read from the "t" field, if any, of the CSV header or JSON Lines objects.
+func (svr *StrictServerImpl) loadObjectStorageTrustMatrix(
+	ctx context.Context, ref *openapi.ObjectStorageTrustRef,
+) (c, edgeTimes *sparse.Matrix, err error) {
+	if openapi.IsJSONLines(ref.Url) {
+		r, err := svr.openObjectStorage(ctx, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer util.Close(r)
+		return loadJSONLinesTrustMatrix(ctx, r, ref.JsonLines)
+	}
+	opts, err := openapi.DelimitedTextOptions(ref.Format, ref.Url, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid format: %w", err)
+	}
+	r, err := svr.openObjectStorage(ctx, ref)
 	if err != nil {
-		return nil, fmt.Errorf("cannot load trust matrix from S3: %w", err)
+		return nil, nil, err
 	}
-	defer util.Close(res.Body)
-	return svr.loadCSVTrustMatrix(csv.NewReader(res.Body))
+	defer util.Close(r)
+	c, edgeTimes, err = sparse.NewCSRMatrixWithTimesFromCSV(ctx,
+		sparse.NewCSVReader(r, opts...), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	squareWithEdgeTimes(c, edgeTimes)
+	return c, edgeTimes, nil
 }
 
-func (svr *StrictServerImpl) loadFileTrustMatrix(
-	path string,
-) (*sparse.Matrix, error) {
-	f, err := os.Open(path)
+// loadJSONLinesTrustMatrix loads a trust matrix in the given JSON Lines format
+// along with its edge times (nil if none), read from the "t" member, if any.
+func loadJSONLinesTrustMatrix(
+	ctx context.Context, r io.Reader, format *openapi.JSONLinesFormat,
+) (c, edgeTimes *sparse.Matrix, err error) {
+	opts, err := openapi.JSONLinesOptions(format, true)
 	if err != nil {
-		return nil, err
+		return nil, nil, fmt.Errorf("invalid JSON Lines format: %w", err)
+	}
+	c, edgeTimes, err = sparse.NewCSRMatrixWithTimesFromJSONL(ctx, r, opts...)
+	if err != nil {
+		return nil, nil, err
 	}
-	defer util.Close(f)
-	return svr.loadCSVTrustMatrix(csv.NewReader(f))
+	squareWithEdgeTimes(c, edgeTimes)
+	return c, edgeTimes, nil
 }
 
-func (svr *StrictServerImpl) loadCSVTrustMatrix(
-	r util.CSVReader,
-) (*sparse.Matrix, error) {
-	header, err := r.Read()
-	if err != nil {
-		return nil, fmt.Errorf("cannot read CSV header: %w", err)
+// squareWithEdgeTimes grows c and its edge times (if not nil) into square.
+func squareWithEdgeTimes(c, edgeTimes *sparse.Matrix) {
+	rows, cols := c.Dims()
+	size := max(rows, cols)
+	c.SetDim(size, size)
+	if edgeTimes != nil {
+		edgeTimes.SetDim(size, size)
 	}
-	if !reflect.DeepEqual(header, []string{"i", "j", "v"}) {
-		return nil, fmt.Errorf("invalid CSV header %#v", header)
+}
+
+// openObjectStorage opens the object referred to by ref for reading.
+func (svr *StrictServerImpl) openObjectStorage(
+	ctx context.Context, ref *openapi.ObjectStorageTrustRef,
+) (io.ReadCloser, error) {
+	u, err := url.Parse(ref.Url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse object storage URL: %w", err)
 	}
-	var entries []sparse.CooEntry
-	var size = 0
-	for record, err := r.Read(); err == nil; record, err = r.Read() {
-		if len(record) != 3 {
-			return nil, fmt.Errorf("invalid CSV record %#v", record)
-		}
-		i, err := strconv.Atoi(record[0])
-		if err != nil {
-			return nil, fmt.Errorf("invalid i=%#v: %w", record[0], err)
-		}
-		j, err := strconv.Atoi(record[1])
-		if err != nil {
-			return nil, fmt.Errorf("invalid j=%#v: %w", record[1], err)
-		}
-		v, err := strconv.ParseFloat(record[2], 64)
+	switch strings.ToLower(u.Scheme) {
+	case "s3":
+		bucket := u.Host
+		path := strings.TrimPrefix(u.Path, "/")
+		res, err := svr.core.LoadS3Object(ctx, bucket, path)
 		if err != nil {
-			return nil, fmt.Errorf("invalid v=%#v: %w", record[2], err)
+			return nil, fmt.Errorf("cannot load object from S3: %w", err)
 		}
-		if size < i+1 {
-			size = i + 1
-		}
-		if size < j+1 {
-			size = j + 1
+		return res.Body, nil
+	case "file":
+		if !svr.UseFileURI {
+			return nil, fmt.Errorf("file: URI is disabled in this server")
 		}
-		entries = append(entries, sparse.CooEntry{Row: i, Column: j, Value: v})
+		return os.Open(u.Path)
+	default:
+		return nil, fmt.Errorf("unknown object storage URL scheme %#v",
+			u.Scheme)
 	}
-	return sparse.NewCSRMatrix(size, size, entries, false), nil
 }
 
+// loadTrustVector loads the given trust vector.
+// opts (e.g. spopt.IncludeZero) decide which entry values are valid.
 func (svr *StrictServerImpl) loadTrustVector(
 	ctx context.Context,
 	ref *openapi.TrustRef,
+	opts ...spopt.Option,
 ) (*sparse.Vector, error) {
 	switch ref.Scheme {
 	case openapi.Inline:
@@ -548,21 +1295,22 @@ func (svr *StrictServerImpl) loadTrustVector(
 		if err != nil {
 			return nil, err
 		}
-		return loadInlineTrustVector(&inline)
+		return loadInlineTrustVector(&inline, opts...)
 	case openapi.Objectstorage:
 		objectStorage, err := ref.AsObjectStorageTrustRef()
 		if err != nil {
 			return nil, err
 		}
-		return svr.loadObjectStorageTrustVector(ctx, &objectStorage)
+		return svr.loadObjectStorageTrustVector(ctx, &objectStorage, opts...)
 	default:
 		return nil, fmt.Errorf("unknown trust vector ref type %#v", ref.Scheme)
 	}
 }
 
-func loadInlineTrustVector(inline *openapi.InlineTrustRef) (
-	*sparse.Vector, error,
-) {
+func loadInlineTrustVector(
+	inline *openapi.InlineTrustRef, opts ...spopt.Option,
+) (*sparse.Vector, error) {
+	o := spopt.New(opts...)
 	var entries []sparse.Entry
 	for idx, entry := range inline.Entries {
 		i, err := entry.AsTrustVectorEntryIndex()
@@ -574,9 +1322,11 @@ func loadInlineTrustVector(inline *openapi.InlineTrustRef) (
 			return nil, fmt.Errorf("entry %d: i=%d is out of range [0..%d)",
 				idx, i.I, inline.Size)
 		}
-		if entry.V <= 0 {
-			return nil, fmt.Errorf("entry %d: v=%f is out of range (0, inf)",
-				idx, entry.V)
+		switch {
+		case entry.V < 0 && !o.Value.AllowNegative:
+			return nil, fmt.Errorf("entry %d: v=%f is negative", idx, entry.V)
+		case entry.V == 0 && !o.Value.IncludeZero:
+			return nil, fmt.Errorf("entry %d: v=0 is not allowed", idx)
 		}
 		entries = append(entries, sparse.Entry{Index: i.I, Value: entry.V})
 	}
@@ -589,79 +1339,31 @@ func loadInlineTrustVector(inline *openapi.InlineTrustRef) (
 
 func (svr *StrictServerImpl) loadObjectStorageTrustVector(
 	ctx context.Context, ref *openapi.ObjectStorageTrustRef,
+	opts ...spopt.Option,
 ) (*sparse.Vector, error) {
-	u, err := url.Parse(ref.Url)
-	if err != nil {
-		return nil, fmt.Errorf("cannot parse object storage URL: %w", err)
-	}
-	switch strings.ToLower(u.Scheme) {
-	case "s3":
-		bucket := u.Host
-		path := strings.TrimPrefix(u.Path, "/")
-		return svr.loadS3TrustVector(ctx, bucket, path)
-	case "file":
-		if !svr.UseFileURI {
-			return nil, fmt.Errorf("file: URI is disabled in this server")
+	if openapi.IsJSONLines(ref.Url) {
+		fieldOpts, err := openapi.JSONLinesOptions(ref.JsonLines, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON Lines format: %w", err)
 		}
-		return svr.loadFileTrustVector(u.Path)
-	default:
-		return nil, fmt.Errorf("unknown object storage URL scheme %#v",
-			u.Scheme)
+		r, err := svr.openObjectStorage(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(r)
+		opts = append(fieldOpts, opts...)
+		return sparse.NewVectorFromJSONL(ctx, r, opts...)
 	}
-}
-
-func (svr *StrictServerImpl) loadS3TrustVector(
-	ctx context.Context, bucket string, key string,
-) (*sparse.Vector, error) {
-	res, err := svr.core.LoadS3Object(ctx, bucket, key)
+	textOpts, err := openapi.DelimitedTextOptions(ref.Format, ref.Url, false)
 	if err != nil {
-		return nil, fmt.Errorf("cannot load trust vector from S3: %w", err)
+		return nil, fmt.Errorf("invalid format: %w", err)
 	}
-	defer util.Close(res.Body)
-	r := csv.NewReader(res.Body)
-	return svr.loadCsvTrustVector(r)
-
-}
-
-func (svr *StrictServerImpl) loadFileTrustVector(
-	path string,
-) (*sparse.Vector, error) {
-	f, err := os.Open(path)
+	opts = append(textOpts, opts...)
+	r, err := svr.openObjectStorage(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
-	defer util.Close(f)
-	return svr.loadCsvTrustVector(csv.NewReader(f))
-}
-
-func (svr *StrictServerImpl) loadCsvTrustVector(
-	r *csv.Reader,
-) (*sparse.Vector, error) {
-	header, err := r.Read()
-	if err != nil {
-		return nil, fmt.Errorf("cannot read CSV header: %w", err)
-	}
-	if !reflect.DeepEqual(header, []string{"i", "v"}) {
-		return nil, fmt.Errorf("invalid CSV header %#v", header)
-	}
-	var entries []sparse.Entry
-	var size = 0
-	for record, err := r.Read(); err == nil; record, err = r.Read() {
-		if len(record) != 2 {
-			return nil, fmt.Errorf("invalid CSV record %#v", record)
-		}
-		i, err := strconv.Atoi(record[0])
-		if err != nil {
-			return nil, fmt.Errorf("invalid i=%#v: %w", record[0], err)
-		}
-		v, err := strconv.ParseFloat(record[1], 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid v=%#v: %w", record[1], err)
-		}
-		if size < i+1 {
-			size = i + 1
-		}
-		entries = append(entries, sparse.Entry{Index: i, Value: v})
-	}
-	return sparse.NewVector(size, entries), nil
+	defer util.Close(r)
+	return sparse.NewVectorFromCSV(ctx, sparse.NewCSVReader(r, opts...),
+		opts...)
 }
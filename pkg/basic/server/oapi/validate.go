@@ -0,0 +1,165 @@
+// The rest of the server (openapi.go) uses cgo.
+
+//go:build cgo
+
+package oapiserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k3l.io/go-eigentrust/pkg/api/openapi"
+	"k3l.io/go-eigentrust/pkg/basic/server"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+func (svr *StrictServerImpl) ValidateLocalTrust(
+	ctx context.Context, request openapi.ValidateLocalTrustRequestObject,
+) (openapi.ValidateLocalTrustResponseObject, error) {
+	issues, err := svr.validateTrustMatrix(ctx, request.Body)
+	if err != nil {
+		var httpError server.HTTPError
+		if errors.As(err, &httpError) {
+			switch httpError.Code {
+			case 400:
+				var resp openapi.ValidateLocalTrust400JSONResponse
+				resp.Message = httpError.Inner.Error()
+				return resp, nil
+			}
+		}
+		return nil, err
+	}
+	resp := openapi.ValidationResponseOKJSONResponse{
+		Issues: make([]openapi.ValidationIssue, 0, len(issues)),
+	}
+	resp.Issues = append(resp.Issues, issues...)
+	return openapi.ValidateLocalTrust200JSONResponse{ValidationResponseOKJSONResponse: resp}, nil
+}
+
+// validateTrustMatrix checks the given trust matrix ref
+// the same way loadTrustMatrix loads it, and returns every problem found.
+func (svr *StrictServerImpl) validateTrustMatrix(
+	ctx context.Context, ref *openapi.TrustRef,
+) ([]openapi.ValidationIssue, error) {
+	switch ref.Scheme {
+	case openapi.Inline:
+		inline, err := ref.AsInlineTrustRef()
+		if err != nil {
+			return nil, server.HTTPError{Code: 400, Inner: err}
+		}
+		return validateInlineTrustMatrix(&inline), nil
+	case openapi.Stored:
+		// Already loaded and validated.
+		return nil, nil
+	case openapi.Objectstorage:
+		objectStorage, err := ref.AsObjectStorageTrustRef()
+		if err != nil {
+			return nil, server.HTTPError{Code: 400, Inner: err}
+		}
+		if openapi.IsJSONLines(objectStorage.Url) {
+			return svr.validateJSONLinesTrustMatrix(ctx, &objectStorage)
+		}
+		opts, err := openapi.DelimitedTextOptions(objectStorage.Format,
+			objectStorage.Url, true)
+		if err != nil {
+			return nil, server.HTTPError{
+				Code: 400, Inner: fmt.Errorf("invalid format: %w", err),
+			}
+		}
+		r, err := svr.openObjectStorage(ctx, &objectStorage)
+		if err != nil {
+			return nil, server.HTTPError{Code: 400, Inner: err}
+		}
+		defer util.Close(r)
+		csvIssues, err := sparse.ValidateMatrixCSV(ctx,
+			sparse.NewCSVReader(r, opts...), opts...)
+		if err != nil {
+			return nil, server.HTTPError{
+				Code: 400, Inner: fmt.Errorf("cannot read CSV: %w", err),
+			}
+		}
+		return util.Map(csvIssues, apiCSVIssue), nil
+	default:
+		return nil, server.HTTPError{
+			Code:  400,
+			Inner: fmt.Errorf("unknown local trust ref type %#v", ref.Scheme),
+		}
+	}
+}
+
+// validateJSONLinesTrustMatrix checks the given JSON Lines trust matrix
+// the same way loadJSONLinesTrustMatrix loads it, including entry times.
+func (svr *StrictServerImpl) validateJSONLinesTrustMatrix(
+	ctx context.Context, ref *openapi.ObjectStorageTrustRef,
+) ([]openapi.ValidationIssue, error) {
+	opts, err := openapi.JSONLinesOptions(ref.JsonLines, true)
+	if err != nil {
+		return nil, server.HTTPError{
+			Code: 400, Inner: fmt.Errorf("invalid JSON Lines format: %w", err),
+		}
+	}
+	opts = append([]spopt.Option{spopt.TimeNamed("t")}, opts...)
+	r, err := svr.openObjectStorage(ctx, ref)
+	if err != nil {
+		return nil, server.HTTPError{Code: 400, Inner: err}
+	}
+	defer util.Close(r)
+	issues, err := sparse.ValidateMatrixJSONL(ctx, r, opts...)
+	if err != nil {
+		return nil, server.HTTPError{
+			Code: 400, Inner: fmt.Errorf("cannot read JSON Lines: %w", err),
+		}
+	}
+	return util.Map(issues, apiCSVIssue), nil
+}
+
+func apiCSVIssue(issue sparse.CSVIssue) openapi.ValidationIssue {
+	result := openapi.ValidationIssue{
+		Line:    &issue.Line,
+		Message: issue.Err.Error(),
+	}
+	if issue.Column != 0 {
+		result.Column = &issue.Column
+	}
+	if issue.Field != "" {
+		result.Field = &issue.Field
+	}
+	return result
+}
+
+// validateInlineTrustMatrix checks the given inline trust matrix
+// the same way loadInlineTrustMatrix loads it;
+// negative entries are distrust, not issues.
+func validateInlineTrustMatrix(
+	inline *openapi.InlineTrustRef,
+) (issues []openapi.ValidationIssue) {
+	if inline.Size <= 0 {
+		issues = append(issues, openapi.ValidationIssue{
+			Message: fmt.Sprintf("invalid size=%#v", inline.Size),
+		})
+	}
+	for idx, entry := range inline.Entries {
+		addIssue := func(format string, args ...any) {
+			idx := idx
+			issues = append(issues, openapi.ValidationIssue{
+				Entry:   &idx,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+		ij, err := entry.AsTrustMatrixEntryIndices()
+		if err != nil {
+			addIssue("invalid or missing i/j: %v", err)
+			continue
+		}
+		if ij.I < 0 || ij.I >= inline.Size {
+			addIssue("i=%d is out of range [0..%d)", ij.I, inline.Size)
+		}
+		if ij.J < 0 || ij.J >= inline.Size {
+			addIssue("j=%d is out of range [0..%d)", ij.J, inline.Size)
+		}
+	}
+	return issues
+}
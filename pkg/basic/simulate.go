@@ -0,0 +1,200 @@
+package basic
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// OverlayLocalTrust returns a copy-on-write overlay of the local trust (c)
+// with the given changes applied,
+// for simulating hypothetical local trust without modifying c.
+//
+// Each change sets the local trust at (Row, Column) to Value;
+// zero removes the entry.  Later changes override earlier ones.
+// Changes outside c grow the overlay (but not c) to fit them.
+//
+// The overlay shares the unchanged rows with c,
+// so neither may be modified in-place while the other is in use;
+// Transpose (or Clone) the overlay before canonicalizing it.
+func OverlayLocalTrust(
+	c *sparse.Matrix, changes []sparse.CooEntry,
+) (*sparse.Matrix, error) {
+	rows, cols := c.Dims()
+	byRow := make(map[int][]sparse.Entry)
+	for _, change := range changes {
+		if change.Row < 0 || change.Column < 0 {
+			return nil, fmt.Errorf("negative index in change %#v", change)
+		}
+		if math.IsNaN(change.Value) || math.IsInf(change.Value, 0) {
+			return nil, fmt.Errorf("invalid value in change %#v", change)
+		}
+		byRow[change.Row] = append(byRow[change.Row], sparse.Entry{
+			Index: change.Column, Value: change.Value,
+		})
+		rows = max(rows, change.Row+1)
+		cols = max(cols, change.Column+1)
+	}
+	if _, err := c.Dim(); err == nil {
+		// Keep a square matrix square.
+		rows = max(rows, cols)
+		cols = rows
+	}
+	overlay := &sparse.Matrix{CSMatrix: sparse.CSMatrix{
+		MajorDim: rows,
+		MinorDim: cols,
+		Entries:  make([][]sparse.Entry, rows),
+	}}
+	copy(overlay.Entries, c.Entries)
+	for row, rowChanges := range byRow {
+		overlay.Entries[row] = overlaySpan(overlay.Entries[row], rowChanges)
+	}
+	return overlay, nil
+}
+
+// OverlayTrustVector returns a copy of the trust vector (v)
+// with the given changes applied, as in OverlayLocalTrust.
+func OverlayTrustVector(
+	v *sparse.Vector, changes []sparse.Entry,
+) (*sparse.Vector, error) {
+	dim := v.Dim
+	for _, change := range changes {
+		if change.Index < 0 {
+			return nil, fmt.Errorf("negative index in change %#v", change)
+		}
+		if math.IsNaN(change.Value) || math.IsInf(change.Value, 0) {
+			return nil, fmt.Errorf("invalid value in change %#v", change)
+		}
+		dim = max(dim, change.Index+1)
+	}
+	return &sparse.Vector{
+		Dim:     dim,
+		Entries: overlaySpan(v.Entries, changes),
+	}, nil
+}
+
+// overlaySpan returns a new sorted span
+// with the given changes applied to the given sorted span.
+func overlaySpan(span []sparse.Entry, changes []sparse.Entry) []sparse.Entry {
+	values := make(map[int]float64, len(changes))
+	for _, change := range changes {
+		values[change.Index] = change.Value
+	}
+	result := make([]sparse.Entry, 0, len(span)+len(values))
+	for _, e := range span {
+		if _, changed := values[e.Index]; !changed {
+			result = append(result, e)
+		}
+	}
+	for index, value := range values {
+		if value != 0 {
+			result = append(result, sparse.Entry{Index: index, Value: value})
+		}
+	}
+	slices.SortFunc(result, func(e1, e2 sparse.Entry) int {
+		return cmp.Compare(e1.Index, e2.Index)
+	})
+	return result
+}
+
+// ScoreChange is the change in one peer's global trust
+// between two trust vectors; see CompareTrustVectors.
+type ScoreChange struct {
+	Peer int `json:"peer"`
+
+	// Before and After are the scores of the peer.
+	Before float64 `json:"before"`
+	After  float64 `json:"after"`
+
+	// RankBefore and RankAfter are the 1-based ranks of the peer,
+	// i.e. 1 + the number of peers with a higher score.
+	RankBefore int `json:"rankBefore"`
+	RankAfter  int `json:"rankAfter"`
+}
+
+// Delta returns the score change, i.e. After - Before.
+func (sc ScoreChange) Delta() float64 { return sc.After - sc.Before }
+
+// CompareTrustVectors compares two global trust vectors,
+// e.g. the stored result and a simulated one,
+// and returns the peers whose scores changed by more than minChange,
+// in descending order of the absolute change.
+//
+// Ranks take all peers into account, including unaffected ones.
+// The shorter vector is treated as zero-padded.
+func CompareTrustVectors(
+	before, after *sparse.Vector, minChange float64,
+) []ScoreChange {
+	dim := max(before.Dim, after.Dim)
+	rankBefore := newRanker(before, dim)
+	rankAfter := newRanker(after, dim)
+	var changes []ScoreChange
+	add := func(peer int, t0, t1 float64) {
+		if math.Abs(t1-t0) > minChange {
+			changes = append(changes, ScoreChange{
+				Peer:       peer,
+				Before:     t0,
+				After:      t1,
+				RankBefore: rankBefore.rank(t0),
+				RankAfter:  rankAfter.rank(t1),
+			})
+		}
+	}
+	// Merge-join the sorted entries.
+	e0, e1 := before.Entries, after.Entries
+	for len(e0) != 0 || len(e1) != 0 {
+		switch {
+		case len(e1) == 0 || len(e0) != 0 && e0[0].Index < e1[0].Index:
+			add(e0[0].Index, e0[0].Value, 0)
+			e0 = e0[1:]
+		case len(e0) == 0 || e1[0].Index < e0[0].Index:
+			add(e1[0].Index, 0, e1[0].Value)
+			e1 = e1[1:]
+		default:
+			add(e0[0].Index, e0[0].Value, e1[0].Value)
+			e0, e1 = e0[1:], e1[1:]
+		}
+	}
+	slices.SortStableFunc(changes, func(sc1, sc2 ScoreChange) int {
+		return cmp.Compare(math.Abs(sc2.Delta()), math.Abs(sc1.Delta()))
+	})
+	return changes
+}
+
+// ranker ranks scores within a trust vector.
+type ranker struct {
+	// values are the nonzero scores, in descending order.
+	values []float64
+
+	// zeros is the number of zero scores.
+	zeros int
+}
+
+func newRanker(v *sparse.Vector, dim int) *ranker {
+	r := &ranker{values: make([]float64, 0, len(v.Entries))}
+	for _, e := range v.Entries {
+		if e.Value != 0 {
+			r.values = append(r.values, e.Value)
+		}
+	}
+	slices.SortFunc(r.values, func(v1, v2 float64) int {
+		return cmp.Compare(v2, v1)
+	})
+	r.zeros = dim - len(r.values)
+	return r
+}
+
+// rank returns the 1-based rank of the given score.
+func (r *ranker) rank(score float64) int {
+	higher := sort.Search(len(r.values), func(i int) bool {
+		return r.values[i] <= score
+	})
+	if score < 0 {
+		higher += r.zeros
+	}
+	return 1 + higher
+}
@@ -0,0 +1,112 @@
+package basic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestOverlayLocalTrust(t *testing.T) {
+	c := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 0, Column: 2, Value: 2},
+		{Row: 1, Column: 2, Value: 3},
+	}, false)
+	saved := c.Clone()
+	overlay, err := OverlayLocalTrust(c, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 0},  // remove
+		{Row: 0, Column: 0, Value: 4},  // add
+		{Row: 0, Column: 2, Value: 5},  // overwritten below
+		{Row: 0, Column: 2, Value: 6},  // change
+		{Row: 3, Column: 0, Value: -1}, // grow
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, saved, c)
+	assert.Equal(t, [][]sparse.Entry{
+		{{Index: 0, Value: 4}, {Index: 2, Value: 6}},
+		{{Index: 2, Value: 3}},
+		nil,
+		{{Index: 0, Value: -1}},
+	}, overlay.Entries)
+	assert.Equal(t, 4, overlay.MajorDim)
+	assert.Equal(t, 4, overlay.MinorDim)
+	// Unchanged rows are shared.
+	assert.Same(t, &c.Entries[1][0], &overlay.Entries[1][0])
+
+	_, err = OverlayLocalTrust(c, []sparse.CooEntry{{Row: -1, Column: 0}})
+	assert.Error(t, err)
+}
+
+func TestOverlayTrustVector(t *testing.T) {
+	v := sparse.NewVector(3, []sparse.Entry{{Index: 1, Value: 1}})
+	overlay, err := OverlayTrustVector(v, []sparse.Entry{
+		{Index: 1, Value: 0}, {Index: 4, Value: 2},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, sparse.NewVector(3,
+		[]sparse.Entry{{Index: 1, Value: 1}}), v)
+	assert.Equal(t, sparse.NewVector(5,
+		[]sparse.Entry{{Index: 4, Value: 2}}), overlay)
+}
+
+func TestCompareTrustVectors(t *testing.T) {
+	before := sparse.NewVector(4, []sparse.Entry{
+		{Index: 0, Value: 0.5},
+		{Index: 1, Value: 0.3},
+		{Index: 2, Value: 0.2},
+	})
+	after := sparse.NewVector(5, []sparse.Entry{
+		{Index: 0, Value: 0.5},
+		{Index: 1, Value: 0.05},
+		{Index: 3, Value: 0.6},
+		{Index: 4, Value: -0.2},
+	})
+	assert.Equal(t, []ScoreChange{
+		{Peer: 3, Before: 0, After: 0.6, RankBefore: 4, RankAfter: 1},
+		{Peer: 1, Before: 0.3, After: 0.05, RankBefore: 2, RankAfter: 3},
+		{Peer: 2, Before: 0.2, After: 0, RankBefore: 3, RankAfter: 4},
+		{Peer: 4, Before: 0, After: -0.2, RankBefore: 4, RankAfter: 5},
+	}, CompareTrustVectors(before, after, 0))
+	assert.Len(t, CompareTrustVectors(before, after, 0.2), 2)
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	c := sparse.NewCSRMatrix(3, 3, []sparse.CooEntry{
+		{Row: 0, Column: 1, Value: 1},
+		{Row: 1, Column: 2, Value: 1},
+		{Row: 2, Column: 0, Value: 1},
+	}, false)
+	p := sparse.NewVector(3, []sparse.Entry{{Index: 0, Value: 1}})
+	compute := func(c *sparse.Matrix, p *sparse.Vector,
+		opts ...ComputeOpt) *sparse.Vector {
+		c = c.Clone()
+		p = p.Clone()
+		CanonicalizeTrustVector(p)
+		assert.NoError(t, CanonicalizeLocalTrust(c, p))
+		tv, err := Compute(ctx, c, p, 0.2, 1e-12, opts...)
+		assert.NoError(t, err)
+		return tv
+	}
+	t0 := compute(c, p)
+	// Cut 1 -> 2; 2 now gets no trust.
+	overlay, err := OverlayLocalTrust(c, []sparse.CooEntry{
+		{Row: 1, Column: 2, Value: 0},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	t1 := compute(overlay, p, WithInitialTrust(t0.Clone()))
+	changes := CompareTrustVectors(t0, t1, 1e-9)
+	if assert.Len(t, changes, 3) {
+		assert.Equal(t, 2, changes[0].Peer)
+		assert.InDelta(t, 0, changes[0].After, 1e-9)
+		assert.Equal(t, 3, changes[0].RankAfter)
+	}
+}
@@ -0,0 +1,217 @@
+// Package graph analyzes local trust matrices as directed trust graphs.
+//
+// Peer i trusting peer j (a positive entry at row i, column j)
+// is an edge from i to j.
+// Negative entries (distrust) and zero entries are not edges.
+package graph
+
+import (
+	"context"
+	"math/bits"
+	"slices"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// Report describes the structure of a local trust matrix,
+// namely what basic.Compute will see when fed with it.
+type Report struct {
+	// NumPeers is the number of peers, i.e. the matrix dimension.
+	NumPeers int `json:"numPeers"`
+
+	// NumEdges is the number of positive entries, including self-loops.
+	NumEdges int `json:"numEdges"`
+
+	// NumNegativeEdges is the number of negative (distrust) entries.
+	NumNegativeEdges int `json:"numNegativeEdges"`
+
+	// NumSelfLoops is the number of nonzero diagonal entries,
+	// i.e. peers trusting or distrusting themselves.
+	NumSelfLoops int `json:"numSelfLoops"`
+
+	// NumDangling is the number of peers without outbound trust,
+	// i.e. whose rows have no positive entries,
+	// whatever basic.DanglingStrategy later handles them.
+	NumDangling int `json:"numDangling"`
+
+	// OutDegree describes the out-degree (number of trustees) distribution.
+	OutDegree DegreeStats `json:"outDegree"`
+
+	// InDegree describes the in-degree (number of trusters) distribution.
+	InDegree DegreeStats `json:"inDegree"`
+
+	// NumComponents is the number of strongly connected components.
+	NumComponents int `json:"numComponents"`
+
+	// LargestComponent is the number of peers
+	// in the largest strongly connected component.
+	LargestComponent int `json:"largestComponent"`
+
+	// NumSingletonComponents is the number of strongly connected components
+	// consisting of only one peer.
+	NumSingletonComponents int `json:"numSingletonComponents"`
+
+	// NumUnreachable is the number of peers
+	// not reachable from any pre-trusted peer.
+	NumUnreachable int `json:"numUnreachable"`
+
+	// UnreachableShare is NumUnreachable as a fraction of NumPeers.
+	UnreachableShare float64 `json:"unreachableShare"`
+}
+
+// DegreeStats describes a degree distribution.
+type DegreeStats struct {
+	Min    int     `json:"min"`
+	Max    int     `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median int     `json:"median"`
+
+	// Histogram counts peers by degree in power-of-two buckets:
+	// Histogram[0] counts peers of degree 0,
+	// and Histogram[k] (k > 0) counts peers of degree [2^(k-1)..2^k).
+	Histogram []int `json:"histogram"`
+}
+
+// Analyze analyzes the given local trust matrix.
+//
+// preTrust determines the peers from which reachability is measured;
+// peers with positive pre-trust are pre-trusted.
+// If preTrust is nil or has no positive entry, every peer is pre-trusted,
+// same as basic.CanonicalizeTrustVector makes it uniform.
+func Analyze(
+	ctx context.Context, m *sparse.Matrix, preTrust *sparse.Vector,
+) (*Report, error) {
+	n, err := m.Dim()
+	if err != nil {
+		return nil, err
+	}
+	if preTrust != nil && preTrust.Dim != n {
+		return nil, sparse.ErrDimensionMismatch
+	}
+	r := &Report{NumPeers: n}
+	outDegrees := make([]int, n)
+	inDegrees := make([]int, n)
+	for i, span := range m.Entries {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		for _, e := range span {
+			if e.Index == i && e.Value != 0 {
+				r.NumSelfLoops++
+			}
+			switch {
+			case e.Value > 0:
+				r.NumEdges++
+				outDegrees[i]++
+				inDegrees[e.Index]++
+			case e.Value < 0:
+				r.NumNegativeEdges++
+			}
+		}
+		if outDegrees[i] == 0 {
+			r.NumDangling++
+		}
+	}
+	r.OutDegree = degreeStats(outDegrees)
+	r.InDegree = degreeStats(inDegrees)
+	labels, numComponents, err := StronglyConnectedComponents(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	r.NumComponents = numComponents
+	sizes := make([]int, numComponents)
+	for _, label := range labels {
+		sizes[label]++
+	}
+	for _, size := range sizes {
+		r.LargestComponent = max(r.LargestComponent, size)
+		if size == 1 {
+			r.NumSingletonComponents++
+		}
+	}
+	var sources []int
+	if preTrust != nil {
+		for _, e := range preTrust.Entries {
+			if e.Value > 0 {
+				sources = append(sources, e.Index)
+			}
+		}
+	}
+	if len(sources) != 0 {
+		reachable, err := Reachable(ctx, m, sources)
+		if err != nil {
+			return nil, err
+		}
+		for _, ok := range reachable {
+			if !ok {
+				r.NumUnreachable++
+			}
+		}
+	}
+	if n != 0 {
+		r.UnreachableShare = float64(r.NumUnreachable) / float64(n)
+	}
+	return r, nil
+}
+
+func degreeStats(degrees []int) (s DegreeStats) {
+	if len(degrees) == 0 {
+		return
+	}
+	sorted := slices.Clone(degrees)
+	slices.Sort(sorted)
+	s.Min, s.Max = sorted[0], sorted[len(sorted)-1]
+	s.Median = sorted[len(sorted)/2]
+	s.Histogram = make([]int, bits.Len(uint(s.Max))+1)
+	total := 0
+	for _, degree := range degrees {
+		total += degree
+		s.Histogram[bits.Len(uint(degree))]++
+	}
+	s.Mean = float64(total) / float64(len(degrees))
+	return
+}
+
+// Reachable returns which peers are reachable from the given source peers,
+// following edges (positive entries) of m.
+// Sources are reachable from themselves.
+func Reachable(
+	ctx context.Context, m *sparse.Matrix, sources []int,
+) ([]bool, error) {
+	n, err := m.Dim()
+	if err != nil {
+		return nil, err
+	}
+	reachable := make([]bool, n)
+	var queue []int
+	for _, i := range sources {
+		if i < 0 || i >= n {
+			return nil, util.IndexOutOfBoundsError{Index: i, Bound: n}
+		}
+		if !reachable[i] {
+			reachable[i] = true
+			queue = append(queue, i)
+		}
+	}
+	for visited := 0; len(queue) > 0; visited++ {
+		if visited%checkInterval == 0 {
+			if err = ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		i := queue[0]
+		queue = queue[1:]
+		for _, e := range m.Entries[i] {
+			if e.Value > 0 && !reachable[e.Index] {
+				reachable[e.Index] = true
+				queue = append(queue, e.Index)
+			}
+		}
+	}
+	return reachable, nil
+}
+
+// checkInterval is the number of peers visited
+// between context cancellation checks.
+const checkInterval = 4096
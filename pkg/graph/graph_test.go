@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// testMatrix returns a square matrix with the given dimension and entries.
+func testMatrix(n int, entries ...sparse.CooEntry) *sparse.Matrix {
+	m := &sparse.Matrix{CSMatrix: sparse.CSMatrix{
+		MajorDim: n,
+		MinorDim: n,
+		Entries:  make([][]sparse.Entry, n),
+	}}
+	for _, e := range entries {
+		m.Entries[e.Row] = append(m.Entries[e.Row],
+			sparse.Entry{Index: e.Column, Value: e.Value})
+	}
+	return m
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		m          *sparse.Matrix
+		wantLabels []int
+		wantCount  int
+	}{
+		{"Empty", testMatrix(0), []int{}, 0},
+		{"Isolated", testMatrix(2), []int{0, 1}, 2},
+		{
+			// 0 → 1 → 2 → 0, 2 → 3, 3 ⇄ 4, 5 (self-loop and distrust only)
+			"Mixed",
+			testMatrix(6,
+				sparse.CooEntry{Row: 0, Column: 1, Value: 1},
+				sparse.CooEntry{Row: 1, Column: 2, Value: 1},
+				sparse.CooEntry{Row: 2, Column: 0, Value: 1},
+				sparse.CooEntry{Row: 2, Column: 3, Value: 1},
+				sparse.CooEntry{Row: 3, Column: 4, Value: 1},
+				sparse.CooEntry{Row: 4, Column: 3, Value: 1},
+				sparse.CooEntry{Row: 5, Column: 0, Value: -1},
+				sparse.CooEntry{Row: 5, Column: 5, Value: 1},
+			),
+			[]int{1, 1, 1, 0, 0, 2}, 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels, count, err := StronglyConnectedComponents(
+				context.Background(), tt.m)
+			if err != nil {
+				t.Fatalf("StronglyConnectedComponents() error = %v", err)
+			}
+			if !reflect.DeepEqual(labels, tt.wantLabels) {
+				t.Errorf("StronglyConnectedComponents() labels = %v, want %v",
+					labels, tt.wantLabels)
+			}
+			if count != tt.wantCount {
+				t.Errorf("StronglyConnectedComponents() count = %v, want %v",
+					count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestStronglyConnectedComponents_LongPath(t *testing.T) {
+	// A long cycle would overflow a recursive implementation's stack.
+	const n = 1 << 20
+	m := testMatrix(n)
+	for i := range m.Entries {
+		m.Entries[i] = []sparse.Entry{{Index: (i + 1) % n, Value: 1}}
+	}
+	_, count, err := StronglyConnectedComponents(context.Background(), m)
+	if err != nil {
+		t.Fatalf("StronglyConnectedComponents() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("StronglyConnectedComponents() count = %v, want 1", count)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	// 0 → 1 → 2 → 0, 2 → 3, 3 ⇄ 4, 5 (self-loop and distrust only)
+	m := testMatrix(6,
+		sparse.CooEntry{Row: 0, Column: 1, Value: 1},
+		sparse.CooEntry{Row: 1, Column: 2, Value: 1},
+		sparse.CooEntry{Row: 2, Column: 0, Value: 1},
+		sparse.CooEntry{Row: 2, Column: 3, Value: 1},
+		sparse.CooEntry{Row: 3, Column: 4, Value: 1},
+		sparse.CooEntry{Row: 4, Column: 3, Value: 1},
+		sparse.CooEntry{Row: 5, Column: 0, Value: -1},
+		sparse.CooEntry{Row: 5, Column: 5, Value: 0},
+	)
+	common := Report{
+		NumPeers:         6,
+		NumEdges:         6,
+		NumNegativeEdges: 1,
+		NumSelfLoops:     0,
+		NumDangling:      1,
+		OutDegree: DegreeStats{
+			Min: 0, Max: 2, Mean: 1, Median: 1,
+			Histogram: []int{1, 4, 1},
+		},
+		InDegree: DegreeStats{
+			Min: 0, Max: 2, Mean: 1, Median: 1,
+			Histogram: []int{1, 4, 1},
+		},
+		NumComponents:          3,
+		LargestComponent:       3,
+		NumSingletonComponents: 1,
+	}
+	tests := []struct {
+		name            string
+		preTrust        *sparse.Vector
+		wantUnreachable int
+	}{
+		{"Uniform", nil, 0},
+		{"Zero", sparse.NewVector(6, nil), 0},
+		{
+			"Head",
+			sparse.NewVector(6, []sparse.Entry{{Index: 1, Value: 1}}),
+			1,
+		},
+		{
+			"Tail",
+			sparse.NewVector(6, []sparse.Entry{
+				{Index: 3, Value: 1},
+				{Index: 5, Value: 1},
+			}),
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Analyze(context.Background(), m, tt.preTrust)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+			want := common
+			want.NumUnreachable = tt.wantUnreachable
+			want.UnreachableShare = float64(tt.wantUnreachable) / 6
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("Analyze() got = %+v, want %+v", *got, want)
+			}
+		})
+	}
+	_, err := Analyze(context.Background(), m, sparse.NewVector(5, nil))
+	if err != sparse.ErrDimensionMismatch {
+		t.Errorf("Analyze() error = %v, want %v",
+			err, sparse.ErrDimensionMismatch)
+	}
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"context"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+// StronglyConnectedComponents finds the strongly connected components of m,
+// following edges (positive entries) of m.
+//
+// It returns the component label of each peer, numbered from 0,
+// and the number of components.
+// Labels are in reverse topological order of the condensed graph,
+// i.e. no edge leads from a component to another with a higher label.
+func StronglyConnectedComponents(
+	ctx context.Context, m *sparse.Matrix,
+) (labels []int, count int, err error) {
+	n, err := m.Dim()
+	if err != nil {
+		return nil, 0, err
+	}
+	// Iterative Tarjan's algorithm;
+	// recursion could overflow the stack on long paths.
+	const unvisited = -1
+	type frame struct {
+		peer int // peer being visited
+		pos  int // position of the next outbound edge to follow
+	}
+	var (
+		index   = make([]int, n)
+		lowLink = make([]int, n)
+		onStack = make([]bool, n)
+		stack   []int
+		frames  []frame
+		visited int
+	)
+	labels = make([]int, n)
+	for i := range index {
+		index[i] = unvisited
+	}
+	visit := func(i int) {
+		index[i], lowLink[i] = visited, visited
+		visited++
+		stack = append(stack, i)
+		onStack[i] = true
+		frames = append(frames, frame{peer: i})
+	}
+	for root := 0; root < n; root++ {
+		if index[root] != unvisited {
+			continue
+		}
+		visit(root)
+		for len(frames) > 0 {
+			if visited%checkInterval == 0 {
+				if err = ctx.Err(); err != nil {
+					return nil, 0, err
+				}
+			}
+			f := &frames[len(frames)-1]
+			i := f.peer
+			span := m.Entries[i]
+			descended := false
+			for f.pos < len(span) && !descended {
+				e := span[f.pos]
+				f.pos++
+				switch {
+				case e.Value <= 0 || e.Index == i:
+				case index[e.Index] == unvisited:
+					visit(e.Index) // invalidates f
+					descended = true
+				case onStack[e.Index]:
+					lowLink[i] = min(lowLink[i], index[e.Index])
+				}
+			}
+			if descended {
+				continue
+			}
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].peer
+				lowLink[parent] = min(lowLink[parent], lowLink[i])
+			}
+			if lowLink[i] == index[i] {
+				for {
+					j := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[j] = false
+					labels[j] = count
+					if j == i {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+	return labels, count, nil
+}
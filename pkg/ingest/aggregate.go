@@ -0,0 +1,140 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/sparse"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+// Event is one interaction between two peers,
+// e.g. a transaction or a rating of one peer by another.
+type Event struct {
+	// From is the peer that interacted with (e.g. rated) To.
+	From peer.Index
+
+	// To is the peer that From interacted with.
+	To peer.Index
+
+	// Outcome is the event outcome (type), e.g. "success" or "failure";
+	// see Config.OutcomeWeights.
+	Outcome string
+
+	// Weight is the weight (e.g. amount) of this event; usually 1.
+	Weight float64
+
+	// Time is the event time, in Unix seconds; 0 if unknown.
+	Time int64
+}
+
+type edge struct{ from, to peer.Index }
+
+// Aggregator aggregates events into local trust.
+type Aggregator struct {
+	config *Config
+	values map[edge]float64
+	times  map[edge]int64
+	dim    int
+}
+
+// NewAggregator returns a new aggregator using the given config.
+func NewAggregator(config *Config) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Aggregator{
+		config: config,
+		values: make(map[edge]float64),
+		times:  make(map[edge]int64),
+	}, nil
+}
+
+// Add aggregates the given event.
+//
+// Self-interactions (From == To) are skipped,
+// as a peer's trust in itself does not count.
+func (a *Aggregator) Add(e Event) error {
+	if math.IsNaN(e.Weight) || math.IsInf(e.Weight, 0) || e.Weight < 0 {
+		return fmt.Errorf("event weight %#v is invalid", e.Weight)
+	}
+	a.dim = max(a.dim, e.From+1, e.To+1)
+	if e.From == e.To {
+		return nil
+	}
+	outcomeWeight, err := a.config.OutcomeWeight(e.Outcome)
+	if err != nil {
+		return err
+	}
+	if outcomeWeight == 0 {
+		return nil
+	}
+	var value float64
+	switch a.config.Mode {
+	case SatUnsat:
+		value = math.Copysign(e.Weight, outcomeWeight)
+	case Count:
+		value = 1
+	case WeightedSum:
+		value = outcomeWeight * e.Weight
+	}
+	key := edge{e.From, e.To}
+	a.values[key] += value
+	a.times[key] = max(a.times[key], e.Time)
+	return nil
+}
+
+// AddFromChannel aggregates all events received from the given channel,
+// until it is closed.
+func (a *Aggregator) AddFromChannel(
+	ctx context.Context, ch <-chan Event,
+) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := a.Add(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// LocalTrust returns the local trust aggregated so far.
+//
+// Its dimension covers all peers seen in events,
+// even those left without any local trust.
+// Zero local trust, e.g. SatUnsat clipped at zero, is omitted.
+func (a *Aggregator) LocalTrust(ctx context.Context) (*sparse.Matrix, error) {
+	entries := make([]sparse.CooEntry, 0, len(a.values))
+	for key, value := range a.values {
+		if a.config.Mode == SatUnsat {
+			value = max(value, 0)
+		}
+		entries = append(entries, sparse.CooEntry{
+			Row: key.from, Column: key.to, Value: value,
+		})
+	}
+	return sparse.NewCSRMatrixFromEntries(ctx, entries,
+		spopt.MinDim(a.dim, a.dim), spopt.AllowNegative)
+}
+
+// EdgeTimes returns the time of the latest event between each pair of peers,
+// in Unix seconds, at the same location as their local trust.
+// It can be used with basic.DecayLocalTrust.
+func (a *Aggregator) EdgeTimes(ctx context.Context) (*sparse.Matrix, error) {
+	entries := make([]sparse.CooEntry, 0, len(a.times))
+	for key, t := range a.times {
+		entries = append(entries, sparse.CooEntry{
+			Row: key.from, Column: key.to, Value: float64(t),
+		})
+	}
+	return sparse.NewCSRMatrixFromEntries(ctx, entries,
+		spopt.MinDim(a.dim, a.dim), spopt.AllowNegative)
+}
@@ -0,0 +1,152 @@
+package ingest
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/sparse"
+)
+
+func TestAggregator(t *testing.T) {
+	events := []Event{
+		{From: 0, To: 1, Outcome: "success", Weight: 1, Time: 100},
+		{From: 0, To: 1, Outcome: "success", Weight: 2, Time: 300},
+		{From: 0, To: 1, Outcome: "failure", Weight: 1, Time: 200},
+		{From: 0, To: 2, Outcome: "refund", Weight: 4},
+		{From: 1, To: 0, Outcome: "failure", Weight: 1},
+		{From: 1, To: 1, Outcome: "success", Weight: 1}, // self
+		{From: 2, To: 0, Outcome: "view", Weight: 5},
+		{From: 3, To: 2, Outcome: "view", Weight: 5},
+	}
+	outcomeWeights := map[string]float64{
+		"success": 1, "failure": -2, "refund": -0.5, "view": 0,
+	}
+	tests := []struct {
+		name string
+		mode Mode
+		want [][]sparse.Entry
+	}{
+		{
+			name: "sat-unsat",
+			mode: SatUnsat,
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 2}},
+				nil,
+				nil,
+				nil,
+			},
+		},
+		{
+			name: "count",
+			mode: Count,
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 3}, {Index: 2, Value: 1}},
+				{{Index: 0, Value: 1}},
+				nil,
+				nil,
+			},
+		},
+		{
+			name: "weighted",
+			mode: WeightedSum,
+			want: [][]sparse.Entry{
+				{{Index: 1, Value: 1}, {Index: 2, Value: -2}},
+				{{Index: 0, Value: -2}},
+				nil,
+				nil,
+			},
+		},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAggregator(&Config{
+				Mode:           tt.mode,
+				OutcomeWeights: outcomeWeights,
+			})
+			if err != nil {
+				t.Fatalf("NewAggregator() error = %v", err)
+			}
+			for _, e := range events {
+				if err = a.Add(e); err != nil {
+					t.Fatalf("Add(%+v) error = %v", e, err)
+				}
+			}
+			got, err := a.LocalTrust(ctx)
+			if err != nil {
+				t.Fatalf("LocalTrust() error = %v", err)
+			}
+			if got.MajorDim != 4 || got.MinorDim != 4 {
+				t.Errorf("LocalTrust() dim = %dx%d, want 4x4",
+					got.MajorDim, got.MinorDim)
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("LocalTrust() = %v, want %v", got.Entries, tt.want)
+			}
+			times, err := a.EdgeTimes(ctx)
+			if err != nil {
+				t.Fatalf("EdgeTimes() error = %v", err)
+			}
+			want := []sparse.Entry{{Index: 1, Value: 300}}
+			if !reflect.DeepEqual(times.Entries[0], want) {
+				t.Errorf("EdgeTimes()[0] = %v, want %v",
+					times.Entries[0], want)
+			}
+		})
+	}
+}
+
+func TestAggregator_Add_errors(t *testing.T) {
+	a, err := NewAggregator(&Config{
+		OutcomeWeights: map[string]float64{"success": 1},
+	})
+	if err != nil {
+		t.Fatalf("NewAggregator() error = %v", err)
+	}
+	for _, e := range []Event{
+		{From: 0, To: 1, Outcome: "bogus", Weight: 1},
+		{From: 0, To: 1, Outcome: "success", Weight: -1},
+	} {
+		if err = a.Add(e); err == nil {
+			t.Errorf("Add(%+v) succeeded, want error", e)
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			json: `{"mode": "weighted", "outcomeWeights": {"success": 1},
+				"defaultOutcomeWeight": -1}`,
+			want: Config{
+				Mode:                 WeightedSum,
+				OutcomeWeights:       map[string]float64{"success": 1},
+				DefaultOutcomeWeight: new(float64),
+			},
+		},
+		{name: "default", json: `{}`, want: Config{Mode: SatUnsat}},
+		{name: "unknown mode", json: `{"mode": "bogus"}`, wantErr: true},
+		{name: "unknown field", json: `{"bogus": 1}`, wantErr: true},
+	}
+	*tests[0].want.DefaultOutcomeWeight = -1
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadConfig(strings.NewReader(tt.json))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v",
+					err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("LoadConfig() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Package ingest builds local trust from raw interaction events,
+// such as transactions, ratings, or downloads between peers,
+// as described in the EigenTrust paper.
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Mode decides how events between the same truster and trustee
+// aggregate into their local trust.
+type Mode int
+
+const (
+	// SatUnsat is the EigenTrust paper's s_ij = sat(i,j) - unsat(i,j),
+	// clipped at zero: events with a positive outcome weight are
+	// satisfactory, and events with a negative one are unsatisfactory.
+	// Each event counts by its own weight;
+	// the magnitude of the outcome weight does not matter.
+	SatUnsat Mode = iota
+
+	// Count counts events, regardless of outcome and event weights.
+	// Events whose outcome weight is zero are not counted.
+	Count
+
+	// WeightedSum sums the outcome weight times the event weight.
+	// The result is not clipped: negative sums become distrust.
+	WeightedSum
+)
+
+// ParseMode returns the aggregation mode with the given name,
+// as returned by Mode.String.
+func ParseMode(name string) (Mode, error) {
+	switch name {
+	case "satunsat":
+		return SatUnsat, nil
+	case "count":
+		return Count, nil
+	case "weighted":
+		return WeightedSum, nil
+	default:
+		return 0, fmt.Errorf("unknown aggregation mode %#v", name)
+	}
+}
+
+func (m Mode) String() string {
+	switch m {
+	case SatUnsat:
+		return "satunsat"
+	case Count:
+		return "count"
+	case WeightedSum:
+		return "weighted"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mode) UnmarshalText(text []byte) (err error) {
+	*m, err = ParseMode(string(text))
+	return
+}
+
+// Config tells how to aggregate events into local trust.
+type Config struct {
+	// Mode is the aggregation mode.
+	Mode Mode `json:"mode"`
+
+	// OutcomeWeights maps event outcomes (types) into their weights,
+	// e.g. {"success": 1, "failure": -1, "refund": -0.5}.
+	// If empty, every outcome weighs 1.
+	OutcomeWeights map[string]float64 `json:"outcomeWeights,omitempty"`
+
+	// DefaultOutcomeWeight is the weight of outcomes
+	// not found in OutcomeWeights.
+	// If nil, such outcomes are an error.
+	DefaultOutcomeWeight *float64 `json:"defaultOutcomeWeight,omitempty"`
+}
+
+// LoadConfig reads a JSON-encoded Config from r and validates it.
+func LoadConfig(r io.Reader) (*Config, error) {
+	config := &Config{}
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate checks that the config is usable.
+func (c *Config) Validate() error {
+	switch c.Mode {
+	case SatUnsat, Count, WeightedSum:
+	default:
+		return fmt.Errorf("unknown aggregation mode %d", c.Mode)
+	}
+	for outcome, weight := range c.OutcomeWeights {
+		if math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return fmt.Errorf("outcome %#v weight %#v is invalid",
+				outcome, weight)
+		}
+	}
+	if w := c.DefaultOutcomeWeight; w != nil &&
+		(math.IsNaN(*w) || math.IsInf(*w, 0)) {
+		return fmt.Errorf("default outcome weight %#v is invalid", *w)
+	}
+	return nil
+}
+
+// OutcomeWeight returns the weight of the given outcome.
+func (c *Config) OutcomeWeight(outcome string) (float64, error) {
+	if len(c.OutcomeWeights) == 0 {
+		return 1, nil
+	}
+	if weight, ok := c.OutcomeWeights[outcome]; ok {
+		return weight, nil
+	}
+	if c.DefaultOutcomeWeight != nil {
+		return *c.DefaultOutcomeWeight, nil
+	}
+	return 0, fmt.Errorf("unknown outcome %#v", outcome)
+}
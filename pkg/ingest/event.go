@@ -0,0 +1,188 @@
+package ingest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// Event field names, in CSV headers and JSON Lines objects.
+// Only from and to are required.
+const (
+	FromField    = "from"
+	ToField      = "to"
+	OutcomeField = "outcome"
+	WeightField  = "weight"
+	TimeField    = "time"
+)
+
+var fieldNames = []string{
+	FromField, ToField, OutcomeField, WeightField, TimeField,
+}
+
+// parseEvent parses an event from the given field values,
+// keyed by field name.  Missing optional fields are empty.
+//
+// If m is not nil, peer identifiers are looked up in (or allocated into) m;
+// otherwise, they are parsed as peer indices.
+func parseEvent(fields map[string]string, m *peer.Map) (e Event, err error) {
+	for _, name := range []string{FromField, ToField} {
+		if fields[name] == "" {
+			return e, fmt.Errorf("missing %s", name)
+		}
+	}
+	if e.From, err = peer.ParseId(fields[FromField], m, true); err != nil {
+		return e, fmt.Errorf("invalid %s %#v: %w",
+			FromField, fields[FromField], err)
+	}
+	if e.To, err = peer.ParseId(fields[ToField], m, true); err != nil {
+		return e, fmt.Errorf("invalid %s %#v: %w",
+			ToField, fields[ToField], err)
+	}
+	e.Outcome = fields[OutcomeField]
+	e.Weight = 1
+	if s := fields[WeightField]; s != "" {
+		if e.Weight, err = strconv.ParseFloat(s, 64); err != nil {
+			return e, fmt.Errorf("invalid %s %#v: %w", WeightField, s, err)
+		}
+	}
+	if s := fields[TimeField]; s != "" {
+		if e.Time, err = parseTime(s); err != nil {
+			return e, fmt.Errorf("invalid %s %#v: %w", TimeField, s, err)
+		}
+	}
+	return e, nil
+}
+
+// parseTime parses either Unix seconds or an RFC 3339 timestamp
+// into Unix seconds.
+func parseTime(s string) (int64, error) {
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return sec, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, errors.New("neither Unix seconds nor RFC 3339 time")
+	}
+	return t.Unix(), nil
+}
+
+func sendEvent(ctx context.Context, ch chan<- Event, e Event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case ch <- e:
+		return nil
+	}
+}
+
+// SendEventsFromCSV parses events from CSV records
+// and sends them into the given channel.
+//
+// The first record is the header, naming the fields (see FromField etc.);
+// other fields are ignored.
+// Peer identifiers are mapped into m as in parseEvent.
+func SendEventsFromCSV(
+	ctx context.Context, r util.CSVReader, ch chan<- Event, m *peer.Map,
+) error {
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return fmt.Errorf("cannot read CSV header: %w", err)
+	}
+	_, err = util.NewCSVFieldExtractor(header, FromField, ToField)
+	if err != nil {
+		return err
+	}
+	for record := 2; ; record++ {
+		values, err := r.Read()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+		fields := make(map[string]string, len(fieldNames))
+		for _, name := range fieldNames {
+			if i := slices.Index(header, name); i >= 0 && i < len(values) {
+				fields[name] = values[i]
+			}
+		}
+		e, err := parseEvent(fields, m)
+		if err != nil {
+			return fmt.Errorf("record %d: %w", record, err)
+		}
+		if err = sendEvent(ctx, ch, e); err != nil {
+			return err
+		}
+	}
+}
+
+// SendEventsFromJSONL parses events from JSON Lines input
+// and sends them into the given channel.
+//
+// Each line is an object with members named after the fields
+// (see FromField etc.), either strings or numbers; other members are ignored.
+// Blank lines are skipped.
+// Peer identifiers are mapped into m as in parseEvent.
+func SendEventsFromJSONL(
+	ctx context.Context, r io.Reader, ch chan<- Event, m *peer.Map,
+) error {
+	br := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		text, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if text = bytes.TrimSpace(text); len(text) != 0 {
+			e, err := parseJSONLEvent(text, m)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			if err = sendEvent(ctx, ch, e); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func parseJSONLEvent(text []byte, m *peer.Map) (Event, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(text, &members); err != nil {
+		return Event{}, err
+	}
+	fields := make(map[string]string, len(fieldNames))
+	for _, name := range fieldNames {
+		raw, ok := members[name]
+		if !ok {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			fields[name] = s
+			continue
+		}
+		var n json.Number
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return Event{}, fmt.Errorf(
+				"member %#v is neither a string nor a number", name)
+		}
+		fields[name] = n.String()
+	}
+	return parseEvent(fields, m)
+}
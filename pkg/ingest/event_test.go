@@ -0,0 +1,106 @@
+package ingest
+
+import (
+	"context"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+)
+
+func collectEvents(
+	t *testing.T, send func(ctx context.Context, ch chan<- Event) error,
+) ([]Event, error) {
+	t.Helper()
+	ctx := context.Background()
+	ch := make(chan Event)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		sendErr <- send(ctx, ch)
+	}()
+	var events []Event
+	for e := range ch {
+		events = append(events, e)
+	}
+	return events, <-sendErr
+}
+
+func TestSendEventsFromCSV(t *testing.T) {
+	input := `to,from,outcome,weight,time,note
+bob,alice,success,2.5,1700000000,hi
+alice,bob,failure,,2023-11-14T22:13:20Z,
+carol,alice,,,,
+`
+	m := peer.NewMap()
+	got, err := collectEvents(t,
+		func(ctx context.Context, ch chan<- Event) error {
+			return SendEventsFromCSV(ctx, csv.NewReader(strings.NewReader(input)),
+				ch, m)
+		})
+	if err != nil {
+		t.Fatalf("SendEventsFromCSV() error = %v", err)
+	}
+	want := []Event{
+		{From: 0, To: 1, Outcome: "success", Weight: 2.5, Time: 1700000000},
+		{From: 1, To: 0, Outcome: "failure", Weight: 1, Time: 1700000000},
+		{From: 0, To: 2, Weight: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SendEventsFromCSV() = %+v, want %+v", got, want)
+	}
+	if ids := m.Ids(); !reflect.DeepEqual(ids, []peer.Id{
+		"alice", "bob", "carol",
+	}) {
+		t.Errorf("peer map = %v", ids)
+	}
+}
+
+func TestSendEventsFromCSV_errors(t *testing.T) {
+	for _, input := range []string{
+		"from,outcome\nalice,success\n",
+		"from,to,weight\nalice,bob,x\n",
+		"from,to,time\nalice,bob,yesterday\n",
+		"from,to\n,bob\n",
+	} {
+		_, err := collectEvents(t,
+			func(ctx context.Context, ch chan<- Event) error {
+				return SendEventsFromCSV(ctx,
+					csv.NewReader(strings.NewReader(input)), ch, peer.NewMap())
+			})
+		if err == nil {
+			t.Errorf("SendEventsFromCSV(%#v) succeeded, want error", input)
+		}
+	}
+}
+
+func TestSendEventsFromJSONL(t *testing.T) {
+	input := `{"from": 2, "to": 0, "outcome": "success", "weight": 3, "meta": {}}
+
+{"from": "0", "to": "1", "time": 1700000000}
+`
+	got, err := collectEvents(t,
+		func(ctx context.Context, ch chan<- Event) error {
+			return SendEventsFromJSONL(ctx, strings.NewReader(input), ch, nil)
+		})
+	if err != nil {
+		t.Fatalf("SendEventsFromJSONL() error = %v", err)
+	}
+	want := []Event{
+		{From: 2, To: 0, Outcome: "success", Weight: 3},
+		{From: 0, To: 1, Weight: 1, Time: 1700000000},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SendEventsFromJSONL() = %+v, want %+v", got, want)
+	}
+	_, err = collectEvents(t,
+		func(ctx context.Context, ch chan<- Event) error {
+			return SendEventsFromJSONL(ctx, strings.NewReader(`{"from": 0}`),
+				ch, nil)
+		})
+	if err == nil {
+		t.Errorf("SendEventsFromJSONL() succeeded without to, want error")
+	}
+}
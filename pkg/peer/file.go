@@ -0,0 +1,271 @@
+package peer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// mapFile is the append-only backing file of a Map,
+// with one peer identifier per line, in peer index order.
+type mapFile struct {
+	path   string
+	f      *os.File
+	lock   *os.File      // exclusive lock for writers; nil if read-only
+	w      *bufio.Writer // nil if read-only
+	offset int64         // bytes of f loaded into the map
+	err    error         // sticky write error
+}
+
+// OpenMapFile opens the peer map file at the given path,
+// creating an empty one if it does not exist,
+// and returns a map that durably records newly allocated peers therein.
+//
+// The file has one peer identifier per line, in peer index order,
+// the same format that MapWithIdFile reads,
+// so peer indices stay stable across runs.
+// New peers are appended in the order allocated;
+// Sync makes them durable, and Close syncs and releases the file.
+//
+// Only one process can open the same file with OpenMapFile at a time,
+// as enforced by a lock on an adjacent file (path + ".lock");
+// others can follow it with OpenMapFileReadOnly.
+//
+// opts (e.g. WithNormalizer, WithAliases) apply before peers are loaded,
+// so that identifiers in the file are canonicalized as well.
+// File entries that canonicalize into the same identifier are merged
+// into the first one; see Merged.
+func OpenMapFile(path string, opts ...MapOption) (*Map, error) {
+	lock, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		util.Close(lock)
+		return nil, fmt.Errorf("cannot lock peer map file %#v: %w", path, err)
+	}
+	m, err := openMapFile(path, os.O_RDWR|os.O_CREATE, opts...)
+	if err != nil {
+		util.Close(lock)
+		return nil, err
+	}
+	m.file.lock = lock
+	m.file.w = bufio.NewWriter(m.file.f)
+	// Terminate the last line if it lacks a line break, e.g. hand-edited.
+	var rest []byte
+	if _, err = m.file.f.Seek(m.file.offset, io.SeekStart); err == nil {
+		rest, err = io.ReadAll(m.file.f)
+	}
+	if err == nil && len(rest) != 0 {
+		id := strings.TrimSuffix(string(rest), "\r")
+		m.file.offset += int64(len(rest))
+		m.load(id)
+		m.numFilePeers = len(m.ids)
+		if _, err = m.file.w.WriteString("\n"); err == nil {
+			m.file.offset++
+			err = m.Sync()
+		}
+	}
+	if err != nil {
+		_ = m.Close()
+		return nil, err
+	}
+	return m, nil
+}
+
+// OpenMapFileReadOnly opens the peer map file at the given path
+// and returns a map loaded from it.
+//
+// The map can still allocate peers, but only in memory.
+// Refresh loads peers appended since by another process
+// that opened the file with OpenMapFile.
+// opts apply as with OpenMapFile.
+func OpenMapFileReadOnly(path string, opts ...MapOption) (*Map, error) {
+	return openMapFile(path, os.O_RDONLY, opts...)
+}
+
+func openMapFile(path string, flag int, opts ...MapOption) (*Map, error) {
+	m := NewMap()
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+	f, err := os.OpenFile(path, flag, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	m.file = &mapFile{path: path, f: f}
+	if err = m.Refresh(); err != nil {
+		util.Close(f)
+		return nil, err
+	}
+	return m, nil
+}
+
+// Refresh loads peers appended to the backing file since last loaded.
+// It is a no-op if the map is not file-backed.
+//
+// For a read-only map, peers allocated in memory only are discarded first,
+// so that indices agree with the file.
+// If the file has been compacted since, the map is reloaded from scratch.
+func (m *Map) Refresh() error {
+	mf := m.file
+	if mf == nil {
+		return nil
+	}
+	if mf.w == nil {
+		m.truncate(m.numFilePeers)
+		if err := mf.reopenIfReplaced(); err != nil {
+			return err
+		}
+		if mf.offset == 0 {
+			m.truncate(0)
+		}
+	}
+	if _, err := mf.f.Seek(mf.offset, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(mf.f)
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			// Ignore a partial line still being written.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		mf.offset += int64(len(line))
+		m.load(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+		m.numFilePeers = len(m.ids)
+	}
+}
+
+// reopenIfReplaced reopens the file if the path now refers to another file,
+// e.g. rewritten by Compact in another process.
+func (mf *mapFile) reopenIfReplaced() error {
+	pathInfo, err := os.Stat(mf.path)
+	if err != nil {
+		return err
+	}
+	fileInfo, err := mf.f.Stat()
+	if err != nil {
+		return err
+	}
+	if os.SameFile(pathInfo, fileInfo) {
+		return nil
+	}
+	f, err := os.Open(mf.path)
+	if err != nil {
+		return err
+	}
+	util.Close(mf.f)
+	mf.f, mf.offset = f, 0
+	return nil
+}
+
+// appendId records a newly allocated peer into the backing file, if any.
+func (m *Map) appendId(id Id) {
+	mf := m.file
+	if mf == nil || mf.w == nil || mf.err != nil {
+		return
+	}
+	if strings.ContainsAny(id, "\r\n") {
+		mf.err = fmt.Errorf("cannot record peer id %#v with a line break", id)
+		return
+	}
+	if _, mf.err = mf.w.WriteString(id + "\n"); mf.err == nil {
+		mf.offset += int64(len(id) + 1)
+		m.numFilePeers = len(m.ids)
+	}
+}
+
+// Sync makes newly allocated peers durable in the backing file.
+// It also reports any error that occurred while recording them.
+// It is a no-op if the map is not file-backed or read-only.
+func (m *Map) Sync() error {
+	mf := m.file
+	if mf == nil || mf.w == nil {
+		return nil
+	}
+	if mf.err != nil {
+		return mf.err
+	}
+	if mf.err = mf.w.Flush(); mf.err != nil {
+		return mf.err
+	}
+	mf.err = mf.f.Sync()
+	return mf.err
+}
+
+// Close syncs and closes the backing file, if any.
+// The map remains usable in memory.
+func (m *Map) Close() error {
+	mf := m.file
+	if mf == nil {
+		return nil
+	}
+	err := m.Sync()
+	err = errors.Join(err, mf.f.Close())
+	if mf.lock != nil {
+		err = errors.Join(err, mf.lock.Close())
+	}
+	m.file = nil
+	return err
+}
+
+// rewrite atomically replaces the backing file contents with the peers
+// currently in the map.
+func (m *Map) rewrite() (err error) {
+	mf := m.file
+	if mf.w == nil {
+		return fmt.Errorf("peer map file %#v is read-only", mf.path)
+	}
+	if err = m.Sync(); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(mf.path),
+		filepath.Base(mf.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			util.Close(tmp)
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+	if err = tmp.Chmod(0o644); err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	var offset int64
+	for _, id := range m.ids {
+		n, err := w.WriteString(id + "\n")
+		if err != nil {
+			return err
+		}
+		offset += int64(n)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), mf.path); err != nil {
+		return err
+	}
+	util.Close(mf.f)
+	mf.f, mf.w, mf.offset = tmp, bufio.NewWriter(tmp), offset
+	m.numFilePeers = len(m.ids)
+	return nil
+}
@@ -0,0 +1,130 @@
+package peer
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOpenMapFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peers.txt")
+	// Last line lacks a line break, as if hand-edited.
+	if err := os.WriteFile(path, []byte("alice\nbob"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := OpenMapFile(path)
+	if err != nil {
+		t.Fatalf("OpenMapFile() error = %v", err)
+	}
+	if _, err = OpenMapFile(path); err == nil {
+		t.Errorf("OpenMapFile() succeeded while already open")
+	}
+	reader, err := OpenMapFileReadOnly(path)
+	if err != nil {
+		t.Fatalf("OpenMapFileReadOnly() error = %v", err)
+	}
+	if got := m.Allocate("carol"); got != 2 {
+		t.Errorf("Allocate(carol) = %v, want 2", got)
+	}
+	if got := m.Allocate("alice"); got != 0 {
+		t.Errorf("Allocate(alice) = %v, want 0", got)
+	}
+	if err = m.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	// In-memory allocation by reader is discarded on refresh.
+	if got := reader.Allocate("dave"); got != 2 {
+		t.Errorf("reader.Allocate(dave) = %v, want 2", got)
+	}
+	if err = reader.Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	want := []Id{"alice", "bob", "carol"}
+	if got := reader.Ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reader.Ids() = %v, want %v", got, want)
+	}
+
+	remap, err := m.Compact(func(index Index) bool { return index != 1 })
+	if err != nil {
+		t.Fatalf("Compact() error = %v", err)
+	}
+	if want := []Index{0, -1, 1}; !reflect.DeepEqual(remap, want) {
+		t.Errorf("Compact() = %v, want %v", remap, want)
+	}
+	if got := m.Allocate("erin"); got != 2 {
+		t.Errorf("Allocate(erin) = %v, want 2", got)
+	}
+	if err = m.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	want = []Id{"alice", "carol", "erin"}
+	if err = reader.Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if got := reader.Ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reader.Ids() after compaction = %v, want %v", got, want)
+	}
+	_ = reader.Close()
+
+	m, err = OpenMapFile(path)
+	if err != nil {
+		t.Fatalf("OpenMapFile() error = %v", err)
+	}
+	defer func() { _ = m.Close() }()
+	if got := m.Ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reopened Ids() = %v, want %v", got, want)
+	}
+	if index, ok := m.Index("erin"); !ok || index != 2 {
+		t.Errorf("Index(erin) = %v, %v, want 2, true", index, ok)
+	}
+}
+
+func TestOpenMapFile_Normalize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peers.txt")
+	err := os.WriteFile(path, []byte("0xABC\nfoo\n0xabc\nBAR\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliases := csv.NewReader(strings.NewReader("bar,foo\n"))
+	m, err := OpenMapFile(path, WithNormalizer(LowerCase), WithAliases(aliases))
+	if err != nil {
+		t.Fatalf("OpenMapFile() error = %v", err)
+	}
+	defer func() { _ = m.Close() }()
+	for id, want := range map[Id]Index{"0xABC": 0, "0xabc": 0, "BAR": 1} {
+		if got := m.Allocate(id); got != want {
+			t.Errorf("Allocate(%v) = %v, want %v", id, got, want)
+		}
+	}
+	if want := map[Index]Index{2: 0, 3: 1}; !reflect.DeepEqual(m.Merged(), want) {
+		t.Errorf("Merged() = %v, want %v", m.Merged(), want)
+	}
+	if got := m.Allocate("Carol"); got != 4 {
+		t.Errorf("Allocate(Carol) = %v, want 4", got)
+	}
+	if err = m.Sync(); err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	reader, err := OpenMapFileReadOnly(path, WithNormalizer(LowerCase))
+	if err != nil {
+		t.Fatalf("OpenMapFileReadOnly() error = %v", err)
+	}
+	want := []Id{"0xabc", "foo", "0xabc", "bar", "carol"}
+	if got := reader.Ids(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reader.Ids() = %v, want %v", got, want)
+	}
+
+	remap, err := m.Compact(func(Index) bool { return true })
+	if err != nil {
+		t.Fatalf("Compact() error = %v", err)
+	}
+	if want := []Index{0, 1, 0, 1, 2}; !reflect.DeepEqual(remap, want) {
+		t.Errorf("Compact() = %v, want %v", remap, want)
+	}
+	if want := map[Index]Index(nil); !reflect.DeepEqual(m.Merged(), want) {
+		t.Errorf("Merged() after Compact() = %v, want none", m.Merged())
+	}
+}
@@ -3,6 +3,7 @@ package peer
 import (
 	"bufio"
 	"context"
+	"fmt"
 	"io"
 	"maps"
 	"os"
@@ -16,6 +17,12 @@ import (
 type Map struct {
 	indices map[Id]Index
 	ids     []Id
+
+	normalize Normalizer // canonicalizes ids; nil if none
+	aliases   map[Id]Id  // alias to canonical id (both normalized)
+
+	file         *mapFile // backing file, if any; see OpenMapFile
+	numFilePeers int      // number of peers recorded in file
 }
 
 // NewMap returns a new, empty peer map.
@@ -79,20 +86,167 @@ func MapWithIdFile(path string) (*Map, error) {
 	return MapWithIdReader(f)
 }
 
+// SetNormalizer sets the normalizer that canonicalizes identifiers
+// given to Allocate, Index, and AddAlias.  nil disables normalization.
+//
+// Peers already in the map are not affected;
+// to normalize peers loaded from a file, use WithNormalizer instead.
+func (m *Map) SetNormalizer(normalize Normalizer) {
+	m.normalize = normalize
+}
+
+// MapOption configures a map before peers are loaded into it;
+// see OpenMapFile.
+type MapOption func(m *Map) error
+
+// WithNormalizer sets the normalizer; see SetNormalizer.
+func WithNormalizer(normalize Normalizer) MapOption {
+	return func(m *Map) error {
+		m.SetNormalizer(normalize)
+		return nil
+	}
+}
+
+// WithAliases loads aliases from the given CSV records;
+// see LoadAliases.
+func WithAliases(r util.CSVReader) MapOption {
+	return func(m *Map) error { return m.LoadAliases(r) }
+}
+
+// AddAlias makes alias an alias of id,
+// so that both refer to the same peer (that of id).
+//
+// It is an error if alias already refers to another peer,
+// either because it has been allocated an index of its own
+// or because it is already an alias of another identifier.
+func (m *Map) AddAlias(alias, id Id) error {
+	alias, id = m.normalized(alias), m.Canonical(id)
+	if alias == id {
+		return nil
+	}
+	if target, ok := m.aliases[alias]; ok && target != id {
+		return AliasConflict{Alias: alias, Id: id, Existing: target}
+	}
+	if _, ok := m.indices[alias]; ok {
+		return AliasConflict{Alias: alias, Id: id, Existing: alias}
+	}
+	if m.aliases == nil {
+		m.aliases = make(map[Id]Id)
+	}
+	// Existing aliases of alias now resolve to id.
+	for a, target := range m.aliases {
+		if target == alias {
+			m.aliases[a] = id
+		}
+	}
+	m.aliases[alias] = id
+	return nil
+}
+
+// LoadAliases adds aliases from the given CSV records,
+// each with two fields: alias and identifier, as with AddAlias.
+func (m *Map) LoadAliases(r util.CSVReader) error {
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if len(record) != 2 {
+			return fmt.Errorf("line %d: expected alias and id, got %#v",
+				line, record)
+		}
+		if err = m.AddAlias(record[0], record[1]); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func (m *Map) normalized(id Id) Id {
+	if m.normalize != nil {
+		return m.normalize(id)
+	}
+	return id
+}
+
+// Canonical returns the canonical form of the given identifier,
+// i.e. normalized and with aliases resolved.
+func (m *Map) Canonical(id Id) Id {
+	id = m.normalized(id)
+	if target, ok := m.aliases[id]; ok {
+		return target
+	}
+	return id
+}
+
 // Allocate returns the peer index for the given id, allocating one if needed.
+// id is canonicalized first; see Canonical.
+//
+// If the map is file-backed, newly allocated peers are recorded in the file;
+// see Sync for errors.
 func (m *Map) Allocate(id Id) (index Index) {
+	id = m.Canonical(id)
+	index, allocated := m.allocate(id)
+	if allocated {
+		m.appendId(id)
+	}
+	return
+}
+
+// load canonicalizes and appends the given identifier read from a file,
+// always at the next index, so that indices agree with the file.
+// If it canonicalizes into an identifier already in the map,
+// it is merged into that peer: its own index is kept but unused,
+// as Index and Allocate return the earlier one; see Merged.
+func (m *Map) load(id Id) {
+	id = m.Canonical(id)
+	if _, ok := m.indices[id]; !ok {
+		m.indices[id] = len(m.ids)
+	}
+	m.ids = append(m.ids, id)
+}
+
+// Merged returns the indices of peers loaded from a file
+// that were merged into earlier peers (see OpenMapFile),
+// mapped to the indices of the earlier peers; nil if none.
+func (m *Map) Merged() map[Index]Index {
+	var merged map[Index]Index
+	for index, id := range m.ids {
+		if first := m.indices[id]; first != index {
+			if merged == nil {
+				merged = make(map[Index]Index)
+			}
+			merged[index] = first
+		}
+	}
+	return merged
+}
+
+func (m *Map) allocate(id Id) (index Index, allocated bool) {
 	index, ok := m.indices[id]
 	if !ok {
 		index = len(m.ids)
 		m.ids = append(m.ids, id)
 		m.indices[id] = index
 	}
-	return
+	return index, !ok
+}
+
+// truncate drops peers at or after the given index.
+func (m *Map) truncate(length int) {
+	for index, id := range m.ids[length:] {
+		if m.indices[id] == length+index {
+			delete(m.indices, id) // not merged into a kept peer
+		}
+	}
+	m.ids = m.ids[:length]
 }
 
 // Index returns the peer index for the given id.
+// id is canonicalized first; see Canonical.
 func (m *Map) Index(id Id) (index Index, ok bool) {
-	index, ok = m.indices[id]
+	index, ok = m.indices[m.Canonical(id)]
 	return
 }
 
@@ -105,9 +259,51 @@ func (m *Map) Id(index Index) (id Id, ok bool) {
 }
 
 // Clear clears the map.  Subsequent allocation starts from index 0 again.
+//
+// A writable file-backed map also empties its file; see Sync for errors.
 func (m *Map) Clear() {
 	clear(m.indices)
 	m.ids = nil
+	if m.file != nil && m.file.w != nil && m.file.err == nil {
+		m.file.err = m.rewrite()
+	}
+}
+
+// Compact drops peers for which used returns false,
+// renumbering the remaining ones in their original order,
+// and returns the old-to-new peer index mapping (-1 for dropped peers).
+// Merged peers (see Merged) are dropped and mapped to the new index
+// of the peer they were merged into.
+// Matrices and vectors indexed using the map
+// must be remapped accordingly, e.g. with their Remap methods.
+//
+// A file-backed map atomically rewrites its file;
+// if this fails, the map is left unchanged.
+func (m *Map) Compact(used func(index Index) bool) ([]Index, error) {
+	remap := make([]Index, len(m.ids))
+	ids := make([]Id, 0, len(m.ids))
+	for index, id := range m.ids {
+		if first := m.indices[id]; first != index {
+			remap[index] = remap[first] // merged; see Merged
+		} else if used(index) {
+			remap[index] = len(ids)
+			ids = append(ids, id)
+		} else {
+			remap[index] = -1
+		}
+	}
+	oldIds, oldIndices := m.ids, m.indices
+	m.ids, m.indices = ids, make(map[Id]Index, len(ids))
+	for index, id := range ids {
+		m.indices[id] = index
+	}
+	if m.file != nil {
+		if err := m.rewrite(); err != nil {
+			m.ids, m.indices = oldIds, oldIndices
+			return nil, fmt.Errorf("cannot rewrite peer map file: %w", err)
+		}
+	}
+	return remap, nil
 }
 
 // Ids returns (a copy of) the index-to-identifier array.
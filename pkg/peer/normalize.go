@@ -0,0 +1,99 @@
+package peer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Normalizer returns the canonical form of the given peer identifier,
+// so that different spellings of the same identity map to the same peer.
+type Normalizer func(id Id) Id
+
+// TrimSpace is a Normalizer that trims leading and trailing whitespace.
+func TrimSpace(id Id) Id { return strings.TrimSpace(id) }
+
+// LowerCase is a Normalizer that case-folds the identifier into lowercase.
+func LowerCase(id Id) Id { return strings.ToLower(id) }
+
+// EIP55 is a Normalizer that canonicalizes Ethereum addresses
+// (0x followed by 40 hex digits, in any case)
+// into their EIP-55 mixed-case checksum form.
+// Other identifiers are returned unchanged.
+func EIP55(id Id) Id {
+	if len(id) != 42 || id[0] != '0' || (id[1] != 'x' && id[1] != 'X') {
+		return id
+	}
+	addr := strings.ToLower(id[2:])
+	if _, err := hex.DecodeString(addr); err != nil {
+		return id
+	}
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(addr))
+	digest := hash.Sum(nil)
+	checksummed := []byte(addr)
+	for i, c := range checksummed {
+		nibble := digest[i/2] >> 4
+		if i%2 == 1 {
+			nibble = digest[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksummed)
+}
+
+// StripPrefix returns a Normalizer that strips the given prefix, if present,
+// e.g. "eip155:1:" from CAIP-10 account IDs.
+func StripPrefix(prefix string) Normalizer {
+	return func(id Id) Id { return strings.TrimPrefix(id, prefix) }
+}
+
+// Normalizers returns a Normalizer that applies the given ones in order.
+func Normalizers(normalizers ...Normalizer) Normalizer {
+	return func(id Id) Id {
+		for _, normalize := range normalizers {
+			id = normalize(id)
+		}
+		return id
+	}
+}
+
+// ParseNormalizer returns the Normalizer named by the given spec:
+//
+//   - "trim": TrimSpace
+//   - "lower": LowerCase
+//   - "eip55": EIP55
+//   - "strip-prefix:PREFIX": StripPrefix(PREFIX)
+func ParseNormalizer(spec string) (Normalizer, error) {
+	if prefix, ok := strings.CutPrefix(spec, "strip-prefix:"); ok {
+		return StripPrefix(prefix), nil
+	}
+	switch spec {
+	case "trim":
+		return TrimSpace, nil
+	case "lower":
+		return LowerCase, nil
+	case "eip55":
+		return EIP55, nil
+	default:
+		return nil, fmt.Errorf("unknown peer id normalizer %#v", spec)
+	}
+}
+
+// ParseNormalizers returns a Normalizer that applies the normalizers
+// named by the given specs in order; see ParseNormalizer.
+func ParseNormalizers(specs ...string) (Normalizer, error) {
+	var normalizers []Normalizer
+	for _, spec := range specs {
+		normalizer, err := ParseNormalizer(spec)
+		if err != nil {
+			return nil, err
+		}
+		normalizers = append(normalizers, normalizer)
+	}
+	return Normalizers(normalizers...), nil
+}
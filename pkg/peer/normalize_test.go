@@ -0,0 +1,92 @@
+package peer
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEIP55(t *testing.T) {
+	tests := []struct {
+		id   Id
+		want Id
+	}{
+		// From the EIP-55 specification.
+		{
+			"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		{
+			"0XFB6916095CA1DF60BB79CE92CE3EA74C37C5D359",
+			"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{
+			"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+			"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		},
+		{
+			"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+			"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		},
+		{"0x1234", "0x1234"},
+		{
+			"0xZZaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			"0xZZaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		},
+		{"alice", "alice"},
+	}
+	for _, tt := range tests {
+		if got := EIP55(tt.id); got != tt.want {
+			t.Errorf("EIP55(%#v) = %#v, want %#v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestParseNormalizers(t *testing.T) {
+	normalize, err := ParseNormalizers("trim", "strip-prefix:eip155:1:",
+		"eip55")
+	if err != nil {
+		t.Fatalf("ParseNormalizers() error = %v", err)
+	}
+	got := normalize(" eip155:1:0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED\n")
+	if want := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"; got != want {
+		t.Errorf("normalize() = %#v, want %#v", got, want)
+	}
+	if _, err = ParseNormalizers("trim", "upper"); err == nil {
+		t.Errorf("ParseNormalizers(upper) succeeded")
+	}
+}
+
+func TestMap_Aliases(t *testing.T) {
+	m := NewMap()
+	m.SetNormalizer(Normalizers(TrimSpace, LowerCase))
+	if got := m.Allocate("Alice"); got != 0 {
+		t.Errorf("Allocate(Alice) = %v, want 0", got)
+	}
+	aliases := "alice.eth,ALICE\nali,alice.eth\n"
+	if err := m.LoadAliases(csv.NewReader(strings.NewReader(aliases))); err != nil {
+		t.Fatalf("LoadAliases() error = %v", err)
+	}
+	for _, id := range []Id{"alice", " ALICE ", "Alice.eth", "ali"} {
+		if index, ok := m.Index(id); !ok || index != 0 {
+			t.Errorf("Index(%#v) = %v, %v, want 0, true", id, index, ok)
+		}
+		if got := m.Allocate(id); got != 0 {
+			t.Errorf("Allocate(%#v) = %v, want 0", id, got)
+		}
+	}
+	if got := m.Allocate("bob"); got != 1 {
+		t.Errorf("Allocate(bob) = %v, want 1", got)
+	}
+	var conflict AliasConflict
+	if err := m.AddAlias("bob", "alice"); !errors.As(err, &conflict) {
+		t.Errorf("AddAlias(bob, alice) error = %v, want AliasConflict", err)
+	}
+	if err := m.AddAlias("ali", "bob"); !errors.As(err, &conflict) {
+		t.Errorf("AddAlias(ali, bob) error = %v, want AliasConflict", err)
+	}
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", m.Len())
+	}
+}
@@ -0,0 +1,154 @@
+package peer
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// SyncMap is a concurrency-safe peer map, for sharing across goroutines,
+// e.g. server request handlers.
+//
+// Reads (Index, Id, Len, Snapshot) are lock-free;
+// allocations are serialized, and can be batched with AllocateAll.
+// Peer indices never change once allocated,
+// so a Snapshot remains a consistent view while allocations continue.
+type SyncMap struct {
+	mu      sync.Mutex // serializes allocations into m
+	m       *Map
+	ids     atomic.Pointer[[]Id]    // published prefix of m.ids; read-only
+	indices util.SyncMap[Id, Index] // for published ids
+}
+
+// NewSyncMap returns a concurrency-safe peer map
+// that takes over the given map, including its peers, normalizer, aliases,
+// and backing file if any.  m must not be used directly afterwards.
+// If m is nil, a new, empty map is used.
+func NewSyncMap(m *Map) *SyncMap {
+	if m == nil {
+		m = NewMap()
+	}
+	s := &SyncMap{m: m}
+	for id, index := range m.indices {
+		s.indices.Store(id, index)
+	}
+	s.publish()
+	return s
+}
+
+// publish makes the current peers of s.m visible to readers.
+// Elements of the published slice are never modified:
+// s.m only ever appends to its ids.
+func (s *SyncMap) publish() {
+	ids := s.m.ids
+	s.ids.Store(&ids)
+}
+
+// Allocate returns the peer index for the given id, allocating one if needed.
+// id is canonicalized first; see Map.Canonical.
+func (s *SyncMap) Allocate(id Id) Index {
+	if index, ok := s.Index(id); ok {
+		return index
+	}
+	return s.AllocateAll([]Id{id})[0]
+}
+
+// AllocateAll returns the peer indices for the given ids,
+// allocating them as needed, all under one lock acquisition.
+func (s *SyncMap) AllocateAll(ids []Id) []Index {
+	indices := make([]Index, len(ids))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	numPeers := s.m.Len()
+	for i, id := range ids {
+		indices[i] = s.m.Allocate(id)
+	}
+	// Publish ids first, so that any index found in s.indices is valid.
+	s.publish()
+	for _, id := range s.m.ids[numPeers:] {
+		s.indices.Store(id, s.m.indices[id])
+	}
+	return indices
+}
+
+// Index returns the peer index for the given id.
+// id is canonicalized first; see Map.Canonical.
+func (s *SyncMap) Index(id Id) (index Index, ok bool) {
+	return s.indices.Load(s.m.Canonical(id))
+}
+
+// Id returns the peer id for the given index.
+func (s *SyncMap) Id(index Index) (id Id, ok bool) {
+	ids := *s.ids.Load()
+	if ok = index >= 0 && index < len(ids); ok {
+		id = ids[index]
+	}
+	return
+}
+
+// Len returns the number of peers.
+func (s *SyncMap) Len() int { return len(*s.ids.Load()) }
+
+// Snapshot returns a consistent, read-only view of the current peers,
+// unaffected by subsequent allocations.
+func (s *SyncMap) Snapshot() *MapSnapshot {
+	return &MapSnapshot{ids: *s.ids.Load(), s: s}
+}
+
+// Sync makes newly allocated peers durable in the backing file, if any.
+// See Map.Sync.
+func (s *SyncMap) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Sync()
+}
+
+// Close syncs and closes the backing file, if any.  See Map.Close.
+func (s *SyncMap) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Close()
+}
+
+// MapSnapshot is a read-only view of the peers of a SyncMap
+// at one point in time.  It is safe for concurrent use.
+type MapSnapshot struct {
+	ids []Id
+	s   *SyncMap
+}
+
+// Len returns the number of peers in the snapshot.
+func (v *MapSnapshot) Len() int { return len(v.ids) }
+
+// Id returns the peer id for the given index.
+func (v *MapSnapshot) Id(index Index) (id Id, ok bool) {
+	if ok = index >= 0 && index < len(v.ids); ok {
+		id = v.ids[index]
+	}
+	return
+}
+
+// Index returns the peer index for the given id,
+// if the peer had been allocated when the snapshot was taken.
+func (v *MapSnapshot) Index(id Id) (index Index, ok bool) {
+	index, ok = v.s.Index(id)
+	if ok && index >= len(v.ids) {
+		return 0, false
+	}
+	return
+}
+
+// Range calls f for each peer in index order, until f returns false.
+func (v *MapSnapshot) Range(f func(index Index, id Id) bool) {
+	for index, id := range v.ids {
+		if !f(index, id) {
+			return
+		}
+	}
+}
+
+// Map returns a new, standalone peer map with the peers in the snapshot.
+func (v *MapSnapshot) Map() *Map {
+	return MapWithIds(v.ids...)
+}
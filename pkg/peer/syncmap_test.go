@@ -0,0 +1,83 @@
+package peer
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	m := MapWithIds("alice", "bob")
+	m.SetNormalizer(LowerCase)
+	s := NewSyncMap(m)
+	got := s.AllocateAll([]Id{"Bob", "carol", "CAROL", "dave"})
+	if fmt.Sprint(got) != "[1 2 2 3]" {
+		t.Errorf("AllocateAll() = %v, want [1 2 2 3]", got)
+	}
+	snapshot := s.Snapshot()
+	if got := s.Allocate("erin"); got != 4 {
+		t.Errorf("Allocate(erin) = %v, want 4", got)
+	}
+	if snapshot.Len() != 4 || s.Len() != 5 {
+		t.Errorf("Len() = %v (snapshot), %v, want 4, 5", snapshot.Len(), s.Len())
+	}
+	if _, ok := snapshot.Index("erin"); ok {
+		t.Errorf("snapshot.Index(erin) found a peer allocated after snapshot")
+	}
+	if index, ok := snapshot.Index("DAVE"); !ok || index != 3 {
+		t.Errorf("snapshot.Index(DAVE) = %v, %v, want 3, true", index, ok)
+	}
+	var ids []Id
+	snapshot.Range(func(index Index, id Id) bool {
+		ids = append(ids, id)
+		return true
+	})
+	if fmt.Sprint(ids) != "[alice bob carol dave]" {
+		t.Errorf("Range() visited %v", ids)
+	}
+}
+
+func TestSyncMap_Merged(t *testing.T) {
+	m := NewMap()
+	m.SetNormalizer(LowerCase)
+	m.load("Alice") // as if loaded from a file
+	m.load("alice")
+	s := NewSyncMap(m)
+	if index, ok := s.Index("ALICE"); !ok || index != 0 {
+		t.Errorf("Index(ALICE) = %v, %v, want 0, true", index, ok)
+	}
+}
+
+func TestSyncMap_Concurrent(t *testing.T) {
+	s := NewSyncMap(nil)
+	const numWriters, numIds = 4, 1000
+	var wg sync.WaitGroup
+	for w := 0; w < numWriters; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < numIds; i++ {
+				s.Allocate(fmt.Sprint(i))
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for s.Len() < numIds {
+			snapshot := s.Snapshot()
+			snapshot.Range(func(index Index, id Id) bool {
+				if got, ok := snapshot.Index(id); !ok || got != index {
+					t.Errorf("Index(%#v) = %v, %v, want %v, true",
+						id, got, ok, index)
+					return false
+				}
+				return true
+			})
+		}
+	}()
+	wg.Wait()
+	if s.Len() != numIds {
+		t.Errorf("Len() = %v, want %v", s.Len(), numIds)
+	}
+}
@@ -42,3 +42,15 @@ type NegativeIndex struct {
 func (e NegativeIndex) Error() string {
 	return fmt.Sprintf("negative peer index %#v", e.Value)
 }
+
+// AliasConflict means that an alias already refers to another peer.
+type AliasConflict struct {
+	Alias    Id
+	Id       Id
+	Existing Id // the peer that Alias already refers to
+}
+
+func (e AliasConflict) Error() string {
+	return fmt.Sprintf("cannot alias %#v to %#v: already refers to %#v",
+		e.Alias, e.Id, e.Existing)
+}
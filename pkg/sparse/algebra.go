@@ -0,0 +1,247 @@
+package sparse
+
+import (
+	"context"
+	"slices"
+
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// Matrix algebra.
+//
+// The methods below store their result into the receiver,
+// which may also be one of the operands.
+// They check for context cancellation between rows.
+
+// checkCtx returns the context error, if any, without blocking.
+func checkCtx(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// AddMat stores a*m1 + b*m2 into the receiver.
+//
+// m1 and m2 must have the same dimensions.
+// Entries that sum to zero are dropped.
+func (m *CSRMatrix) AddMat(
+	ctx context.Context, a float64, m1 *CSRMatrix, b float64, m2 *CSRMatrix,
+) error {
+	rows, cols := m1.Dims()
+	if rows2, cols2 := m2.Dims(); rows != rows2 || cols != cols2 {
+		return ErrDimensionMismatch
+	}
+	entries := make([][]Entry, rows)
+	for row := range entries {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		s1, s2 := m1.Entries[row], m2.Entries[row]
+		var span []Entry
+		if len(s1)+len(s2) > 0 {
+			span = make([]Entry, 0, len(s1)+len(s2))
+		}
+		for len(s1) > 0 || len(s2) > 0 {
+			var e Entry
+			switch {
+			case len(s2) == 0 || (len(s1) > 0 && s1[0].Index < s2[0].Index):
+				e = Entry{Index: s1[0].Index, Value: a * s1[0].Value}
+				s1 = s1[1:]
+			case len(s1) == 0 || s2[0].Index < s1[0].Index:
+				e = Entry{Index: s2[0].Index, Value: b * s2[0].Value}
+				s2 = s2[1:]
+			default: // s1[0].Index == s2[0].Index
+				e = Entry{
+					Index: s1[0].Index,
+					Value: a*s1[0].Value + b*s2[0].Value,
+				}
+				s1, s2 = s1[1:], s2[1:]
+			}
+			if e.Value != 0 {
+				span = append(span, e)
+			}
+		}
+		entries[row] = util.ShrinkWrap(span)
+	}
+	m.setEntries(rows, cols, entries)
+	return nil
+}
+
+// ScaleMat stores a*m1 into the receiver.
+func (m *CSRMatrix) ScaleMat(ctx context.Context, a float64, m1 *CSRMatrix) error {
+	rows, cols := m1.Dims()
+	entries := make([][]Entry, rows)
+	if a != 0 {
+		for row, span1 := range m1.Entries {
+			if err := checkCtx(ctx); err != nil {
+				return err
+			}
+			v := &Vector{Dim: cols, Entries: slices.Clone(span1)}
+			v.scaleInPlace(a)
+			entries[row] = util.ShrinkWrap(v.Entries)
+		}
+	}
+	m.setEntries(rows, cols, entries)
+	return nil
+}
+
+// SelectRows stores the given rows of m1 into the receiver,
+// i.e. row i of the receiver is row rows[i] of m1.
+//
+// The receiver has len(rows) rows and the same columns as m1.
+// rows may repeat the same row.
+func (m *CSRMatrix) SelectRows(
+	ctx context.Context, m1 *CSRMatrix, rows []int,
+) error {
+	rows1, cols := m1.Dims()
+	entries := make([][]Entry, len(rows))
+	for i, row := range rows {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		if row < 0 || row >= rows1 {
+			return util.IndexOutOfBoundsError{Index: row, Bound: rows1}
+		}
+		entries[i] = util.ShrinkWrap(slices.Clone(m1.Entries[row]))
+	}
+	m.setEntries(len(rows), cols, entries)
+	return nil
+}
+
+// SelectColumns stores the given columns of m1 into the receiver,
+// i.e. column j of the receiver is column cols[j] of m1.
+//
+// The receiver has the same rows as m1 and len(cols) columns.
+// cols must not repeat the same column.
+func (m *CSRMatrix) SelectColumns(
+	ctx context.Context, m1 *CSRMatrix, cols []int,
+) error {
+	rows, cols1 := m1.Dims()
+	newCols := make([]int, cols1) // m1 column -> receiver column + 1
+	for j, col := range cols {
+		switch {
+		case col < 0 || col >= cols1:
+			return util.IndexOutOfBoundsError{Index: col, Bound: cols1}
+		case newCols[col] != 0:
+			return DuplicateIndexError{Index: col}
+		}
+		newCols[col] = j + 1
+	}
+	entries := make([][]Entry, rows)
+	for row, span1 := range m1.Entries {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		var span []Entry
+		for _, e := range span1 {
+			if j := newCols[e.Index]; j != 0 {
+				span = append(span, Entry{Index: j - 1, Value: e.Value})
+			}
+		}
+		entries[row] = util.ShrinkWrap(SortEntriesByIndex(span))
+	}
+	m.setEntries(rows, len(cols), entries)
+	return nil
+}
+
+// MulElemMat stores the element-wise (Hadamard) product of m1 and m2
+// into the receiver.
+//
+// m1 and m2 must have the same dimensions.
+func (m *CSRMatrix) MulElemMat(
+	ctx context.Context, m1 *CSRMatrix, m2 *CSRMatrix,
+) error {
+	rows, cols := m1.Dims()
+	if rows2, cols2 := m2.Dims(); rows != rows2 || cols != cols2 {
+		return ErrDimensionMismatch
+	}
+	entries := make([][]Entry, rows)
+	for row := range entries {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		s1, s2 := m1.Entries[row], m2.Entries[row]
+		var span []Entry
+		for len(s1) > 0 && len(s2) > 0 {
+			switch {
+			case s1[0].Index < s2[0].Index:
+				s1 = s1[1:]
+			case s2[0].Index < s1[0].Index:
+				s2 = s2[1:]
+			default:
+				if value := s1[0].Value * s2[0].Value; value != 0 {
+					span = append(span, Entry{Index: s1[0].Index, Value: value})
+				}
+				s1, s2 = s1[1:], s2[1:]
+			}
+		}
+		entries[row] = util.ShrinkWrap(span)
+	}
+	m.setEntries(rows, cols, entries)
+	return nil
+}
+
+// MulMat stores the matrix product m1·m2 into the receiver
+// (sparse–sparse multiplication, aka SpGEMM).
+//
+// maxNNZ is the fill-in budget:
+// If positive and the product would have more nonzero entries,
+// MulMat returns FillInBudgetError, leaving the receiver intact.
+//
+// m1's column count must match m2's row count.
+func (m *CSRMatrix) MulMat(
+	ctx context.Context, m1 *CSRMatrix, m2 *CSRMatrix, maxNNZ int,
+) error {
+	rows, inner := m1.Dims()
+	inner2, cols := m2.Dims()
+	if inner != inner2 {
+		return ErrDimensionMismatch
+	}
+	// Gustavson's algorithm:
+	// Accumulate each product row into a dense scratch row (sums),
+	// tracking which columns it touched (touched).
+	sums := make([]KBNSummer, cols)
+	seen := make([]bool, cols)
+	var touched []int
+	entries := make([][]Entry, rows)
+	nnz := 0
+	for row, span1 := range m1.Entries {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		touched = touched[:0]
+		for _, e1 := range span1 {
+			for _, e2 := range m2.Entries[e1.Index] {
+				if !seen[e2.Index] {
+					seen[e2.Index] = true
+					touched = append(touched, e2.Index)
+				}
+				sums[e2.Index].Add(e1.Value * e2.Value)
+			}
+		}
+		slices.Sort(touched)
+		var span []Entry
+		for _, col := range touched {
+			if value := sums[col].Sum(); value != 0 {
+				span = append(span, Entry{Index: col, Value: value})
+			}
+			sums[col], seen[col] = KBNSummer{}, false
+		}
+		nnz += len(span)
+		if maxNNZ > 0 && nnz > maxNNZ {
+			return FillInBudgetError{Budget: maxNNZ}
+		}
+		entries[row] = util.ShrinkWrap(span)
+	}
+	m.setEntries(rows, cols, entries)
+	return nil
+}
+
+// setEntries replaces the receiver's contents.
+func (m *CSMatrix) setEntries(majorDim, minorDim int, entries [][]Entry) {
+	_ = m.Munmap()
+	m.MajorDim, m.MinorDim, m.Entries = majorDim, minorDim, entries
+}
@@ -0,0 +1,326 @@
+package sparse
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// denseCSR returns a CSR matrix with the given (dense) rows and cols.
+func denseCSR(cols int, rows ...[]float64) *CSRMatrix {
+	m := &CSRMatrix{CSMatrix: CSMatrix{
+		MajorDim: len(rows),
+		MinorDim: cols,
+		Entries:  make([][]Entry, len(rows)),
+	}}
+	for i, row := range rows {
+		for j, value := range row {
+			if value != 0 {
+				m.Entries[i] = append(m.Entries[i], Entry{j, value})
+			}
+		}
+	}
+	return m
+}
+
+func TestCSRMatrix_AddMat(t *testing.T) {
+	m1 := denseCSR(3,
+		[]float64{1, 0, 2},
+		[]float64{0, 0, 0},
+		[]float64{3, 4, 0},
+	)
+	m2 := denseCSR(3,
+		[]float64{0, 5, 1},
+		[]float64{6, 0, 0},
+		[]float64{1.5, 0, 7},
+	)
+	tests := []struct {
+		name    string
+		a, b    float64
+		m2      *CSRMatrix
+		want    *CSRMatrix
+		wantErr error
+	}{
+		{
+			"Plain", 1, 1, m2,
+			denseCSR(3,
+				[]float64{1, 5, 3},
+				[]float64{6, 0, 0},
+				[]float64{4.5, 4, 7},
+			),
+			nil,
+		},
+		{
+			"WeightedCancel", 2, -2, m2,
+			denseCSR(3,
+				[]float64{2, -10, 2},
+				[]float64{-12, 0, 0},
+				[]float64{3, 8, -14},
+			),
+			nil,
+		},
+		{
+			"ZeroSumDropped", 1, -2, denseCSR(3,
+				[]float64{0.5, 0, 1},
+				[]float64{0, 0, 0},
+				[]float64{0, 2, 0},
+			),
+			denseCSR(3,
+				[]float64{0, 0, 0},
+				[]float64{0, 0, 0},
+				[]float64{3, 0, 0},
+			),
+			nil,
+		},
+		{
+			"DimensionMismatch", 1, 1, denseCSR(2, []float64{1, 2}),
+			nil, ErrDimensionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &CSRMatrix{}
+			err := m.AddMat(context.Background(), tt.a, m1, tt.b, tt.m2)
+			if err != tt.wantErr {
+				t.Fatalf("AddMat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("AddMat() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRMatrix_AddMat_Alias(t *testing.T) {
+	m := denseCSR(2, []float64{1, 2}, []float64{0, 3})
+	if err := m.AddMat(context.Background(), 1, m, 1, m); err != nil {
+		t.Fatalf("AddMat() error = %v", err)
+	}
+	want := denseCSR(2, []float64{2, 4}, []float64{0, 6})
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("AddMat() got = %v, want %v", m, want)
+	}
+}
+
+func TestCSRMatrix_ScaleMat(t *testing.T) {
+	m1 := denseCSR(2, []float64{1, 2}, []float64{0, 3})
+	tests := []struct {
+		name string
+		a    float64
+		want *CSRMatrix
+	}{
+		{"One", 1, denseCSR(2, []float64{1, 2}, []float64{0, 3})},
+		{"Half", 0.5, denseCSR(2, []float64{0.5, 1}, []float64{0, 1.5})},
+		{"Zero", 0, denseCSR(2, []float64{0, 0}, []float64{0, 0})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &CSRMatrix{}
+			if err := m.ScaleMat(context.Background(), tt.a, m1); err != nil {
+				t.Fatalf("ScaleMat() error = %v", err)
+			}
+			if !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("ScaleMat() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+	if m1.Entries[0][0].Value != 1 {
+		t.Errorf("ScaleMat() modified its operand")
+	}
+}
+
+func TestCSRMatrix_SelectRows(t *testing.T) {
+	m1 := denseCSR(3,
+		[]float64{1, 0, 2},
+		[]float64{0, 0, 0},
+		[]float64{3, 4, 0},
+	)
+	tests := []struct {
+		name    string
+		rows    []int
+		want    *CSRMatrix
+		wantErr error
+	}{
+		{"None", nil, denseCSR(3), nil},
+		{
+			"Reorder", []int{2, 0, 2, 1},
+			denseCSR(3,
+				[]float64{3, 4, 0},
+				[]float64{1, 0, 2},
+				[]float64{3, 4, 0},
+				[]float64{0, 0, 0},
+			),
+			nil,
+		},
+		{
+			"OutOfBounds", []int{0, 3}, nil,
+			util.IndexOutOfBoundsError{Index: 3, Bound: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &CSRMatrix{}
+			err := m.SelectRows(context.Background(), m1, tt.rows)
+			if err != tt.wantErr {
+				t.Fatalf("SelectRows() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("SelectRows() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRMatrix_SelectColumns(t *testing.T) {
+	m1 := denseCSR(3,
+		[]float64{1, 0, 2},
+		[]float64{0, 0, 0},
+		[]float64{3, 4, 0},
+	)
+	tests := []struct {
+		name    string
+		cols    []int
+		want    *CSRMatrix
+		wantErr error
+	}{
+		{
+			"Reorder", []int{2, 0},
+			denseCSR(2,
+				[]float64{2, 1},
+				[]float64{0, 0},
+				[]float64{0, 3},
+			),
+			nil,
+		},
+		{
+			"Single", []int{1},
+			denseCSR(1, []float64{0}, []float64{0}, []float64{4}),
+			nil,
+		},
+		{
+			"Duplicate", []int{0, 1, 0}, nil,
+			DuplicateIndexError{Index: 0},
+		},
+		{
+			"OutOfBounds", []int{-1}, nil,
+			util.IndexOutOfBoundsError{Index: -1, Bound: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &CSRMatrix{}
+			err := m.SelectColumns(context.Background(), m1, tt.cols)
+			if err != tt.wantErr {
+				t.Fatalf("SelectColumns() error = %v, wantErr %v",
+					err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("SelectColumns() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRMatrix_MulElemMat(t *testing.T) {
+	m1 := denseCSR(3,
+		[]float64{1, 0, 2},
+		[]float64{5, 0, 0},
+		[]float64{3, 4, 0},
+	)
+	m2 := denseCSR(3,
+		[]float64{2, 5, 3},
+		[]float64{0, 6, 0},
+		[]float64{0.5, 0, 7},
+	)
+	want := denseCSR(3,
+		[]float64{2, 0, 6},
+		[]float64{0, 0, 0},
+		[]float64{1.5, 0, 0},
+	)
+	m := &CSRMatrix{}
+	if err := m.MulElemMat(context.Background(), m1, m2); err != nil {
+		t.Fatalf("MulElemMat() error = %v", err)
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("MulElemMat() got = %v, want %v", m, want)
+	}
+	err := m.MulElemMat(context.Background(), m1, denseCSR(3, []float64{1}))
+	if err != ErrDimensionMismatch {
+		t.Errorf("MulElemMat() error = %v, want %v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestCSRMatrix_MulMat(t *testing.T) {
+	//   1 0 2     0 1     6 1
+	//   0 3 0  ×  1 0  =  3 0
+	//             3 0
+	m1 := denseCSR(3, []float64{1, 0, 2}, []float64{0, 3, 0})
+	m2 := denseCSR(2, []float64{0, 1}, []float64{1, 0}, []float64{3, 0})
+	tests := []struct {
+		name    string
+		m2      *CSRMatrix
+		maxNNZ  int
+		want    *CSRMatrix
+		wantErr error
+	}{
+		{
+			"Unlimited", m2, 0,
+			denseCSR(2, []float64{6, 1}, []float64{3, 0}),
+			nil,
+		},
+		{
+			"WithinBudget", m2, 3,
+			denseCSR(2, []float64{6, 1}, []float64{3, 0}),
+			nil,
+		},
+		{
+			"OverBudget", m2, 2, nil,
+			FillInBudgetError{Budget: 2},
+		},
+		{
+			"Cancellation", denseCSR(1,
+				[]float64{1}, []float64{0}, []float64{-0.5},
+			), 0,
+			denseCSR(1, []float64{0}, []float64{0}),
+			nil,
+		},
+		{
+			"DimensionMismatch", denseCSR(2, []float64{1, 0}), 0, nil,
+			ErrDimensionMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &CSRMatrix{}
+			err := m.MulMat(context.Background(), m1, tt.m2, tt.maxNNZ)
+			if err != tt.wantErr {
+				t.Fatalf("MulMat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("MulMat() got = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRMatrix_Algebra_Canceled(t *testing.T) {
+	m1 := denseCSR(2, []float64{1, 2}, []float64{0, 3})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m := &CSRMatrix{}
+	for name, err := range map[string]error{
+		"AddMat":        m.AddMat(ctx, 1, m1, 1, m1),
+		"ScaleMat":      m.ScaleMat(ctx, 2, m1),
+		"SelectRows":    m.SelectRows(ctx, m1, []int{0}),
+		"SelectColumns": m.SelectColumns(ctx, m1, []int{0}),
+		"MulElemMat":    m.MulElemMat(ctx, m1, m1),
+		"MulMat":        m.MulMat(ctx, m1, m1, 0),
+	} {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("%s() error = %v, want %v", name, err, context.Canceled)
+		}
+	}
+}
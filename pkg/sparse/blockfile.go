@@ -0,0 +1,686 @@
+package sparse
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// A block file stores a compressed sparse matrix on disk,
+// partitioned into blocks of consecutive major spans (rows),
+// so that it can be streamed one block at a time.
+//
+// All integers are little-endian.  The file consists of:
+//
+//   - The header (blockFileHeaderSize bytes): the magic string,
+//     then major dim, minor dim, nnz, rows per block, number of blocks,
+//     and the byte offset of the block table, each as uint64.
+//   - Blocks.  Each block contains, for each of its major spans,
+//     the cumulative nnz within the block (uint64),
+//     then the minor indices (uint32) of all entries in the block,
+//     padded to a multiple of 8 bytes,
+//     then the values (float64) of all entries in the block.
+//   - The block table: the byte offset (uint64) and nnz (uint64)
+//     of each block.
+
+const blockFileMagic = "EGTBLK01"
+
+const blockFileHeaderSize = 8 + 6*8
+
+// blockFileRowsPerBlock is the number of major spans per block.
+var blockFileRowsPerBlock = 1 << 12
+
+// blockFileRunSize is the maximum number of entries
+// that BuildBlockFile sorts in memory before spilling onto disk.
+var blockFileRunSize = 1 << 24
+
+type blockFileHeader struct {
+	MajorDim, MinorDim, NNZ, RowsPerBlock, NumBlocks, TableOffset uint64
+}
+
+type blockFileBlock struct {
+	Offset, NNZ uint64
+}
+
+// BlockFile is a compressed sparse matrix streamed from a block file.
+//
+// Create the file with BuildBlockFile, then open it with OpenBlockFile.
+// As an Operator, a BlockFile reads the needed blocks from disk
+// upon each MulRows call, keeping only one block at a time in memory
+// (per concurrent call), so the matrix itself need not fit in memory.
+//
+// To compute global trust out of core, build the transposed local trust
+// into a block file, canonicalize it with basic.CanonicalizeBlockFile,
+// and pass it to basic.Compute with basic.WithTransposedLocalTrust
+// and basic.WithLeakRedistribution.
+// Block files are a library feature; the CLI and the servers
+// always load local trust into memory.
+type BlockFile struct {
+	file   *os.File
+	header blockFileHeader
+	blocks []blockFileBlock
+	scale  []float64
+}
+
+// BuildBlockFile creates a block file at the given path
+// with the entries taken from a channel.
+//
+// It accepts the same options as NewCSMatrixFromEntryCh;
+// in particular, spopt.ColumnMajor stores the transpose,
+// e.g. for use as the transposed local trust with basic.Compute.
+// Entries are sorted out of core, i.e. using temporary files
+// in the same directory as path, so that they need not fit in memory.
+func BuildBlockFile(
+	ctx context.Context, path string, ch <-chan CooEntry,
+	opts ...spopt.Option,
+) (err error) {
+	o := spopt.New(opts...)
+	majorAxis, minorAxis := o.MajorMinorAxes()
+	majMinFromRowCol := o.MajMinFromRowCol()
+	majorDim, minorDim := majorAxis.Dim, minorAxis.Dim
+	var runs []*os.File
+	defer func() {
+		for _, run := range runs {
+			util.Close(run)
+			_ = os.Remove(run.Name())
+		}
+	}()
+	run := make([]blockFileRecord, 0, min(blockFileRunSize, 1<<16))
+	spill := func() error {
+		sortBlockFileRecords(run)
+		f, err := os.CreateTemp(filepath.Dir(path),
+			"eigentrust-blockfile-run.")
+		if err != nil {
+			return err
+		}
+		runs = append(runs, f)
+		w := bufio.NewWriter(f)
+		for _, r := range run {
+			if _, err = w.Write(r.encode()); err != nil {
+				return err
+			}
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+		_, err = f.Seek(0, io.SeekStart)
+		run = run[:0]
+		return err
+	}
+EntryLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-ch:
+			switch {
+			case !ok:
+				break EntryLoop
+			case e.Value == 0 && !o.Value.IncludeZero:
+				continue EntryLoop
+			case e.Value < 0 && !o.Value.AllowNegative:
+				return NegativeValueError{e.Value}
+			}
+			major, minor := majMinFromRowCol(e.Row, e.Column)
+			if major >= majorDim {
+				if !majorAxis.Grow {
+					return util.IndexOutOfBoundsError{
+						Index: major, Bound: majorDim,
+					}
+				}
+				majorDim = major + 1
+			}
+			if minor >= minorDim {
+				if !minorAxis.Grow {
+					return util.IndexOutOfBoundsError{
+						Index: minor, Bound: minorDim,
+					}
+				}
+				minorDim = minor + 1
+			}
+			run = append(run, blockFileRecord{
+				Major: uint64(major), Minor: uint64(minor), Value: e.Value,
+			})
+			if len(run) >= blockFileRunSize {
+				if err = spill(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if uint64(minorDim) > MaxCompactDim {
+		return fmt.Errorf("minor dimension %d too big for block file",
+			minorDim)
+	}
+	var next func() (blockFileRecord, bool, error)
+	if len(runs) == 0 {
+		sortBlockFileRecords(run)
+		next = func() (r blockFileRecord, ok bool, err error) {
+			if len(run) == 0 {
+				return r, false, nil
+			}
+			r, run = run[0], run[1:]
+			return r, true, nil
+		}
+	} else {
+		if len(run) > 0 {
+			if err = spill(); err != nil {
+				return err
+			}
+		}
+		run = nil
+		next, err = mergeBlockFileRuns(runs)
+		if err != nil {
+			return err
+		}
+	}
+	w, err := newBlockFileWriter(path, majorDim, minorDim)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err1 := w.Close(); err == nil {
+			err = err1
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+	rowColFromMajMin := o.RowColFromMajMin()
+	var span []Entry
+	spanMajor := 0
+	for {
+		r, ok, err := next()
+		if err != nil {
+			return err
+		}
+		if !ok || int(r.Major) != spanMajor {
+			span, err = dedupSpan(span, o.Value)
+			if err != nil {
+				var dup DuplicateIndexError
+				if errors.As(err, &dup) {
+					row, col := rowColFromMajMin(spanMajor, dup.Index)
+					err = DuplicateEntryError{Row: row, Column: col}
+				}
+				return err
+			}
+			if spanMajor < majorDim {
+				if err = w.WriteSpan(ctx, spanMajor, span); err != nil {
+					return err
+				}
+			}
+			if !ok {
+				break
+			}
+			span, spanMajor = span[:0], int(r.Major)
+		}
+		span = append(span, Entry{Index: int(r.Minor), Value: r.Value})
+	}
+	return nil
+}
+
+// blockFileRecord is an entry in a sorted run.
+type blockFileRecord struct {
+	Major, Minor uint64
+	Value        float64
+}
+
+const blockFileRecordSize = 24
+
+func (r *blockFileRecord) encode() []byte {
+	var buf [blockFileRecordSize]byte
+	binary.LittleEndian.PutUint64(buf[0:], r.Major)
+	binary.LittleEndian.PutUint64(buf[8:], r.Minor)
+	binary.LittleEndian.PutUint64(buf[16:], math.Float64bits(r.Value))
+	return buf[:]
+}
+
+func (r *blockFileRecord) decode(buf []byte) {
+	r.Major = binary.LittleEndian.Uint64(buf[0:])
+	r.Minor = binary.LittleEndian.Uint64(buf[8:])
+	r.Value = math.Float64frombits(binary.LittleEndian.Uint64(buf[16:]))
+}
+
+func sortBlockFileRecords(records []blockFileRecord) {
+	// Stable: Duplicates stay in the order they were seen.
+	sort.SliceStable(records, func(i, j int) bool {
+		ri, rj := &records[i], &records[j]
+		return ri.Major < rj.Major ||
+			(ri.Major == rj.Major && ri.Minor < rj.Minor)
+	})
+}
+
+type blockFileRunHead struct {
+	record blockFileRecord
+	run    int
+}
+
+type blockFileRunHeap []blockFileRunHead
+
+func (h blockFileRunHeap) Len() int { return len(h) }
+func (h blockFileRunHeap) Less(i, j int) bool {
+	ri, rj := &h[i].record, &h[j].record
+	switch {
+	case ri.Major != rj.Major:
+		return ri.Major < rj.Major
+	case ri.Minor != rj.Minor:
+		return ri.Minor < rj.Minor
+	default: // earlier run first, so duplicates stay in the order seen
+		return h[i].run < h[j].run
+	}
+}
+func (h blockFileRunHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *blockFileRunHeap) Push(x any)   { *h = append(*h, x.(blockFileRunHead)) }
+func (h *blockFileRunHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// mergeBlockFileRuns k-way merges the given sorted runs.
+func mergeBlockFileRuns(
+	runs []*os.File,
+) (next func() (blockFileRecord, bool, error), err error) {
+	readers := make([]*bufio.Reader, len(runs))
+	h := make(blockFileRunHeap, 0, len(runs))
+	var buf [blockFileRecordSize]byte
+	read := func(run int) error {
+		_, err := io.ReadFull(readers[run], buf[:])
+		switch {
+		case err == nil:
+			head := blockFileRunHead{run: run}
+			head.record.decode(buf[:])
+			heap.Push(&h, head)
+		case errors.Is(err, io.EOF):
+		default:
+			return err
+		}
+		return nil
+	}
+	for run, f := range runs {
+		readers[run] = bufio.NewReader(f)
+		if err = read(run); err != nil {
+			return nil, err
+		}
+	}
+	return func() (r blockFileRecord, ok bool, err error) {
+		if len(h) == 0 {
+			return r, false, nil
+		}
+		head := heap.Pop(&h).(blockFileRunHead)
+		if err = read(head.run); err != nil {
+			return r, false, err
+		}
+		return head.record, true, nil
+	}, nil
+}
+
+// blockFileWriter writes major spans into a new block file, in order.
+type blockFileWriter struct {
+	file   *os.File
+	w      *bufio.Writer
+	offset uint64
+	header blockFileHeader
+	blocks []blockFileBlock
+	spans  []uint64 // cumulative nnz of major spans in the current block
+	block  []Entry  // entries in the current block
+	major  int      // next major index to write
+}
+
+func newBlockFileWriter(
+	path string, majorDim, minorDim int,
+) (*blockFileWriter, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w := &blockFileWriter{
+		file:   file,
+		w:      bufio.NewWriter(file),
+		offset: blockFileHeaderSize,
+		header: blockFileHeader{
+			MajorDim:     uint64(majorDim),
+			MinorDim:     uint64(minorDim),
+			RowsPerBlock: uint64(blockFileRowsPerBlock),
+		},
+	}
+	// Header placeholder; Close fills it in.
+	if _, err = w.w.Write(make([]byte, blockFileHeaderSize)); err != nil {
+		util.Close(file)
+		return nil, err
+	}
+	return w, nil
+}
+
+// WriteSpan writes the given major span.
+// Skipped major spans (since the last call) are written as empty.
+func (w *blockFileWriter) WriteSpan(
+	ctx context.Context, major int, span []Entry,
+) error {
+	for ; w.major <= major; w.major++ {
+		if len(w.spans) == blockFileRowsPerBlock {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if err := w.flushBlock(); err != nil {
+				return err
+			}
+		}
+		if w.major == major {
+			w.block = append(w.block, span...)
+		}
+		w.spans = append(w.spans, uint64(len(w.block)))
+	}
+	return nil
+}
+
+func (w *blockFileWriter) flushBlock() error {
+	if len(w.spans) == 0 {
+		return nil
+	}
+	nnz := uint64(len(w.block))
+	w.blocks = append(w.blocks, blockFileBlock{Offset: w.offset, NNZ: nnz})
+	var buf [8]byte
+	write := func(b []byte) error {
+		_, err := w.w.Write(b)
+		w.offset += uint64(len(b))
+		return err
+	}
+	for _, end := range w.spans {
+		binary.LittleEndian.PutUint64(buf[:], end)
+		if err := write(buf[:]); err != nil {
+			return err
+		}
+	}
+	for _, e := range w.block {
+		binary.LittleEndian.PutUint32(buf[:4], uint32(e.Index))
+		if err := write(buf[:4]); err != nil {
+			return err
+		}
+	}
+	if nnz%2 != 0 {
+		if err := write(make([]byte, 4)); err != nil {
+			return err
+		}
+	}
+	for _, e := range w.block {
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(e.Value))
+		if err := write(buf[:]); err != nil {
+			return err
+		}
+	}
+	w.header.NNZ += nnz
+	w.spans, w.block = w.spans[:0], w.block[:0]
+	return nil
+}
+
+// Close writes the remaining major spans, the block table, and the header.
+func (w *blockFileWriter) Close() (err error) {
+	defer func() {
+		if err1 := w.file.Close(); err == nil {
+			err = err1
+		}
+	}()
+	if w.major < int(w.header.MajorDim) {
+		err = w.WriteSpan(context.Background(), int(w.header.MajorDim)-1, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if err = w.flushBlock(); err != nil {
+		return err
+	}
+	w.header.NumBlocks = uint64(len(w.blocks))
+	w.header.TableOffset = w.offset
+	if err = binary.Write(w.w, binary.LittleEndian, w.blocks); err != nil {
+		return err
+	}
+	if err = w.w.Flush(); err != nil {
+		return err
+	}
+	var header bytes.Buffer
+	header.WriteString(blockFileMagic)
+	if err = binary.Write(&header, binary.LittleEndian, w.header); err != nil {
+		return err
+	}
+	_, err = w.file.WriteAt(header.Bytes(), 0)
+	return err
+}
+
+// OpenBlockFile opens the block file at the given path.
+//
+// Close the returned BlockFile after use.
+func OpenBlockFile(path string) (*BlockFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &BlockFile{file: file}
+	if err = m.readIndex(); err != nil {
+		util.Close(file)
+		return nil, fmt.Errorf("invalid block file %#v: %w", path, err)
+	}
+	return m, nil
+}
+
+func (m *BlockFile) readIndex() error {
+	buf := make([]byte, blockFileHeaderSize)
+	if _, err := m.file.ReadAt(buf, 0); err != nil {
+		return err
+	}
+	if string(buf[:len(blockFileMagic)]) != blockFileMagic {
+		return errors.New("bad magic")
+	}
+	err := binary.Read(bytes.NewReader(buf[len(blockFileMagic):]),
+		binary.LittleEndian, &m.header)
+	if err != nil {
+		return err
+	}
+	h := &m.header
+	if h.RowsPerBlock == 0 ||
+		h.NumBlocks != (h.MajorDim+h.RowsPerBlock-1)/h.RowsPerBlock {
+		return errors.New("inconsistent header")
+	}
+	m.blocks = make([]blockFileBlock, h.NumBlocks)
+	r := io.NewSectionReader(m.file, int64(h.TableOffset),
+		int64(h.NumBlocks)*16)
+	return binary.Read(r, binary.LittleEndian, m.blocks)
+}
+
+// Close closes the underlying file.
+func (m *BlockFile) Close() error { return m.file.Close() }
+
+// Dims returns the dimensions of the matrix.
+func (m *BlockFile) Dims() (majorDim, minorDim int) {
+	return int(m.header.MajorDim), int(m.header.MinorDim)
+}
+
+// Dim asserts the receiver is a square matrix and returns the dimension.
+func (m *BlockFile) Dim() (int, error) {
+	if m.header.MajorDim != m.header.MinorDim {
+		return 0, ErrDimensionMismatch
+	}
+	return int(m.header.MajorDim), nil
+}
+
+// NNZ counts nonzero entries.
+func (m *BlockFile) NNZ() int { return int(m.header.NNZ) }
+
+// ScaleMinor makes the receiver scale each entry by scale[minor index]
+// when reading them, without modifying the file; nil disables scaling.
+//
+// For example, basic.CanonicalizeBlockFile uses this
+// to canonicalize a transposed local trust in a block file.
+func (m *BlockFile) ScaleMinor(scale []float64) { m.scale = scale }
+
+// blockFileBufs pools buffers used to read blocks.
+var blockFileBufs = sync.Pool{New: func() any { return new([]byte) }}
+
+// readBlockSpans reads major spans [begin..end) of the given block,
+// both relative to the block start, then calls f for each entry read.
+func (m *BlockFile) readBlockSpans(
+	block, begin, end int, f func(major int, index uint32, value float64),
+) error {
+	b := m.blocks[block]
+	bufp := blockFileBufs.Get().(*[]byte)
+	defer blockFileBufs.Put(bufp)
+	// span ends, preceded by the end of the previous span if any
+	first := max(begin-1, 0)
+	*bufp = slices.Grow((*bufp)[:0], (end-first)*8)[:(end-first)*8]
+	_, err := m.file.ReadAt(*bufp, int64(b.Offset)+int64(first)*8)
+	if err != nil {
+		return err
+	}
+	ends := make([]uint64, end-first)
+	for i := range ends {
+		ends[i] = binary.LittleEndian.Uint64((*bufp)[i*8:])
+	}
+	var entryBegin uint64
+	if begin > 0 {
+		entryBegin, ends = ends[0], ends[1:]
+	}
+	entryEnd := ends[len(ends)-1]
+	n := int(entryEnd - entryBegin)
+	if n == 0 {
+		return nil
+	}
+	numSpans := min(int(m.header.RowsPerBlock),
+		int(m.header.MajorDim)-block*int(m.header.RowsPerBlock))
+	indicesOffset := b.Offset + uint64(numSpans)*8
+	valuesOffset := indicesOffset + (b.NNZ+b.NNZ%2)*4
+	*bufp = slices.Grow((*bufp)[:0], n*12)[:n*12]
+	indices, values := (*bufp)[:n*4], (*bufp)[n*4:]
+	_, err = m.file.ReadAt(indices, int64(indicesOffset+entryBegin*4))
+	if err != nil {
+		return err
+	}
+	_, err = m.file.ReadAt(values, int64(valuesOffset+entryBegin*8))
+	if err != nil {
+		return err
+	}
+	k := 0
+	for i, spanEnd := range ends {
+		for ; uint64(k) < spanEnd-entryBegin; k++ {
+			f(begin+i, binary.LittleEndian.Uint32(indices[k*4:]),
+				math.Float64frombits(binary.LittleEndian.Uint64(values[k*8:])))
+		}
+	}
+	return nil
+}
+
+// forEachBlockSpans calls readBlockSpans
+// for each block overlapping major spans [begin..end).
+func (m *BlockFile) forEachBlockSpans(
+	begin, end int, f func(major int, index uint32, value float64),
+) error {
+	rowsPerBlock := int(m.header.RowsPerBlock)
+	for begin < end {
+		block := begin / rowsPerBlock
+		blockBegin := block * rowsPerBlock
+		blockEnd := min(blockBegin+rowsPerBlock, end)
+		err := m.readBlockSpans(block, begin-blockBegin, blockEnd-blockBegin,
+			func(major int, index uint32, value float64) {
+				f(blockBegin+major, index, value)
+			})
+		if err != nil {
+			return err
+		}
+		begin = blockEnd
+	}
+	return nil
+}
+
+// MulRows computes rows [begin..end) of m multiplied by x into y[begin:end].
+func (m *BlockFile) MulRows(y, x []float64, begin, end int) error {
+	summers := make([]KBNSummer, end-begin)
+	err := m.forEachBlockSpans(begin, end,
+		func(major int, index uint32, value float64) {
+			if m.scale != nil {
+				value *= m.scale[index]
+			}
+			summers[major-begin].Add(value * x[index])
+		})
+	if err != nil {
+		return err
+	}
+	for i := range summers {
+		y[begin+i] = summers[i].Sum()
+	}
+	return nil
+}
+
+// MinorSums returns the sums of entries for each minor index,
+// e.g. the row sums of the original matrix if the file stores its transpose.
+//
+// MinorSums ignores ScaleMinor.
+func (m *BlockFile) MinorSums(ctx context.Context) ([]float64, error) {
+	summers := make([]KBNSummer, m.header.MinorDim)
+	majorDim, rowsPerBlock := int(m.header.MajorDim), int(m.header.RowsPerBlock)
+	for begin := 0; begin < majorDim; begin += rowsPerBlock {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		err := m.forEachBlockSpans(begin, min(begin+rowsPerBlock, majorDim),
+			func(major int, index uint32, value float64) {
+				summers[index].Add(value)
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sums := make([]float64, len(summers))
+	for i := range summers {
+		sums[i] = summers[i].Sum()
+	}
+	return sums, nil
+}
+
+// ReadMatrix reads the entire contents into a new CSMatrix in memory,
+// applying ScaleMinor if set.
+func (m *BlockFile) ReadMatrix(ctx context.Context) (*CSMatrix, error) {
+	majorDim, minorDim := m.Dims()
+	cm := &CSMatrix{
+		MajorDim: majorDim,
+		MinorDim: minorDim,
+		Entries:  make([][]Entry, majorDim),
+	}
+	rowsPerBlock := int(m.header.RowsPerBlock)
+	for begin := 0; begin < majorDim; begin += rowsPerBlock {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		err := m.forEachBlockSpans(begin, min(begin+rowsPerBlock, majorDim),
+			func(major int, index uint32, value float64) {
+				if m.scale != nil {
+					value *= m.scale[index]
+				}
+				cm.Entries[major] = append(cm.Entries[major],
+					Entry{Index: int(index), Value: value})
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cm, nil
+}
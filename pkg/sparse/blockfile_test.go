@@ -0,0 +1,140 @@
+package sparse
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+func TestBlockFile(t *testing.T) {
+	// Small blocks and runs, to exercise multiple blocks and the merge.
+	defer func(rowsPerBlock, runSize int) {
+		blockFileRowsPerBlock, blockFileRunSize = rowsPerBlock, runSize
+	}(blockFileRowsPerBlock, blockFileRunSize)
+	blockFileRowsPerBlock, blockFileRunSize = 7, 50
+
+	const n = 100
+	rng := rand.New(rand.NewSource(1))
+	var entries []CooEntry
+	for k := 0; k < 1000; k++ {
+		entries = append(entries, CooEntry{
+			Row:    rng.Intn(n - 10), // leave trailing empty rows
+			Column: rng.Intn(n),
+			Value:  float64(rng.Intn(5)), // with zeros and duplicates
+		})
+	}
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		opts []spopt.Option
+	}{
+		{"RowMajor", []spopt.Option{spopt.FixedDim(n, n)}},
+		{"ColumnMajor", []spopt.Option{spopt.FixedDim(n, n), spopt.ColumnMajor}},
+		{"Sum", []spopt.Option{spopt.FixedDim(n, n), spopt.SumDuplicates}},
+		{"Grow", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := NewCSMatrixFromEntries(ctx, entries, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewCSMatrixFromEntries() error = %v", err)
+			}
+			path := filepath.Join(t.TempDir(), "matrix.blk")
+			ch := make(chan CooEntry)
+			go func() {
+				defer close(ch)
+				for _, e := range entries {
+					ch <- e
+				}
+			}()
+			if err = BuildBlockFile(ctx, path, ch, tt.opts...); err != nil {
+				t.Fatalf("BuildBlockFile() error = %v", err)
+			}
+			m, err := OpenBlockFile(path)
+			if err != nil {
+				t.Fatalf("OpenBlockFile() error = %v", err)
+			}
+			defer func() { _ = m.Close() }()
+			if majorDim, minorDim := m.Dims(); majorDim != want.MajorDim ||
+				minorDim != want.MinorDim {
+				t.Errorf("Dims() = %d, %d, want %d, %d",
+					majorDim, minorDim, want.MajorDim, want.MinorDim)
+			}
+			if m.NNZ() != want.NNZ() {
+				t.Errorf("NNZ() = %d, want %d", m.NNZ(), want.NNZ())
+			}
+			got, err := m.ReadMatrix(ctx)
+			if err != nil {
+				t.Fatalf("ReadMatrix() error = %v", err)
+			}
+			for major := range want.Entries {
+				if !reflect.DeepEqual(got.Entries[major], want.Entries[major]) {
+					t.Errorf("major %d = %v, want %v", major,
+						got.Entries[major], want.Entries[major])
+				}
+			}
+			if want.MajorDim != want.MinorDim {
+				return
+			}
+			v1 := &Vector{Dim: want.MajorDim}
+			for i := 0; i < v1.Dim; i += 3 {
+				v1.Entries = append(v1.Entries, Entry{i, float64(i + 1)})
+			}
+			wantV, gotV := &Vector{}, &Vector{}
+			if err = wantV.MulVec(ctx, &CSRMatrix{*want}, v1); err != nil {
+				t.Fatalf("MulVec() error = %v", err)
+			}
+			err = gotV.MulVec(ctx, m, v1, WithNumWorkers(3))
+			if err != nil {
+				t.Fatalf("MulVec(block file) error = %v", err)
+			}
+			if !reflect.DeepEqual(gotV, wantV) {
+				t.Errorf("MulVec(block file) = %v, want %v", gotV, wantV)
+			}
+		})
+	}
+}
+
+func TestBlockFile_MinorSums(t *testing.T) {
+	//   ║   0    1    2
+	// ══╬══════════════
+	// 0 ║   1    0    2
+	// 1 ║   0    0    0
+	// 2 ║   3    4    0
+	entries := []CooEntry{{0, 0, 1}, {0, 2, 2}, {2, 0, 3}, {2, 1, 4}}
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "matrix.blk")
+	ch := make(chan CooEntry, len(entries))
+	for _, e := range entries {
+		ch <- e
+	}
+	close(ch)
+	if err := BuildBlockFile(ctx, path, ch); err != nil {
+		t.Fatalf("BuildBlockFile() error = %v", err)
+	}
+	m, err := OpenBlockFile(path)
+	if err != nil {
+		t.Fatalf("OpenBlockFile() error = %v", err)
+	}
+	defer func() { _ = m.Close() }()
+	sums, err := m.MinorSums(ctx)
+	if err != nil {
+		t.Fatalf("MinorSums() error = %v", err)
+	}
+	if want := []float64{4, 4, 2}; !reflect.DeepEqual(sums, want) {
+		t.Errorf("MinorSums() = %v, want %v", sums, want)
+	}
+	m.ScaleMinor([]float64{1, 0.5, 0.25})
+	got, err := m.ReadMatrix(ctx)
+	if err != nil {
+		t.Fatalf("ReadMatrix() error = %v", err)
+	}
+	want := [][]Entry{{{0, 1}, {2, 0.5}}, nil, {{0, 3}, {1, 2}}}
+	if !reflect.DeepEqual(got.Entries, want) {
+		t.Errorf("ReadMatrix() = %v, want %v", got.Entries, want)
+	}
+}
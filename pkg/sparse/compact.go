@@ -0,0 +1,388 @@
+package sparse
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// Operator is a square sparse matrix that can multiply a dense vector.
+//
+// *Matrix, *CompactMatrix, and *BlockFile are Operators.
+type Operator interface {
+	// Dim asserts the operator is square and returns the dimension.
+	Dim() (int, error)
+
+	// NNZ counts nonzero entries.
+	NNZ() int
+
+	// MulRows computes rows [begin..end) of the product
+	// of the operator and the dense vector x into y[begin:end].
+	// Calls for disjoint row ranges may run concurrently.
+	MulRows(y, x []float64, begin, end int) error
+}
+
+// MulRows computes rows [begin..end) of m multiplied by x into y[begin:end].
+//
+// For a CSCMatrix, "rows" are its columns.
+func (m *CSMatrix) MulRows(y, x []float64, begin, end int) error {
+	for row := begin; row < end; row++ {
+		var summer KBNSummer
+		for _, e := range m.Entries[row] {
+			summer.Add(e.Value * x[e.Index])
+		}
+		y[row] = summer.Sum()
+	}
+	return nil
+}
+
+// CompactMatrix is a compressed sparse row matrix in compact storage.
+//
+// It stores column indices as uint32 and values as float32,
+// taking 8 bytes per nonzero entry instead of Entry's 16 bytes,
+// in exchange for limited dimensions and value precision.
+// Arithmetic on the values is still done in float64.
+//
+// Select it with the spopt.Compact option where supported.
+type CompactMatrix struct {
+	Rows, Cols int
+
+	// Offsets delimit the rows: Row i spans [Offsets[i]..Offsets[i+1])
+	// in Indices and Values.  len(Offsets) == Rows+1.
+	Offsets []int
+
+	// Indices contain the column indices, sorted within each row.
+	Indices []uint32
+
+	// Values contain the entry values.
+	Values []float32
+}
+
+// MaxCompactDim is the largest dimension a CompactMatrix can have.
+const MaxCompactDim uint64 = math.MaxUint32 + 1
+
+// NewCompactMatrix creates a new compact matrix
+// with the same contents as the given CSR matrix.
+func NewCompactMatrix(m *CSRMatrix) (*CompactMatrix, error) {
+	rows, cols := m.Dims()
+	if uint64(rows) > MaxCompactDim || uint64(cols) > MaxCompactDim {
+		return nil, fmt.Errorf("matrix too big for compact storage (%dx%d)",
+			rows, cols)
+	}
+	nnz := m.NNZ()
+	cm := &CompactMatrix{
+		Rows:    rows,
+		Cols:    cols,
+		Offsets: make([]int, rows+1),
+		Indices: make([]uint32, 0, nnz),
+		Values:  make([]float32, 0, nnz),
+	}
+	for row, span := range m.Entries {
+		for _, e := range span {
+			cm.Indices = append(cm.Indices, uint32(e.Index))
+			cm.Values = append(cm.Values, float32(e.Value))
+		}
+		cm.Offsets[row+1] = len(cm.Indices)
+	}
+	return cm, nil
+}
+
+// compactEntry is an Entry in compact storage.
+type compactEntry struct {
+	Index uint32
+	Value float32
+}
+
+// NewCompactMatrixFromEntryCh creates a new compact matrix
+// with the entries taken from a channel.
+//
+// It accepts the same options as NewCSRMatrixFromEntryCh,
+// but collects entries directly in compact storage,
+// never holding them in full storage.
+// Duplicate entries are combined in float32.
+func NewCompactMatrixFromEntryCh(
+	ctx context.Context, ch <-chan CooEntry, opts ...spopt.Option,
+) (*CompactMatrix, error) {
+	o := spopt.New(opts...)
+	rows, cols := o.Row.Dim, o.Column.Dim
+	if uint64(rows) > MaxCompactDim || uint64(cols) > MaxCompactDim {
+		return nil, fmt.Errorf("matrix too big for compact storage (%dx%d)",
+			rows, cols)
+	}
+	spans := make([][]compactEntry, rows)
+EntryLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case e, ok := <-ch:
+			switch {
+			case !ok:
+				break EntryLoop
+			case e.Value == 0 && !o.Value.IncludeZero:
+				continue EntryLoop
+			case e.Value < 0 && !o.Value.AllowNegative:
+				return nil, NegativeValueError{e.Value}
+			}
+			if e.Row >= rows {
+				if !o.Row.Grow || uint64(e.Row) >= MaxCompactDim {
+					return nil, util.IndexOutOfBoundsError{
+						Index: e.Row, Bound: rows,
+					}
+				}
+				rows = e.Row + 1
+				spans = slices.Grow(spans, rows-len(spans))[:rows]
+			}
+			if e.Column >= cols {
+				if !o.Column.Grow || uint64(e.Column) >= MaxCompactDim {
+					return nil, util.IndexOutOfBoundsError{
+						Index: e.Column, Bound: cols,
+					}
+				}
+				cols = e.Column + 1
+			}
+			spans[e.Row] = append(spans[e.Row], compactEntry{
+				Index: uint32(e.Column),
+				Value: float32(e.Value),
+			})
+		}
+	}
+	nnz := 0
+	for row, span := range spans {
+		slices.SortStableFunc(span, func(e1, e2 compactEntry) int {
+			return cmp.Compare(e1.Index, e2.Index)
+		})
+		span, err := dedupCompactSpan(span, o.Value)
+		if err != nil {
+			var dup DuplicateIndexError
+			if errors.As(err, &dup) {
+				err = DuplicateEntryError{Row: row, Column: dup.Index}
+			}
+			return nil, err
+		}
+		spans[row] = span
+		nnz += len(span)
+	}
+	cm := &CompactMatrix{
+		Rows:    rows,
+		Cols:    cols,
+		Offsets: make([]int, rows+1),
+		Indices: make([]uint32, 0, nnz),
+		Values:  make([]float32, 0, nnz),
+	}
+	for row, span := range spans {
+		for _, e := range span {
+			cm.Indices = append(cm.Indices, e.Index)
+			cm.Values = append(cm.Values, e.Value)
+		}
+		cm.Offsets[row+1] = len(cm.Indices)
+		spans[row] = nil // release as we go
+	}
+	return cm, nil
+}
+
+// dedupCompactSpan is dedupSpan for compact entries.
+func dedupCompactSpan(
+	span []compactEntry, o *spopt.Value,
+) ([]compactEntry, error) {
+	if len(span) < 2 {
+		return span, nil
+	}
+	n := 0
+	for i, e := range span {
+		if i > 0 && span[n-1].Index == e.Index {
+			v, ok := o.Duplicates.Combine(
+				float64(span[n-1].Value), float64(e.Value))
+			if !ok {
+				return nil, DuplicateIndexError{Index: int(e.Index)}
+			}
+			span[n-1].Value = float32(v)
+			continue
+		}
+		if n > 0 && span[n-1].Value == 0 && !o.IncludeZero {
+			n-- // previous entry combined into zero; overwrite it
+		}
+		span[n] = e
+		n++
+	}
+	if n > 0 && span[n-1].Value == 0 && !o.IncludeZero {
+		n--
+	}
+	return span[:n], nil
+}
+
+// NewCompactTranspose creates a new compact matrix
+// with the transpose of the given matrix.
+//
+// It transposes entries straight into compact storage,
+// never holding the transpose in full storage.
+// m is left intact.
+func NewCompactTranspose(
+	ctx context.Context, m *CSRMatrix,
+) (*CompactMatrix, error) {
+	rows, cols := m.Dims()
+	if uint64(rows) > MaxCompactDim || uint64(cols) > MaxCompactDim {
+		return nil, fmt.Errorf("matrix too big for compact storage (%dx%d)",
+			rows, cols)
+	}
+	nnz := m.NNZ()
+	mt := &CompactMatrix{
+		Rows:    cols,
+		Cols:    rows,
+		Offsets: make([]int, cols+1),
+		Indices: make([]uint32, nnz),
+		Values:  make([]float32, nnz),
+	}
+	for _, span := range m.Entries {
+		for _, e := range span {
+			mt.Offsets[e.Index+1]++
+		}
+	}
+	for col := 0; col < cols; col++ {
+		mt.Offsets[col+1] += mt.Offsets[col]
+	}
+	next := append(mt.Offsets[:0:0], mt.Offsets[:cols]...)
+	for row, span := range m.Entries {
+		if row%minChunkSize == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		for _, e := range span {
+			mt.Indices[next[e.Index]] = uint32(row)
+			mt.Values[next[e.Index]] = float32(e.Value)
+			next[e.Index]++
+		}
+	}
+	return mt, nil
+}
+
+// NewOperator returns the given matrix as an Operator,
+// converted into a CompactMatrix if the spopt.Compact option is given.
+//
+// Upon conversion, m is reset to save memory;
+// caller must not use m anymore regardless.
+// Conversion briefly holds both m and its compact copy;
+// to save peak memory, build compact storage directly,
+// e.g. with NewCompactMatrixFromEntryCh or NewTransposedOperator.
+func NewOperator(m *CSRMatrix, opts ...spopt.Option) (Operator, error) {
+	o := spopt.New(opts...)
+	if !o.Compact {
+		return m, nil
+	}
+	cm, err := NewCompactMatrix(m)
+	if err != nil {
+		return nil, err
+	}
+	m.Reset()
+	return cm, nil
+}
+
+// NewTransposedOperator returns the transpose of the given matrix
+// as an Operator, in compact storage if the spopt.Compact option is given.
+//
+// The compact transpose is built directly (see NewCompactTranspose),
+// so it takes about half the memory of the full-storage transpose.
+// m is left intact.
+func NewTransposedOperator(
+	ctx context.Context, m *CSRMatrix, opts ...spopt.Option,
+) (Operator, error) {
+	o := spopt.New(opts...)
+	if o.Compact {
+		mt, err := NewCompactTranspose(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return mt, nil
+	}
+	mt, err := m.Transpose(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *CompactMatrix) Dims() (rows, cols int) { return m.Rows, m.Cols }
+
+// Dim asserts the receiver is a square matrix and returns the dimension.
+func (m *CompactMatrix) Dim() (int, error) {
+	if m.Rows != m.Cols {
+		return 0, ErrDimensionMismatch
+	}
+	return m.Rows, nil
+}
+
+// NNZ counts nonzero entries.
+func (m *CompactMatrix) NNZ() int { return len(m.Indices) }
+
+// RowVector returns a copy of the given row as a sparse vector.
+func (m *CompactMatrix) RowVector(index int) *Vector {
+	begin, end := m.Offsets[index], m.Offsets[index+1]
+	v := &Vector{Dim: m.Cols}
+	if begin != end {
+		v.Entries = make([]Entry, 0, end-begin)
+		for k := begin; k < end; k++ {
+			v.Entries = append(v.Entries, Entry{
+				Index: int(m.Indices[k]),
+				Value: float64(m.Values[k]),
+			})
+		}
+	}
+	return v
+}
+
+// MulRows computes rows [begin..end) of m multiplied by x into y[begin:end].
+func (m *CompactMatrix) MulRows(y, x []float64, begin, end int) error {
+	for row := begin; row < end; row++ {
+		var summer KBNSummer
+		for k := m.Offsets[row]; k < m.Offsets[row+1]; k++ {
+			summer.Add(float64(m.Values[k]) * x[m.Indices[k]])
+		}
+		y[row] = summer.Sum()
+	}
+	return nil
+}
+
+// Transpose transposes the matrix.
+func (m *CompactMatrix) Transpose(ctx context.Context) (*CompactMatrix, error) {
+	nnz := m.NNZ()
+	mt := &CompactMatrix{
+		Rows:    m.Cols,
+		Cols:    m.Rows,
+		Offsets: make([]int, m.Cols+1),
+		Indices: make([]uint32, nnz),
+		Values:  make([]float32, nnz),
+	}
+	for _, col := range m.Indices {
+		mt.Offsets[col+1]++
+	}
+	for col := 0; col < m.Cols; col++ {
+		mt.Offsets[col+1] += mt.Offsets[col]
+	}
+	next := append(mt.Offsets[:0:0], mt.Offsets[:m.Cols]...)
+	for row := 0; row < m.Rows; row++ {
+		if row%minChunkSize == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		for k := m.Offsets[row]; k < m.Offsets[row+1]; k++ {
+			col := m.Indices[k]
+			mt.Indices[next[col]] = uint32(row)
+			mt.Values[next[col]] = m.Values[k]
+			next[col]++
+		}
+	}
+	return mt, nil
+}
@@ -0,0 +1,162 @@
+package sparse
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+func TestCompactMatrix(t *testing.T) {
+	//   ║   0    1    2    3
+	// ══╬═══════════════════
+	// 0 ║ 100  200  300    0
+	// 1 ║   0  400    0  500
+	// 2 ║   0    0    0    0
+	// 3 ║ 600  700  800  900
+	// 4 ║   0    0 1000    0
+	m := &CSRMatrix{CSMatrix: CSMatrix{
+		MajorDim: 5,
+		MinorDim: 4,
+		Entries: [][]Entry{
+			{{0, 100}, {1, 200}, {2, 300}},
+			{{1, 400}, {3, 500}},
+			nil,
+			{{0, 600}, {1, 700}, {2, 800}, {3, 900}},
+			{{2, 1000}},
+		},
+	}}
+	want := &CompactMatrix{
+		Rows:    5,
+		Cols:    4,
+		Offsets: []int{0, 3, 5, 5, 9, 10},
+		Indices: []uint32{0, 1, 2, 1, 3, 0, 1, 2, 3, 2},
+		Values: []float32{
+			100, 200, 300, 400, 500, 600, 700, 800, 900, 1000,
+		},
+	}
+	cm, err := NewCompactMatrix(m)
+	if err != nil {
+		t.Fatalf("NewCompactMatrix() error = %v", err)
+	}
+	if !reflect.DeepEqual(cm, want) {
+		t.Errorf("NewCompactMatrix() = %#v, want %#v", cm, want)
+	}
+	for row, span := range m.Entries {
+		got := cm.RowVector(row)
+		wantRow := &Vector{Dim: 4, Entries: span}
+		if !reflect.DeepEqual(got, wantRow) {
+			t.Errorf("RowVector(%d) = %#v, want %#v", row, got, wantRow)
+		}
+	}
+	mt, err := m.Transpose(context.Background())
+	if err != nil {
+		t.Fatalf("Transpose() error = %v", err)
+	}
+	wantT, _ := NewCompactMatrix(mt)
+	cmt, err := cm.Transpose(context.Background())
+	if err != nil {
+		t.Fatalf("CompactMatrix.Transpose() error = %v", err)
+	}
+	if !reflect.DeepEqual(cmt, wantT) {
+		t.Errorf("CompactMatrix.Transpose() = %#v, want %#v", cmt, wantT)
+	}
+}
+
+func TestNewOperator(t *testing.T) {
+	entries := []CooEntry{{0, 1, 0.5}, {1, 0, 0.25}, {1, 1, 0.75}}
+	newM := func() *CSRMatrix {
+		m, _ := NewCSRMatrixFromEntries(context.Background(), entries)
+		return m
+	}
+	op, err := NewOperator(newM())
+	if err != nil {
+		t.Fatalf("NewOperator() error = %v", err)
+	}
+	if _, ok := op.(*CSRMatrix); !ok {
+		t.Errorf("NewOperator() = %T, want *CSRMatrix", op)
+	}
+	m := newM()
+	op, err = NewOperator(m, spopt.Compact)
+	if err != nil {
+		t.Fatalf("NewOperator(Compact) error = %v", err)
+	}
+	if _, ok := op.(*CompactMatrix); !ok {
+		t.Errorf("NewOperator(Compact) = %T, want *CompactMatrix", op)
+	}
+	// Values above are exact in float32, so products should match exactly.
+	v1 := &Vector{Dim: 2, Entries: []Entry{{0, 2}, {1, 4}}}
+	want, got := &Vector{}, &Vector{}
+	if err = want.MulVec(context.Background(), newM(), v1); err != nil {
+		t.Fatalf("MulVec() error = %v", err)
+	}
+	if err = got.MulVec(context.Background(), op, v1); err != nil {
+		t.Fatalf("MulVec(compact) error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MulVec(compact) = %#v, want %#v", got, want)
+	}
+}
+
+func TestNewCompactMatrixFromEntryCh(t *testing.T) {
+	entries := []CooEntry{
+		{2, 1, 3}, {0, 2, 1}, {0, 0, 2}, {2, 1, 4}, {1, 1, 0},
+	}
+	newCompact := func(opts ...spopt.Option) (*CompactMatrix, error) {
+		ch := make(chan CooEntry)
+		go func() {
+			defer close(ch)
+			for _, e := range entries {
+				ch <- e
+			}
+		}()
+		return NewCompactMatrixFromEntryCh(context.Background(), ch, opts...)
+	}
+	cm, err := newCompact(spopt.SumDuplicates)
+	if err != nil {
+		t.Fatalf("NewCompactMatrixFromEntryCh() error = %v", err)
+	}
+	m, _ := NewCSRMatrixFromEntries(context.Background(), entries,
+		spopt.SumDuplicates)
+	want, _ := NewCompactMatrix(m)
+	if !reflect.DeepEqual(cm, want) {
+		t.Errorf("NewCompactMatrixFromEntryCh() = %#v, want %#v", cm, want)
+	}
+	_, err = newCompact(spopt.RejectDuplicates)
+	wantErr := DuplicateEntryError{Row: 2, Column: 1}
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Errorf("NewCompactMatrixFromEntryCh(RejectDuplicates) error = %v, "+
+			"want %v", err, wantErr)
+	}
+	_, err = newCompact(spopt.FixedDim(2, 3))
+	if err == nil {
+		t.Errorf("NewCompactMatrixFromEntryCh(FixedDim) error = nil")
+	}
+}
+
+func TestNewTransposedOperator(t *testing.T) {
+	ctx := context.Background()
+	m, _ := NewCSRMatrixFromEntries(ctx, []CooEntry{
+		{0, 1, 0.5}, {0, 2, 0.25}, {1, 0, 0.75}, {2, 1, 1},
+	}, spopt.FixedDim(3, 3))
+	mt, err := m.Transpose(ctx)
+	if err != nil {
+		t.Fatalf("Transpose() error = %v", err)
+	}
+	op, err := NewTransposedOperator(ctx, m)
+	if err != nil {
+		t.Fatalf("NewTransposedOperator() error = %v", err)
+	}
+	if !reflect.DeepEqual(op, mt) {
+		t.Errorf("NewTransposedOperator() = %#v, want %#v", op, mt)
+	}
+	want, _ := NewCompactMatrix(mt)
+	op, err = NewTransposedOperator(ctx, m, spopt.Compact)
+	if err != nil {
+		t.Fatalf("NewTransposedOperator(Compact) error = %v", err)
+	}
+	if !reflect.DeepEqual(op, want) {
+		t.Errorf("NewTransposedOperator(Compact) = %#v, want %#v", op, want)
+	}
+}
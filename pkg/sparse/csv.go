@@ -0,0 +1,284 @@
+package sparse
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// csvFieldPositioner is implemented by CSV readers that can locate fields,
+// such as csv.Reader.
+type csvFieldPositioner interface {
+	FieldPos(field int) (line, column int)
+}
+
+// NewCSVReader returns a CSV reader from r
+// that uses the delimiter and comment character set in the options.
+//
+// Readers returned by NewCSVReader also let CSV parsing functions
+// report problems with their line and column.
+func NewCSVReader(r io.Reader, opts ...spopt.Option) *csv.Reader {
+	o := spopt.New(opts...)
+	reader := csv.NewReader(r)
+	reader.Comma = o.Text.Delimiter
+	reader.Comment = o.Text.Comment
+	return reader
+}
+
+// csvEntryParser parses sparse entries from CSV records:
+// one peer identifier field per axis, followed by the value field.
+type csvEntryParser struct {
+	r      util.CSVReader
+	axes   []*spopt.Axis
+	value  *spopt.Value
+	names  []string
+	xt     *util.CSVFieldExtractor
+	record int // number of records read so far, including the header
+
+	// timeField is the index (into names) of the entry time field,
+	// or -1 if the header has none; see spopt.TimeNamed.
+	timeField int
+
+	// time is the entry time of the last record read, 0 if none.
+	time float64
+
+	// strict enables checks that only the validator performs:
+	// non-finite values and out-of-range indices.
+	strict bool
+}
+
+// newCSVEntryParser reads the header, if any, and returns a parser.
+// Header problems are returned as a CSVIssue.
+func newCSVEntryParser(
+	r util.CSVReader, o *spopt.Set, axes []*spopt.Axis, strict bool,
+) (*csvEntryParser, error) {
+	p := &csvEntryParser{r: r, axes: axes, value: o.Value, strict: strict}
+	var positions []int
+	for _, axis := range axes {
+		p.names = append(p.names, axis.Name)
+		positions = append(positions, axis.Position)
+	}
+	p.names = append(p.names, o.Value.Name)
+	positions = append(positions, o.Value.Position)
+	var header []string
+	if o.Text.Header {
+		var err error
+		header, err = r.Read()
+		p.record++
+		if err != nil {
+			if err == io.EOF {
+				err = errors.New("missing CSV header")
+			}
+			return nil, CSVIssue{Line: 1, Err: err}
+		}
+	}
+	p.xt = &util.CSVFieldExtractor{Indices: make([]int, len(p.names))}
+	for i, name := range p.names {
+		switch {
+		case positions[i] >= 0:
+			p.xt.Indices[i] = positions[i]
+		case header == nil:
+			p.xt.Indices[i] = i
+		default:
+			p.xt.Indices[i] = slices.Index(header, name)
+			if p.xt.Indices[i] == -1 {
+				return nil, CSVIssue{
+					Line: 1,
+					Err: fmt.Errorf("field %#v not in CSV header %#v",
+						name, header),
+				}
+			}
+		}
+	}
+	p.timeField = -1
+	if name := o.Value.TimeName; name != "" && header != nil {
+		if index := slices.Index(header, name); index != -1 {
+			p.timeField = len(p.names)
+			p.names = append(p.names, name)
+			p.xt.Indices = append(p.xt.Indices, index)
+		}
+	}
+	return p, nil
+}
+
+// pos returns the location of the given field of the last record read.
+func (p *csvEntryParser) pos(field int) (line, column int) {
+	if fp, ok := p.r.(csvFieldPositioner); ok {
+		return fp.FieldPos(field)
+	}
+	// Assume one record per line.
+	return p.record, field + 1
+}
+
+// issue returns an issue with the given field (index into p.names).
+func (p *csvEntryParser) issue(field int, err error) CSVIssue {
+	line, column := p.pos(p.xt.Indices[field])
+	return CSVIssue{Line: line, Column: column, Field: p.names[field], Err: err}
+}
+
+// next reads and parses the next record.
+//
+// It returns the peer indices (one per axis) and the value,
+// along with problems found in the record, if any.
+// The entry time, if any, is left in p.time.
+// It returns io.EOF at the end, or another error if the reader fails.
+func (p *csvEntryParser) next() (
+	indices []int, value float64, issues []CSVIssue, err error,
+) {
+	fields, err := p.r.Read()
+	p.record++
+	var parseErr *csv.ParseError
+	switch {
+	case err == nil:
+	case errors.As(err, &parseErr):
+		return nil, 0, []CSVIssue{{
+			Line: parseErr.Line, Column: parseErr.Column, Err: parseErr.Err,
+		}}, nil
+	default:
+		return nil, 0, nil, err
+	}
+	extracted, err := p.xt.ExtractAll(fields)
+	if err != nil {
+		line, _ := p.pos(0)
+		return nil, 0, []CSVIssue{{Line: line, Err: err}}, nil
+	}
+	indices = make([]int, len(p.axes))
+	for i, axis := range p.axes {
+		id := extracted[i]
+		index, err := peer.ParseId(id, axis.PeerMap, axis.Alloc)
+		switch {
+		case err != nil:
+			err = fmt.Errorf("invalid %s id %#v: %w", axis.Name, id, err)
+		case p.strict && !axis.Grow && index >= axis.Dim:
+			err = util.IndexOutOfBoundsError{Index: index, Bound: axis.Dim}
+		}
+		if err != nil {
+			issues = append(issues, p.issue(i, err))
+		}
+		indices[i] = index
+	}
+	literal := extracted[len(p.axes)]
+	value, err = strconv.ParseFloat(literal, 64)
+	switch {
+	case err != nil:
+	case p.strict && (math.IsNaN(value) || math.IsInf(value, 0)):
+		err = NonFiniteValueError{value}
+	case value < 0 && !p.value.AllowNegative:
+		err = NegativeValueError{value}
+	}
+	if err != nil {
+		err = fmt.Errorf("invalid value %#v: %w", literal, err)
+		issues = append(issues, p.issue(len(p.axes), err))
+	}
+	p.time = 0
+	if p.timeField != -1 {
+		// Empty time field means no time.
+		if literal = extracted[p.timeField]; literal != "" {
+			if p.time, err = parseEntryTime(literal); err != nil {
+				err = fmt.Errorf("invalid time %#v: %w", literal, err)
+				issues = append(issues, p.issue(p.timeField, err))
+			}
+		}
+	}
+	return indices, value, issues, nil
+}
+
+// ValidateMatrixCSV checks matrix entries in CSV
+// the same way NewCSMatrixFromCSV reads them with the same options,
+// and returns every problem found, in the order found.
+//
+// Unlike NewCSMatrixFromCSV, which stops at the first problem,
+// ValidateMatrixCSV goes through the entire CSV.
+// It also reports NaN/infinite values,
+// out-of-range indices (under FixedDim and the like),
+// and duplicate entries (under RejectDuplicates).
+//
+// A non-nil error means the reader failed,
+// e.g. an I/O error, and the validation is incomplete.
+func ValidateMatrixCSV(
+	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+) ([]CSVIssue, error) {
+	o := spopt.New(opts...)
+	return validateCSV(ctx, r, o, []*spopt.Axis{o.Row, o.Column})
+}
+
+// ValidateVectorCSV checks vector entries in CSV
+// the same way NewVectorFromCSV reads them with the same options,
+// and returns every problem found, in the order found.
+//
+// See ValidateMatrixCSV for details.
+func ValidateVectorCSV(
+	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+) ([]CSVIssue, error) {
+	o := spopt.New(opts...)
+	return validateCSV(ctx, r, o, []*spopt.Axis{o.Row})
+}
+
+func validateCSV(
+	ctx context.Context, r util.CSVReader, o *spopt.Set, axes []*spopt.Axis,
+) (issues []CSVIssue, err error) {
+	p, err := newCSVEntryParser(r, o, axes, true)
+	if err != nil {
+		var issue CSVIssue
+		if errors.As(err, &issue) {
+			return []CSVIssue{issue}, nil
+		}
+		return nil, err
+	}
+	var seen seenEntries
+	if o.Value.Duplicates == spopt.DuplicateError {
+		seen = make(seenEntries)
+	}
+	for {
+		if err = ctx.Err(); err != nil {
+			return issues, err
+		}
+		indices, _, recordIssues, err := p.next()
+		switch {
+		case err == io.EOF:
+			return issues, nil
+		case err != nil:
+			return issues, err
+		}
+		issues = append(issues, recordIssues...)
+		if seen == nil || len(recordIssues) != 0 {
+			continue
+		}
+		line, _ := p.pos(0)
+		if err = seen.check(indices, line); err != nil {
+			issues = append(issues, CSVIssue{Line: line, Err: err})
+		}
+	}
+}
+
+// seenEntries maps the indices of the entries seen so far
+// to their line numbers, for duplicate detection.
+type seenEntries map[[2]int]int
+
+// check records the entry with the given indices seen at the given line,
+// or returns a duplicate error if it was seen before.
+func (seen seenEntries) check(indices []int, line int) error {
+	var key [2]int
+	copy(key[:], indices)
+	first, ok := seen[key]
+	if !ok {
+		seen[key] = line
+		return nil
+	}
+	var dupErr error
+	if len(indices) == 1 {
+		dupErr = DuplicateIndexError{Index: key[0]}
+	} else {
+		dupErr = DuplicateEntryError{Row: key[0], Column: key[1]}
+	}
+	return fmt.Errorf("%w, first at line %d", dupErr, first)
+}
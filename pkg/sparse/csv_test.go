@@ -0,0 +1,282 @@
+package sparse
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// issueLocation is the comparable part of a CSVIssue.
+type issueLocation struct {
+	Line, Column int
+	Field        string
+}
+
+func TestValidateMatrixCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  []spopt.Option
+		want  []issueLocation
+		errs  []error
+	}{
+		{
+			name:  "Clean",
+			input: "i,j,v\n0,1,1\n1,0,2\n",
+		},
+		{
+			name:  "MissingHeaderField",
+			input: "i,v\n0,1\n",
+			want:  []issueLocation{{1, 0, ""}},
+			errs:  []error{nil},
+		},
+		{
+			name:  "Empty",
+			input: "",
+			want:  []issueLocation{{1, 0, ""}},
+			errs:  []error{nil},
+		},
+		{
+			name: "Values",
+			input: "i,j,v\n" +
+				"0,1,x\n" +
+				"0,2,-1\n" +
+				"0,3,NaN\n" +
+				"0,4,+Inf\n" +
+				"0,5,2\n",
+			want: []issueLocation{
+				{2, 5, "v"}, {3, 5, "v"}, {4, 5, "v"}, {5, 5, "v"},
+			},
+			errs: []error{
+				strconvErr, NegativeValueError{-1}, nil, nil,
+			},
+		},
+		{
+			name:  "AllowNegative",
+			input: "i,j,v\n0,2,-1\n",
+			opts:  []spopt.Option{spopt.AllowNegative},
+		},
+		{
+			name: "Indices",
+			input: "i,j,v\n" +
+				"a,1,1\n" +
+				"1,-2,1\n" +
+				"x,y,1\n",
+			want: []issueLocation{
+				{2, 1, "i"}, {3, 3, "j"}, {4, 1, "i"}, {4, 3, "j"},
+			},
+			errs: []error{nil, peer.NegativeIndex{Value: -2}, nil, nil},
+		},
+		{
+			name:  "OutOfRange",
+			input: "i,j,v\n0,3,1\n3,0,1\n",
+			opts:  []spopt.Option{spopt.FixedDim(3, 4)},
+			want:  []issueLocation{{3, 1, "i"}},
+			errs:  []error{util.IndexOutOfBoundsError{Index: 3, Bound: 3}},
+		},
+		{
+			name:  "UnknownPeer",
+			input: "i,j,v\nalice,bob,1\nalice,carol,1\n",
+			opts: []spopt.Option{
+				spopt.IndicesIn(peer.MapWithIds("alice", "bob")),
+			},
+			want: []issueLocation{{3, 7, "j"}},
+			errs: []error{peer.NoSuchId{Value: "carol"}},
+		},
+		{
+			name:  "Duplicates",
+			input: "i,j,v\n0,1,1\n1,0,1\n0,1,2\n0,1,3\n",
+			opts:  []spopt.Option{spopt.RejectDuplicates},
+			want:  []issueLocation{{4, 0, ""}, {5, 0, ""}},
+			errs: []error{
+				DuplicateEntryError{Row: 0, Column: 1},
+				DuplicateEntryError{Row: 0, Column: 1},
+			},
+		},
+		{
+			name:  "DuplicatesAllowed",
+			input: "i,j,v\n0,1,1\n0,1,2\n",
+		},
+		{
+			name:  "FieldCount",
+			input: "i,j,v\n0,1,1\n0,1\n1,2,x\n",
+			want:  []issueLocation{{3, 1, ""}, {4, 5, "v"}},
+			errs:  []error{csv.ErrFieldCount, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := ValidateMatrixCSV(context.Background(),
+				csv.NewReader(strings.NewReader(tt.input)), tt.opts...)
+			if err != nil {
+				t.Fatalf("ValidateMatrixCSV() error = %v", err)
+			}
+			if len(issues) != len(tt.want) {
+				t.Fatalf("ValidateMatrixCSV() issues = %v, want %d issues",
+					issues, len(tt.want))
+			}
+			for i, issue := range issues {
+				got := issueLocation{issue.Line, issue.Column, issue.Field}
+				if got != tt.want[i] {
+					t.Errorf("issue %d (%v) at %+v, want %+v",
+						i, issue, got, tt.want[i])
+				}
+				if !errorMatches(issue, tt.errs[i]) {
+					t.Errorf("issue %d = %v, want %v", i, issue, tt.errs[i])
+				}
+			}
+		})
+	}
+}
+
+// strconvErr stands for any strconv.NumError.
+var strconvErr = errors.New("strconv error")
+
+func errorMatches(err, target error) bool {
+	switch target {
+	case nil:
+		return true
+	case strconvErr:
+		return strings.Contains(err.Error(), "strconv.ParseFloat")
+	default:
+		return errors.Is(err, target)
+	}
+}
+
+func TestValidateVectorCSV(t *testing.T) {
+	input := "i,v\n0,1\n1,0.5\n0,2\nfoo,1\n2,-Inf\n"
+	issues, err := ValidateVectorCSV(context.Background(),
+		csv.NewReader(strings.NewReader(input)), spopt.RejectDuplicates)
+	if err != nil {
+		t.Fatalf("ValidateVectorCSV() error = %v", err)
+	}
+	want := []issueLocation{{4, 0, ""}, {5, 1, "i"}, {6, 3, "v"}}
+	if len(issues) != len(want) {
+		t.Fatalf("ValidateVectorCSV() issues = %v, want %d issues",
+			issues, len(want))
+	}
+	for i, issue := range issues {
+		got := issueLocation{issue.Line, issue.Column, issue.Field}
+		if got != want[i] {
+			t.Errorf("issue %d (%v) at %+v, want %+v", i, issue, got, want[i])
+		}
+	}
+	if !errors.Is(issues[0], DuplicateIndexError{Index: 0}) {
+		t.Errorf("issue 0 = %v, want duplicate index 0", issues[0])
+	}
+}
+
+func TestNewCSRMatrixFromCSV_IssueLocation(t *testing.T) {
+	input := "i,j,v\n0,1,1\n1,0,-2\n"
+	_, err := NewCSRMatrixFromCSV(context.Background(),
+		csv.NewReader(strings.NewReader(input)))
+	var issue CSVIssue
+	if !errors.As(err, &issue) {
+		t.Fatalf("NewCSRMatrixFromCSV() error = %v, want CSVIssue", err)
+	}
+	if issue.Line != 3 || issue.Field != "v" {
+		t.Errorf("NewCSRMatrixFromCSV() error at line %d field %#v, "+
+			"want line 3 field \"v\"", issue.Line, issue.Field)
+	}
+	if !errors.Is(err, NegativeValueError{-2}) {
+		t.Errorf("NewCSRMatrixFromCSV() error = %v, want %v",
+			err, NegativeValueError{-2})
+	}
+}
+
+func TestNewCSRMatrixFromCSV_TextOptions(t *testing.T) {
+	want := &CSRMatrix{CSMatrix: CSMatrix{
+		MajorDim: 3,
+		MinorDim: 3,
+		Entries: [][]Entry{
+			{{Index: 1, Value: 0.5}},
+			nil,
+			{{Index: 0, Value: 2}},
+		},
+	}}
+	tests := []struct {
+		name  string
+		input string
+		opts  []spopt.Option
+	}{
+		{
+			name:  "CSV",
+			input: "i,j,v\n0,1,0.5\n2,0,2\n",
+		},
+		{
+			name:  "TSV",
+			input: "i\tj\tv\n0\t1\t0.5\n2\t0\t2\n",
+			opts:  []spopt.Option{spopt.TSV},
+		},
+		{
+			name:  "Semicolon",
+			input: "i;j;v\n0;1;0.5\n2;0;2\n",
+			opts:  []spopt.Option{spopt.Delimiter(';')},
+		},
+		{
+			name:  "Comments",
+			input: "# generated\ni,j,v\n0,1,0.5\n# skipped\n2,0,2\n",
+			opts:  []spopt.Option{spopt.CommentSetTo('#')},
+		},
+		{
+			name:  "NoHeader",
+			input: "0,1,0.5\n2,0,2\n",
+			opts:  []spopt.Option{spopt.NoHeader},
+		},
+		{
+			name:  "NoHeaderPositional",
+			input: "x,0.5,1,0\ny,2,0,2\n",
+			opts: []spopt.Option{
+				spopt.NoHeader,
+				spopt.RowIndexAt(3), spopt.ColumnIndexAt(2), spopt.ValueAt(1),
+			},
+		},
+		{
+			name:  "ExtraColumnsByName",
+			input: "note,from,v,to,when\nx,0,0.5,1,today\ny,2,2,0,today\n",
+			opts: []spopt.Option{
+				spopt.RowIndexNamed("from"), spopt.ColumnIndexNamed("to"),
+			},
+		},
+		{
+			name:  "PositionOverridesName",
+			input: "a,b,c\n0,1,0.5\n2,0,2\n",
+			opts: []spopt.Option{
+				spopt.RowIndexAt(0), spopt.ColumnIndexAt(1), spopt.ValueAt(2),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewCSVReader(strings.NewReader(tt.input), tt.opts...)
+			got, err := NewCSRMatrixFromCSV(context.Background(), r,
+				append(tt.opts, spopt.FixedDim(3, 3))...)
+			if err != nil {
+				t.Fatalf("NewCSRMatrixFromCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("NewCSRMatrixFromCSV() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewVectorFromCSV_NoHeader(t *testing.T) {
+	r := NewCSVReader(strings.NewReader("1\t0.5\n3\t2\n"), spopt.TSV)
+	got, err := NewVectorFromCSV(context.Background(), r,
+		spopt.NoHeader, spopt.FixedRows(4))
+	if err != nil {
+		t.Fatalf("NewVectorFromCSV() error = %v", err)
+	}
+	want := &Vector{Dim: 4, Entries: []Entry{{1, 0.5}, {3, 2}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewVectorFromCSV() got = %v, want %v", got, want)
+	}
+}
@@ -2,12 +2,9 @@ package sparse
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"sort"
-	"strconv"
 
-	"k3l.io/go-eigentrust/pkg/peer"
 	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
 	"k3l.io/go-eigentrust/pkg/util"
 )
@@ -28,41 +25,26 @@ func SendEntriesFromCSV(
 	opts ...spopt.Option,
 ) error {
 	o := spopt.New(opts...)
-	header, err := r.Read()
+	p, err := newCSVEntryParser(r, o, []*spopt.Axis{o.Row}, false)
 	if err != nil {
 		return err
 	}
-	xt, err := util.NewCSVFieldExtractor(header, o.Row.Name, o.Value.Name)
-	if err != nil {
-		return err
-	}
-	for fields, err := r.Read(); err != io.EOF; fields, err = r.Read() {
-		if err != nil {
-			return err
-		}
-		iv, err := xt.ExtractAll(fields)
-		if err != nil {
+	for {
+		indices, value, issues, err := p.next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
 			return err
+		case len(issues) != 0:
+			return issues[0]
 		}
-		index, err := peer.ParseId(iv[0], o.Row.PeerMap, o.Row.Alloc)
-		if err != nil {
-			return fmt.Errorf("invalid peer id %#v: %w", iv[0], err)
-		}
-		value, err := strconv.ParseFloat(iv[1], 64)
-		if err == nil && value < 0 && !o.Value.AllowNegative {
-			err = NegativeValueError{value}
-		}
-		if err != nil {
-			return fmt.Errorf("invalid value %#v: %w", iv[1], err)
-		}
-		coo := Entry{index, value}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case ch <- coo:
+		case ch <- Entry{indices[0], value}:
 		}
 	}
-	return nil
 }
 
 // CooEntry is a sparse matrix coordinate-format ("Coo") entry.
@@ -135,46 +117,42 @@ func SortEntriesByValue(entries []Entry) []Entry {
 func SendCooEntriesFromCSV(
 	ctx context.Context, r util.CSVReader, ch chan<- CooEntry,
 	opts ...spopt.Option,
+) error {
+	return sendCooEntriesFromCSV(ctx, r, ch, nil, opts...)
+}
+
+// sendCooEntriesFromCSV is SendCooEntriesFromCSV
+// that also appends the time of each entry into times, if not nil
+// and the CSV header has the time field.
+func sendCooEntriesFromCSV(
+	ctx context.Context, r util.CSVReader, ch chan<- CooEntry,
+	times *[]entryTime, opts ...spopt.Option,
 ) error {
 	o := spopt.New(opts...)
-	header, err := r.Read()
-	if err != nil {
-		return err
-	}
-	xt, err := util.NewCSVFieldExtractor(header,
-		o.Row.Name, o.Column.Name, o.Value.Name)
+	p, err := newCSVEntryParser(r, o, []*spopt.Axis{o.Row, o.Column},
+		false)
 	if err != nil {
 		return err
 	}
-	for fields, err := r.Read(); err != io.EOF; fields, err = r.Read() {
-		if err != nil {
+	for {
+		indices, value, issues, err := p.next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
 			return err
+		case len(issues) != 0:
+			return issues[0]
 		}
-		ijv, err := xt.ExtractAll(fields)
-		if err != nil {
-			return err
-		}
-		row, err := peer.ParseId(ijv[0], o.Row.PeerMap, o.Row.Alloc)
-		if err != nil {
-			return fmt.Errorf("invalid row id %#v: %w", ijv[0], err)
-		}
-		column, err := peer.ParseId(ijv[1], o.Column.PeerMap, o.Column.Alloc)
-		if err != nil {
-			return fmt.Errorf("invalid column id %#v: %w", ijv[1], err)
-		}
-		value, err := strconv.ParseFloat(ijv[2], 64)
-		if err == nil && value < 0 && !o.Value.AllowNegative {
-			err = NegativeValueError{value}
-		}
-		if err != nil {
-			return fmt.Errorf("invalid value %#v: %w", ijv[2], err)
+		if times != nil && p.timeField != -1 {
+			*times = append(*times, entryTime{
+				CooEntry{indices[0], indices[1], value}, p.time,
+			})
 		}
-		coo := CooEntry{row, column, value}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case ch <- coo:
+		case ch <- CooEntry{indices[0], indices[1], value}:
 		}
 	}
-	return nil
 }
@@ -13,6 +13,17 @@ var ErrZeroSum = errors.New("zero sum")
 // ex: a local trust matrix and a pre-trust vector.
 var ErrDimensionMismatch = errors.New("dimension mismatch")
 
+// FillInBudgetError signals that an operation would have produced
+// more nonzero entries than the given budget allows.
+type FillInBudgetError struct {
+	Budget int
+}
+
+func (e FillInBudgetError) Error() string {
+	return fmt.Sprintf("fill-in exceeds budget of %#v nonzero entries",
+		e.Budget)
+}
+
 // NegativeValueError signals a negative-valued entry was encountered
 // where disallowed.
 type NegativeValueError struct {
@@ -22,3 +33,68 @@ type NegativeValueError struct {
 func (e NegativeValueError) Error() string {
 	return fmt.Sprintf("negative value %#v not allowed", e.Value)
 }
+
+// DuplicateIndexError signals that a vector contained multiple entries
+// at the same index where disallowed.
+type DuplicateIndexError struct {
+	Index int
+}
+
+func (e DuplicateIndexError) Error() string {
+	return fmt.Sprintf("duplicate entries at index %#v", e.Index)
+}
+
+// DuplicateEntryError signals that a matrix contained multiple entries
+// at the same location where disallowed.
+type DuplicateEntryError struct {
+	Row, Column int
+}
+
+func (e DuplicateEntryError) Error() string {
+	return fmt.Sprintf("duplicate entries at row %#v column %#v",
+		e.Row, e.Column)
+}
+
+// NonFiniteValueError signals a NaN or infinite value was encountered.
+type NonFiniteValueError struct {
+	Value float64
+}
+
+func (e NonFiniteValueError) Error() string {
+	return fmt.Sprintf("non-finite value %v not allowed", e.Value)
+}
+
+// CSVIssue describes a problem found in a CSV file,
+// along with its location.
+type CSVIssue struct {
+	// Line is the 1-based line number.
+	Line int
+
+	// Column is the 1-based column (byte position within the line)
+	// of the offending field, or 0 if the problem is with the whole record.
+	// If the reader cannot locate fields (see NewCSVReader),
+	// it is the 1-based field number instead.
+	Column int
+
+	// Field is the header name of the offending field, if any.
+	Field string
+
+	// Err describes the problem.
+	Err error
+}
+
+func (e CSVIssue) Error() string {
+	var location string
+	switch {
+	case e.Column == 0:
+		location = fmt.Sprintf("line %d", e.Line)
+	case e.Field == "":
+		location = fmt.Sprintf("line %d, column %d", e.Line, e.Column)
+	default:
+		location = fmt.Sprintf("line %d, column %d (%s)",
+			e.Line, e.Column, e.Field)
+	}
+	return fmt.Sprintf("%s: %v", location, e.Err)
+}
+
+func (e CSVIssue) Unwrap() error { return e.Err }
@@ -0,0 +1,486 @@
+package sparse
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// jsonlEntryParser parses sparse entries from JSON Lines (newline-delimited
+// JSON) input: one JSON object per line, with one peer identifier member
+// per axis and a value member, named after the axis/value names.
+// Blank lines are skipped; other members are ignored.
+type jsonlEntryParser struct {
+	r     *bufio.Reader
+	axes  []*spopt.Axis
+	value *spopt.Value
+	line  int
+
+	// time is the entry time of the last object read, 0 if none;
+	// see spopt.TimeNamed.
+	time float64
+}
+
+func newJSONLEntryParser(
+	r io.Reader, o *spopt.Set, axes []*spopt.Axis,
+) *jsonlEntryParser {
+	return &jsonlEntryParser{r: bufio.NewReader(r), axes: axes, value: o.Value}
+}
+
+// next reads and parses the next object.
+//
+// It returns the peer indices (one per axis) and the value.
+// The entry time, if any, is left in p.time.
+// It returns io.EOF at the end.
+func (p *jsonlEntryParser) next() (indices []int, value float64, err error) {
+	line, err := p.readLine()
+	if err != nil {
+		return nil, 0, err
+	}
+	indices, value, err = p.parse(line)
+	if err != nil {
+		return nil, 0, fmt.Errorf("line %d: %w", p.line, err)
+	}
+	return indices, value, nil
+}
+
+// readLine reads the next non-blank line, with spaces trimmed.
+// It returns io.EOF at the end.
+func (p *jsonlEntryParser) readLine() (line []byte, err error) {
+	for len(line) == 0 {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		line, err = p.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		p.line++
+		line = bytes.TrimSpace(line)
+	}
+	return line, nil
+}
+
+func (p *jsonlEntryParser) parse(line []byte) (
+	indices []int, value float64, err error,
+) {
+	var members map[string]json.RawMessage
+	if err = json.Unmarshal(line, &members); err != nil {
+		return nil, 0, err
+	}
+	indices = make([]int, len(p.axes))
+	for i, axis := range p.axes {
+		id, err := jsonlMember(members, axis.Name)
+		if err != nil {
+			return nil, 0, err
+		}
+		indices[i], err = peer.ParseId(id, axis.PeerMap, axis.Alloc)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid %s id %#v: %w",
+				axis.Name, id, err)
+		}
+	}
+	literal, err := jsonlMember(members, p.value.Name)
+	if err != nil {
+		return nil, 0, err
+	}
+	value, err = strconv.ParseFloat(literal, 64)
+	switch {
+	case err != nil:
+	case math.IsNaN(value) || math.IsInf(value, 0):
+		err = NonFiniteValueError{value}
+	case value < 0 && !p.value.AllowNegative:
+		err = NegativeValueError{value}
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid %s %#v: %w",
+			p.value.Name, literal, err)
+	}
+	p.time = 0
+	if name := p.value.TimeName; name != "" {
+		if _, ok := members[name]; !ok {
+			return indices, value, nil
+		}
+		// Empty or null time means no time.
+		if literal, err = jsonlMember(members, name); err != nil {
+			return nil, 0, err
+		}
+		if literal == "" {
+			return indices, value, nil
+		}
+		if p.time, err = parseEntryTime(literal); err != nil {
+			return nil, 0, fmt.Errorf("invalid %s %#v: %w",
+				name, literal, err)
+		}
+	}
+	return indices, value, nil
+}
+
+// jsonlMember returns the given member, either a string or a number,
+// as a string.
+func jsonlMember(
+	members map[string]json.RawMessage, name string,
+) (string, error) {
+	raw, ok := members[name]
+	if !ok {
+		return "", fmt.Errorf("missing member %#v", name)
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return "", fmt.Errorf("member %#v is neither a string nor a number",
+			name)
+	}
+	return n.String(), nil
+}
+
+// SendEntriesFromJSONL parses vector entries from JSON Lines input
+// and sends them into the given channel.
+//
+// Each line is an object with the index (spopt.IndexNamed, default "i")
+// and value (spopt.ValueNamed, default "v") members.
+// Indices are mapped into peer indices the same way as CSV,
+// e.g. using spopt.IndicesInto.
+func SendEntriesFromJSONL(
+	ctx context.Context, r io.Reader, ch chan<- Entry, opts ...spopt.Option,
+) error {
+	o := spopt.New(opts...)
+	p := newJSONLEntryParser(r, o, []*spopt.Axis{o.Row})
+	for {
+		indices, value, err := p.next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- Entry{indices[0], value}:
+		}
+	}
+}
+
+// SendCooEntriesFromJSONL parses matrix entries from JSON Lines input
+// and sends them into the given channel.
+//
+// Each line is an object with the row index (spopt.RowIndexNamed,
+// default "i"), column index (spopt.ColumnIndexNamed, default "j"),
+// and value (spopt.ValueNamed, default "v") members.
+// Indices are mapped into peer indices the same way as CSV,
+// e.g. using spopt.IndicesInto.
+func SendCooEntriesFromJSONL(
+	ctx context.Context, r io.Reader, ch chan<- CooEntry,
+	opts ...spopt.Option,
+) error {
+	return sendCooEntriesFromJSONL(ctx, r, ch, nil, opts...)
+}
+
+// sendCooEntriesFromJSONL is SendCooEntriesFromJSONL
+// that also appends the time of each entry into times, if not nil
+// and the time member is named (zero if the entry has no time).
+func sendCooEntriesFromJSONL(
+	ctx context.Context, r io.Reader, ch chan<- CooEntry,
+	times *[]entryTime, opts ...spopt.Option,
+) error {
+	o := spopt.New(opts...)
+	p := newJSONLEntryParser(r, o, []*spopt.Axis{o.Row, o.Column})
+	for {
+		indices, value, err := p.next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+		if times != nil && p.value.TimeName != "" {
+			*times = append(*times, entryTime{
+				CooEntry{indices[0], indices[1], value}, p.time,
+			})
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- CooEntry{indices[0], indices[1], value}:
+		}
+	}
+}
+
+// ValidateMatrixJSONL checks matrix entries in JSON Lines
+// the same way NewCSMatrixFromJSONL reads them with the same options,
+// and returns every problem found, in the order found.
+//
+// As with ValidateMatrixCSV, it goes through the entire input,
+// and also reports out-of-range indices (under FixedDim and the like)
+// and duplicate entries (under RejectDuplicates).
+// Issues have line numbers but no columns.
+//
+// A non-nil error means the reader failed,
+// e.g. an I/O error, and the validation is incomplete.
+func ValidateMatrixJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) ([]CSVIssue, error) {
+	o := spopt.New(opts...)
+	return validateJSONL(ctx, r, o, []*spopt.Axis{o.Row, o.Column})
+}
+
+// ValidateVectorJSONL checks vector entries in JSON Lines
+// the same way NewVectorFromJSONL reads them with the same options,
+// and returns every problem found, in the order found.
+//
+// See ValidateMatrixJSONL for details.
+func ValidateVectorJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) ([]CSVIssue, error) {
+	o := spopt.New(opts...)
+	return validateJSONL(ctx, r, o, []*spopt.Axis{o.Row})
+}
+
+func validateJSONL(
+	ctx context.Context, r io.Reader, o *spopt.Set, axes []*spopt.Axis,
+) (issues []CSVIssue, err error) {
+	p := newJSONLEntryParser(r, o, axes)
+	var seen seenEntries
+	if o.Value.Duplicates == spopt.DuplicateError {
+		seen = make(seenEntries)
+	}
+	for {
+		if err = ctx.Err(); err != nil {
+			return issues, err
+		}
+		line, err := p.readLine()
+		switch {
+		case err == io.EOF:
+			return issues, nil
+		case err != nil:
+			return issues, err
+		}
+		indices, _, err := p.parse(line)
+		if err == nil {
+			for i, axis := range axes {
+				if !axis.Grow && indices[i] >= axis.Dim {
+					err = fmt.Errorf("invalid %s id: %w", axis.Name,
+						util.IndexOutOfBoundsError{
+							Index: indices[i], Bound: axis.Dim,
+						})
+					break
+				}
+			}
+		}
+		if err == nil && seen != nil {
+			err = seen.check(indices, p.line)
+		}
+		if err != nil {
+			issues = append(issues, CSVIssue{Line: p.line, Err: err})
+		}
+	}
+}
+
+// jsonlWriter writes JSON Lines objects with fixed member names.
+type jsonlWriter struct {
+	w     *bufio.Writer
+	names [][]byte // JSON-encoded member names
+	buf   []byte
+}
+
+func newJSONLWriter(w io.Writer, names ...string) *jsonlWriter {
+	jw := &jsonlWriter{w: bufio.NewWriter(w)}
+	for _, name := range names {
+		encoded, _ := json.Marshal(name) // strings always marshal
+		jw.names = append(jw.names, encoded)
+	}
+	return jw
+}
+
+// write writes one object.
+// ids are peer IDs, written as strings if mapped or as numbers if literal.
+func (jw *jsonlWriter) write(
+	indices []int, axes []*spopt.Axis, value float64,
+) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return NonFiniteValueError{value}
+	}
+	jw.buf = append(jw.buf[:0], '{')
+	for i, axis := range axes {
+		id, err := peer.GetId(indices[i], axis.PeerMap)
+		if err != nil {
+			return err
+		}
+		jw.buf = append(jw.buf, jw.names[i]...)
+		jw.buf = append(jw.buf, ':')
+		if axis.PeerMap == nil {
+			jw.buf = append(jw.buf, id...)
+		} else {
+			encoded, _ := json.Marshal(id)
+			jw.buf = append(jw.buf, encoded...)
+		}
+		jw.buf = append(jw.buf, ',')
+	}
+	jw.buf = append(jw.buf, jw.names[len(axes)]...)
+	jw.buf = append(jw.buf, ':')
+	jw.buf = strconv.AppendFloat(jw.buf, value, 'g', -1, 64)
+	jw.buf = append(jw.buf, "}\n"...)
+	_, err := jw.w.Write(jw.buf)
+	return err
+}
+
+// WriteIntoJSONL writes all entries into w in JSON Lines format,
+// one object per entry, using the same member names and peer ID mapping
+// that SendCooEntriesFromJSONL reads.
+func (m *CSMatrix) WriteIntoJSONL(
+	ctx context.Context, w io.Writer, opts ...spopt.Option,
+) error {
+	o := spopt.New(opts...)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	axes := []*spopt.Axis{o.Row, o.Column}
+	jw := newJSONLWriter(w, o.Row.Name, o.Column.Name, o.Value.Name)
+	ch := make(chan CooEntry)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- m.SendCooEntries(ctx, ch, opts...)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case coo, ok := <-ch:
+			if !ok {
+				return errors.Join(<-sendErr, jw.w.Flush())
+			}
+			err := jw.write([]int{coo.Row, coo.Column}, axes, coo.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// NewCSMatrixFromJSONL creates a new compressed sparse matrix
+// with the entries read from JSON Lines input.
+//
+// See SendCooEntriesFromJSONL for the input format.
+func NewCSMatrixFromJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) (*CSMatrix, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(chan CooEntry)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- SendCooEntriesFromJSONL(ctx, r, ch, opts...)
+	}()
+	m, err := NewCSMatrixFromEntryCh(ctx, ch, opts...)
+	if err == nil {
+		err = util.ErrFromCh(ctx, sendErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NewCSRMatrixFromJSONL creates a new compressed sparse row matrix
+// with the entries read from JSON Lines input.
+//
+// See SendCooEntriesFromJSONL for the input format.
+func NewCSRMatrixFromJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) (*CSRMatrix, error) {
+	opts = append(opts, spopt.RowMajor)
+	return cs2csr(NewCSMatrixFromJSONL(ctx, r, opts...))
+}
+
+// WriteIntoJSONL writes all entries into w in JSON Lines format.
+func (m *CSRMatrix) WriteIntoJSONL(
+	ctx context.Context, w io.Writer, opts ...spopt.Option,
+) error {
+	opts = append(opts, spopt.RowMajor)
+	return m.CSMatrix.WriteIntoJSONL(ctx, w, opts...)
+}
+
+// WriteIntoJSONL writes all entries into w in JSON Lines format.
+func (m *CSCMatrix) WriteIntoJSONL(
+	ctx context.Context, w io.Writer, opts ...spopt.Option,
+) error {
+	opts = append(opts, spopt.ColumnMajor)
+	return m.CSMatrix.WriteIntoJSONL(ctx, w, opts...)
+}
+
+// NewVectorFromJSONL creates a new sparse vector
+// with the entries read from JSON Lines input.
+//
+// See SendEntriesFromJSONL for the input format.
+func NewVectorFromJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) (*Vector, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(chan Entry)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- SendEntriesFromJSONL(ctx, r, ch, opts...)
+	}()
+	v, err := NewVectorFromEntryCh(ctx, ch, opts...)
+	if err == nil {
+		err = util.ErrFromCh(ctx, sendErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// WriteIntoJSONL writes all entries into w in JSON Lines format,
+// one object per entry, using the same member names and peer ID mapping
+// that SendEntriesFromJSONL reads.
+func (v *Vector) WriteIntoJSONL(
+	ctx context.Context, w io.Writer, opts ...spopt.Option,
+) error {
+	o := spopt.New(opts...)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	axes := []*spopt.Axis{o.Row}
+	jw := newJSONLWriter(w, o.Row.Name, o.Value.Name)
+	ch := make(chan Entry)
+	sendErr := make(chan error, 1)
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- v.SendEntries(ctx, ch, opts...)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entry, ok := <-ch:
+			if !ok {
+				return errors.Join(<-sendErr, jw.w.Flush())
+			}
+			err := jw.write([]int{entry.Index}, axes, entry.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
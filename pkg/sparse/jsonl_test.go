@@ -0,0 +1,179 @@
+package sparse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k3l.io/go-eigentrust/pkg/peer"
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+func TestNewCSRMatrixFromJSONL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    []spopt.Option
+		want    []CooEntry
+		wantErr error
+	}{
+		{
+			name: "Literal",
+			input: `{"i":0,"j":1,"v":1}` + "\n" +
+				"\n" +
+				`{"i":"1","j":2,"v":2.5,"extra":true}` + "\n" +
+				`  {"j":0,"i":2,"v":3}`, // no trailing newline
+			want: []CooEntry{{0, 1, 1}, {1, 2, 2.5}, {2, 0, 3}},
+		},
+		{
+			name:  "FieldNames",
+			input: `{"from":0,"to":1,"value":1}` + "\n",
+			opts: []spopt.Option{
+				spopt.RowIndexNamed("from"), spopt.ColumnIndexNamed("to"),
+				spopt.ValueNamed("value"),
+			},
+			want: []CooEntry{{0, 1, 1}},
+		},
+		{
+			name:    "MissingMember",
+			input:   `{"i":0,"v":1}` + "\n",
+			wantErr: errors.New(`line 1: missing member "j"`),
+		},
+		{
+			name:    "Negative",
+			input:   `{"i":0,"j":1,"v":1}` + "\n" + `{"i":0,"j":2,"v":-1}`,
+			wantErr: NegativeValueError{-1},
+		},
+		{
+			name:    "NotAnObject",
+			input:   "[0,1,1]\n",
+			wantErr: errors.New("line 1: json: cannot unmarshal array"),
+		},
+		{
+			name:    "BadIndex",
+			input:   `{"i":-1,"j":1,"v":1}` + "\n",
+			wantErr: peer.NegativeIndex{Value: -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewCSRMatrixFromJSONL(context.Background(),
+				strings.NewReader(tt.input), tt.opts...)
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error %v", tt.wantErr)
+				}
+				if !errors.Is(err, tt.wantErr) &&
+					!strings.HasPrefix(err.Error(), tt.wantErr.Error()) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewCSRMatrixFromJSONL() error = %v", err)
+			}
+			got, err := m.CooEntries(context.Background())
+			if err != nil {
+				t.Fatalf("CooEntries() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entries = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMatrixJSONL(t *testing.T) {
+	input := `{"i":0,"j":1,"v":1}` + "\n" +
+		"\n" +
+		`{"i":0,"j":1,"v":2}` + "\n" + // duplicate
+		"not json\n" +
+		`{"i":1,"v":1}` + "\n" + // missing j
+		`{"i":2,"j":0,"v":-1}` + "\n" +
+		`{"i":1,"j":5,"v":1}` + "\n" + // out of range
+		`{"i":1,"j":0,"v":1}`
+	issues, err := ValidateMatrixJSONL(context.Background(),
+		strings.NewReader(input),
+		spopt.RejectDuplicates, spopt.FixedDim(3, 3))
+	if err != nil {
+		t.Fatalf("ValidateMatrixJSONL() error = %v", err)
+	}
+	wantLines := []int{3, 4, 5, 6, 7}
+	if len(issues) != len(wantLines) {
+		t.Fatalf("ValidateMatrixJSONL() issues = %v, want %d issues",
+			issues, len(wantLines))
+	}
+	for i, issue := range issues {
+		if issue.Line != wantLines[i] || issue.Column != 0 {
+			t.Errorf("issue %d (%v) at line %d column %d, want line %d",
+				i, issue, issue.Line, issue.Column, wantLines[i])
+		}
+	}
+	if !errors.Is(issues[0], DuplicateEntryError{Row: 0, Column: 1}) {
+		t.Errorf("issue 0 = %v, want duplicate entry (0, 1)", issues[0])
+	}
+	if !errors.Is(issues[3], NegativeValueError{-1}) {
+		t.Errorf("issue 3 = %v, want negative value", issues[3])
+	}
+}
+
+func TestCSRMatrix_WriteIntoJSONL(t *testing.T) {
+	ctx := context.Background()
+	input := `{"from":"0xab","to":"0xcd","value":3}` + "\n" +
+		`{"from":"0xcd","to":"0xef","value":0.5}` + "\n"
+	names := []spopt.Option{
+		spopt.RowIndexNamed("from"), spopt.ColumnIndexNamed("to"),
+		spopt.ValueNamed("value"),
+	}
+	peerMap := peer.NewMap()
+	m, err := NewCSRMatrixFromJSONL(ctx, strings.NewReader(input),
+		append(names, spopt.IndicesInto(peerMap))...)
+	if err != nil {
+		t.Fatalf("NewCSRMatrixFromJSONL() error = %v", err)
+	}
+	if rows, cols := m.Dims(); rows != 2 || cols != 3 {
+		t.Errorf("Dims() = %v, %v, want 2, 3", rows, cols)
+	}
+	var buf bytes.Buffer
+	err = m.WriteIntoJSONL(ctx, &buf,
+		append(names, spopt.IndicesIn(peerMap))...)
+	if err != nil {
+		t.Fatalf("WriteIntoJSONL() error = %v", err)
+	}
+	if got := buf.String(); got != input {
+		t.Errorf("WriteIntoJSONL() wrote %#v, want %#v", got, input)
+	}
+	buf.Reset()
+	if err = m.WriteIntoJSONL(ctx, &buf); err != nil {
+		t.Fatalf("WriteIntoJSONL() error = %v", err)
+	}
+	want := `{"i":0,"j":1,"v":3}` + "\n" + `{"i":1,"j":2,"v":0.5}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteIntoJSONL() wrote %#v, want %#v", got, want)
+	}
+}
+
+func TestVector_JSONL(t *testing.T) {
+	ctx := context.Background()
+	input := `{"i":3,"v":0.25}` + "\n" + `{"i":0,"v":0.75}` + "\n"
+	v, err := NewVectorFromJSONL(ctx, strings.NewReader(input),
+		spopt.MinRows(5))
+	if err != nil {
+		t.Fatalf("NewVectorFromJSONL() error = %v", err)
+	}
+	wantEntries := []Entry{{0, 0.75}, {3, 0.25}}
+	if v.Dim != 5 || !reflect.DeepEqual(v.Entries, wantEntries) {
+		t.Errorf("vector = %v %v, want 5 %v", v.Dim, v.Entries, wantEntries)
+	}
+	var buf bytes.Buffer
+	if err = v.WriteIntoJSONL(ctx, &buf); err != nil {
+		t.Fatalf("WriteIntoJSONL() error = %v", err)
+	}
+	want := `{"i":0,"v":0.75}` + "\n" + `{"i":3,"v":0.25}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteIntoJSONL() wrote %#v, want %#v", got, want)
+	}
+}
@@ -2,11 +2,13 @@ package sparse
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
 	"sort"
 	"strconv"
+	"sync"
 	"syscall"
 	"unsafe"
 
@@ -100,8 +102,18 @@ EntryLoop:
 			})
 		}
 	}
+	rowColFromMajMin := o.RowColFromMajMin()
 	for major, span := range m.Entries {
-		sort.Sort(EntriesByIndex(span))
+		sort.Stable(EntriesByIndex(span))
+		span, err := dedupSpan(span, o.Value)
+		if err != nil {
+			var dup DuplicateIndexError
+			if errors.As(err, &dup) {
+				row, col := rowColFromMajMin(major, dup.Index)
+				err = DuplicateEntryError{Row: row, Column: col}
+			}
+			return nil, err
+		}
 		m.Entries[major] = util.ShrinkWrap(span)
 	}
 	m.Entries = util.ShrinkWrap(m.Entries)
@@ -112,7 +124,8 @@ EntryLoop:
 // NewCSMatrixFromCSV creates a new compressed sparse row matrix
 // with the given dimension and entries.
 //
-// The first CSV row is treated as the header row.
+// The first CSV row is treated as the header row, unless spopt.NoHeader.
+// Delimiter and comment options apply to readers made by NewCSVReader.
 func NewCSMatrixFromCSV(
 	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
 ) (*CSMatrix, error) {
@@ -183,49 +196,191 @@ func (m *CSMatrix) NNZ() (nnz int) {
 	return
 }
 
+// Clone returns a deep copy of the receiver.
+//
+// The entries of the returned copy share one contiguous backing array.
+func (m *CSMatrix) Clone() *CSMatrix {
+	entries := make([]Entry, 0, m.NNZ())
+	spans := make([][]Entry, len(m.Entries))
+	for major, span := range m.Entries {
+		if len(span) != 0 {
+			begin := len(entries)
+			entries = append(entries, span...)
+			spans[major] = entries[begin:len(entries):len(entries)]
+		}
+	}
+	return &CSMatrix{
+		MajorDim: m.MajorDim,
+		MinorDim: m.MinorDim,
+		Entries:  spans,
+	}
+}
+
 // Transpose transposes the sparse matrix.
 func (m *CSMatrix) Transpose(ctx context.Context) (*CSMatrix, error) {
-	nnzs := make([]int, m.MinorDim) // indexed by column
-	for _, rowEntries := range m.Entries {
-		for _, e := range rowEntries {
-			nnzs[e.Index]++
+	mt := &CSMatrix{}
+	if err := m.TransposeInto(ctx, mt); err != nil {
+		return nil, err
+	}
+	runtime.SetFinalizer(mt, (*CSMatrix).finalize)
+	return mt, nil
+}
+
+// TransposeInto stores the transpose of the receiver into mt.
+//
+// TransposeInto is a parallel counting sort over major (row) ranges:
+// Workers first count the entries per column in their own rows,
+// then copy the entries into a single contiguous backing array
+// at the offsets derived from the counts.
+// mt's previous contents are discarded (unmapped if mapped),
+// but only on success; on error, mt is left unchanged.
+//
+// mt must not be the receiver.
+func (m *CSMatrix) TransposeInto(ctx context.Context, mt *CSMatrix) error {
+	if mt == m {
+		return errors.New("cannot transpose in-place")
+	}
+	rows, cols := len(m.Entries), m.MinorDim
+	numWorkers := min(runtime.GOMAXPROCS(0),
+		max((rows+minChunkSize-1)/minChunkSize, 1))
+	bounds := make([]int, numWorkers+1)
+	for w := range bounds {
+		bounds[w] = rows * w / numWorkers
+	}
+
+	// forEachRowRange runs f for each worker's row range in parallel.
+	forEachRowRange := func(f func(w int, row int) error) error {
+		errs := make([]error, numWorkers)
+		var wg sync.WaitGroup
+		wg.Add(numWorkers)
+		for w := 0; w < numWorkers; w++ {
+			go func(w int) {
+				defer wg.Done()
+				for row := bounds[w]; row < bounds[w+1]; row++ {
+					if (row-bounds[w])%minChunkSize == 0 {
+						select {
+						case <-ctx.Done():
+							errs[w] = ctx.Err()
+							return
+						default:
+						}
+					}
+					if errs[w] = f(w, row); errs[w] != nil {
+						return
+					}
+				}
+			}(w)
 		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Pass 1: count entries per column, per row range.
+	counts := make([][]int, numWorkers)
+	for w := range counts {
+		counts[w] = make([]int, cols)
 	}
-	transposedEntries := make([][]Entry, m.MinorDim)
-	for col, nnz := range nnzs {
-		if nnz != 0 {
-			transposedEntries[col] = make([]Entry, 0, nnz)
+	err := forEachRowRange(func(w int, row int) error {
+		for _, e := range m.Entries[row] {
+			counts[w][e.Index]++
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	for row, rowEntries := range m.Entries {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+	// Reduce: offsets[col] is where column col begins, and
+	// counts[w][col] becomes where worker w's entries in column col begin.
+	offsets := make([]int, cols+1)
+	for col := 0; col < cols; col++ {
+		next := offsets[col]
+		for _, count := range counts {
+			count[col], next = next, next+count[col]
+		}
+		offsets[col+1] = next
+	}
+	nnz := offsets[cols]
+
+	// Pass 2: copy entries, per row range.
+	// Row ranges are in order, so each column stays sorted by row.
+	entries := make([]Entry, nnz)
+	err = forEachRowRange(func(w int, row int) error {
+		next := counts[w]
+		for _, e := range m.Entries[row] {
+			entries[next[e.Index]] = Entry{Index: row, Value: e.Value}
+			next[e.Index]++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	spans := make([][]Entry, cols)
+	for col := range spans {
+		spanBegin, spanEnd := offsets[col], offsets[col+1]
+		if spanBegin != spanEnd {
+			spans[col] = entries[spanBegin:spanEnd:spanEnd]
 		}
-		for _, e := range rowEntries {
-			col := e.Index
-			transposedEntries[col] = append(transposedEntries[col],
-				Entry{Index: row, Value: e.Value})
+	}
+	if mt.mapped != nil {
+		if err = syscall.Munmap(mt.mapped); err != nil {
+			return err
 		}
+		mt.mapped = nil
 	}
-	mt := &CSMatrix{
-		MajorDim: m.MinorDim,
-		MinorDim: m.MajorDim,
-		Entries:  transposedEntries,
+	mt.MajorDim = m.MinorDim
+	mt.MinorDim = m.MajorDim
+	mt.Entries = spans
+	return nil
+}
+
+// dedupSpan collapses entries at the same index in-place,
+// according to the duplicate policy in the given value options.
+//
+// span must be sorted by index,
+// with duplicate entries in the order they were seen.
+// Entries that combine into zero are dropped unless o.IncludeZero is set.
+func dedupSpan(span []Entry, o *spopt.Value) ([]Entry, error) {
+	if len(span) < 2 {
+		return span, nil
+	}
+	n := 0
+	for i, e := range span {
+		if i > 0 && span[n-1].Index == e.Index {
+			v, ok := o.Duplicates.Combine(span[n-1].Value, e.Value)
+			if !ok {
+				return nil, DuplicateIndexError{Index: e.Index}
+			}
+			span[n-1].Value = v
+			continue
+		}
+		if n > 0 && span[n-1].Value == 0 && !o.IncludeZero {
+			n-- // previous entry combined into zero; overwrite it
+		}
+		span[n] = e
+		n++
 	}
-	runtime.SetFinalizer(mt, (*CSMatrix).finalize)
-	return mt, nil
+	if n > 0 && span[n-1].Value == 0 && !o.IncludeZero {
+		n--
+	}
+	return span[:n], nil
 }
 
-func mergeSpan(s1, s2 []Entry) []Entry {
+func mergeSpan(
+	s1, s2 []Entry, policy spopt.DuplicatePolicy,
+) ([]Entry, error) {
 	switch {
 	case len(s1) == 0 && len(s2) == 0:
-		return nil
+		return nil, nil
 	case len(s1) == 0:
-		return s2
+		return s2, nil
 	case len(s2) == 0:
-		return s1
+		return s1, nil
 	}
 	s := make([]Entry, 0, len(s1)+len(s2)) // worst case, shrink-wrap later
 	i1, i2 := 0, 0
@@ -250,33 +405,63 @@ func mergeSpan(s1, s2 []Entry) []Entry {
 					s = append(s, s2[i2])
 				}
 				i2++
-			default: // s1[i1].Index == s2[i2].Index, s2 wins
-				if s2[i2].Value != 0 {
-					s = append(s, s2[i2])
+			default: // s1[i1].Index == s2[i2].Index, combine per policy
+				v, ok := policy.Combine(s1[i1].Value, s2[i2].Value)
+				if !ok {
+					return nil, DuplicateIndexError{Index: index1}
+				}
+				if v != 0 {
+					s = append(s, Entry{Index: index1, Value: v})
 				}
 				i1++
 				i2++
 			}
 		}
 	}
-	return append(s[:0:0], s...) // shrink-wrap
+	return append(s[:0:0], s...), nil // shrink-wrap
 }
 
 // Merge merges the given matrix (m2) into the receiver.
 //
-// If both m and m2 contain an entry at the same location, m2's entry wins.
+// If both m and m2 contain an entry at the same location,
+// the duplicate policy in opts decides the outcome;
+// by default, m2's entry wins.
+// An explicit zero entry in m2 removes the corresponding entry in m,
+// unless the policy says otherwise.
+//
+// A duplicate entry rejected by the policy is reported
+// by its row/column per spopt.RowMajor/spopt.ColumnMajor in opts
+// (row-major by default); CSRMatrix.Merge and CSCMatrix.Merge set it.
 //
 // m2 is reset after merge.
-func (m *CSMatrix) Merge(m2 *CSMatrix) {
+// Upon error, m is left intact and m2 is not reset.
+func (m *CSMatrix) Merge(m2 *CSMatrix, opts ...spopt.Option) error {
+	o := spopt.New(opts...)
 	// Load m2 back into memory, we are about to merge/reuse its spans in m.
 	_ = m2.Munmap()
 
+	merged := make([][]Entry, m2.MajorDim)
+	for i := range merged {
+		var span []Entry
+		if i < m.MajorDim {
+			span = m.Entries[i]
+		}
+		span, err := mergeSpan(span, m2.Entries[i], o.Value.Duplicates)
+		if err != nil {
+			var dup DuplicateIndexError
+			if errors.As(err, &dup) {
+				row, col := o.RowColFromMajMin()(i, dup.Index)
+				err = DuplicateEntryError{Row: row, Column: col}
+			}
+			return err
+		}
+		merged[i] = span
+	}
 	m.SetMajorDim(max(m.MajorDim, m2.MajorDim)) // also resizes m.Entries
 	m.SetMinorDim(max(m.MinorDim, m2.MinorDim))
-	for i := 0; i < m2.MajorDim; i++ {
-		m.Entries[i] = mergeSpan(m.Entries[i], m2.Entries[i])
-	}
+	copy(m.Entries, merged)
 	m2.Reset()
+	return nil
 }
 
 // Mmap swaps out contents onto a temp file and mmap-s it, freeing core memory.
@@ -564,7 +749,8 @@ func NewCSRMatrixFromEntryCh(
 // NewCSRMatrixFromCSV creates a new compressed sparse row matrix
 // with the given dimension and entries.
 //
-// The first CSV row is treated as the header row.
+// The first CSV row is treated as the header row, unless spopt.NoHeader.
+// Delimiter and comment options apply to readers made by NewCSVReader.
 func NewCSRMatrixFromCSV(
 	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
 ) (*CSRMatrix, error) {
@@ -572,6 +758,13 @@ func NewCSRMatrixFromCSV(
 	return cs2csr(NewCSMatrixFromCSV(ctx, r, opts...))
 }
 
+// Merge merges the given row-major matrix (m2) into the receiver;
+// see CSMatrix.Merge.
+func (m *CSRMatrix) Merge(m2 *CSMatrix, opts ...spopt.Option) error {
+	opts = append(opts, spopt.RowMajor)
+	return m.CSMatrix.Merge(m2, opts...)
+}
+
 // Dims returns the numbers of rows/columns.
 func (m *CSRMatrix) Dims() (rows, cols int) { return m.MajorDim, m.MinorDim }
 
@@ -597,6 +790,11 @@ func (m *CSRMatrix) SetRowVector(index int, vector *Vector) {
 	m.Entries[index] = vector.Entries
 }
 
+// Clone returns a deep copy of the receiver.
+func (m *CSRMatrix) Clone() *CSRMatrix {
+	return &CSRMatrix{CSMatrix: *m.CSMatrix.Clone()}
+}
+
 // Transpose transposes the matrix.
 func (m *CSRMatrix) Transpose(ctx context.Context) (*CSRMatrix, error) {
 	mt, err := m.CSMatrix.Transpose(ctx)
@@ -652,6 +850,13 @@ type CSCMatrix struct {
 // Dims returns the numbers of rows/columns.
 func (m *CSCMatrix) Dims() (rows, cols int) { return m.MinorDim, m.MajorDim }
 
+// Merge merges the given column-major matrix (m2) into the receiver;
+// see CSMatrix.Merge.
+func (m *CSCMatrix) Merge(m2 *CSMatrix, opts ...spopt.Option) error {
+	opts = append(opts, spopt.ColumnMajor)
+	return m.CSMatrix.Merge(m2, opts...)
+}
+
 // SetDim grows/shrinks the receiver in-place,
 // so it contains the specified number of rows/columns.
 func (m *CSCMatrix) SetDim(rows, cols int) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"reflect"
 	"testing"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
 )
 
 func TestCSMatrix_Transpose(t *testing.T) {
@@ -73,6 +75,51 @@ func TestCSMatrix_Transpose(t *testing.T) {
 	}
 }
 
+func TestCSMatrix_TransposeInto(t *testing.T) {
+	// Large and skewed enough to span multiple workers and row ranges.
+	const rows, cols = 3000, 2000
+	m := &CSMatrix{MajorDim: rows, MinorDim: cols}
+	m.Entries = make([][]Entry, rows)
+	want := &CSMatrix{MajorDim: cols, MinorDim: rows}
+	want.Entries = make([][]Entry, cols)
+	for row := 0; row < rows; row++ {
+		for col := row % 7; col < cols; col += 1 + row%13 + col/100 {
+			value := float64(row*cols + col)
+			m.Entries[row] = append(m.Entries[row], Entry{col, value})
+			want.Entries[col] = append(want.Entries[col], Entry{row, value})
+		}
+	}
+	// Reuse a destination with stale contents.
+	mt := &CSMatrix{
+		MajorDim: 5000,
+		MinorDim: 1,
+		Entries:  make([][]Entry, 5000),
+	}
+	mt.Entries[4999] = []Entry{{0, 1}}
+	if err := m.TransposeInto(context.Background(), mt); err != nil {
+		t.Fatalf("TransposeInto() error = %v", err)
+	}
+	if mt.MajorDim != want.MajorDim || mt.MinorDim != want.MinorDim {
+		t.Errorf("TransposeInto() dims = %dx%d, want %dx%d",
+			mt.MajorDim, mt.MinorDim, want.MajorDim, want.MinorDim)
+	}
+	if !reflect.DeepEqual(mt.Entries, want.Entries) {
+		t.Errorf("TransposeInto() entries mismatch")
+	}
+	if err := m.TransposeInto(context.Background(), m); err == nil {
+		t.Errorf("m.TransposeInto(m) succeeded, want error")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.TransposeInto(ctx, mt); err != context.Canceled {
+		t.Errorf("TransposeInto() error = %v, want %v", err, context.Canceled)
+	}
+	// A failed run leaves the destination unchanged.
+	if !reflect.DeepEqual(mt.Entries, want.Entries) {
+		t.Errorf("TransposeInto() changed the destination on error")
+	}
+}
+
 func TestCSMatrix_Merge(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -125,7 +172,9 @@ func TestCSMatrix_Merge(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.m.Merge(tt.m2)
+			if err := tt.m.Merge(tt.m2); err != nil {
+				t.Fatalf("m.Merge(m2) error = %v", err)
+			}
 			if !reflect.DeepEqual(tt.m, tt.merged) {
 				t.Errorf("m.Merge(m2) = %#v, want %#v", tt.m, tt.merged)
 			}
@@ -137,6 +186,161 @@ func TestCSMatrix_Merge(t *testing.T) {
 	}
 }
 
+func TestCSMatrix_Merge_Duplicates(t *testing.T) {
+	newM := func() *CSMatrix {
+		return &CSMatrix{
+			MajorDim: 2,
+			MinorDim: 2,
+			Entries:  [][]Entry{{{0, 1}, {1, 2}}, {{1, 3}}},
+		}
+	}
+	newM2 := func() *CSMatrix {
+		return &CSMatrix{
+			MajorDim: 2,
+			MinorDim: 2,
+			Entries:  [][]Entry{{{1, 5}}, {{0, 4}, {1, -3}}},
+		}
+	}
+	tests := []struct {
+		name    string
+		opts    []spopt.Option
+		want    [][]Entry
+		wantErr error
+	}{
+		{
+			name: "LastWins",
+			want: [][]Entry{{{0, 1}, {1, 5}}, {{0, 4}, {1, -3}}},
+		},
+		{
+			name: "FirstWins",
+			opts: []spopt.Option{spopt.FirstWins},
+			want: [][]Entry{{{0, 1}, {1, 2}}, {{0, 4}, {1, 3}}},
+		},
+		{
+			name: "Sum",
+			opts: []spopt.Option{spopt.SumDuplicates},
+			want: [][]Entry{{{0, 1}, {1, 7}}, {{0, 4}}},
+		},
+		{
+			name: "Max",
+			opts: []spopt.Option{spopt.MaxDuplicates},
+			want: [][]Entry{{{0, 1}, {1, 5}}, {{0, 4}, {1, 3}}},
+		},
+		{
+			name:    "Reject",
+			opts:    []spopt.Option{spopt.RejectDuplicates},
+			wantErr: DuplicateEntryError{Row: 0, Column: 1},
+		},
+		{
+			name:    "RejectColumnMajor",
+			opts:    []spopt.Option{spopt.RejectDuplicates, spopt.ColumnMajor},
+			wantErr: DuplicateEntryError{Row: 1, Column: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, m2 := newM(), newM2()
+			err := m.Merge(m2, tt.opts...)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("m.Merge(m2) error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !reflect.DeepEqual(m, newM()) {
+					t.Errorf("m = %#v, want intact", m)
+				}
+				if !reflect.DeepEqual(m2, newM2()) {
+					t.Errorf("m2 = %#v, want intact", m2)
+				}
+				return
+			}
+			if !reflect.DeepEqual(m.Entries, tt.want) {
+				t.Errorf("m.Merge(m2) = %#v, want %#v", m.Entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSCMatrix_Merge_Duplicates(t *testing.T) {
+	// |1 0|.Merge(|0 0|) rejects the duplicate at row 1, column 0.
+	// |5 2|       |5 0|
+	m := &CSCMatrix{CSMatrix{
+		MajorDim: 2, MinorDim: 2, Entries: [][]Entry{{{0, 1}, {1, 5}}, {{1, 2}}},
+	}}
+	m2 := &CSCMatrix{CSMatrix{
+		MajorDim: 2, MinorDim: 2, Entries: [][]Entry{{{1, 5}}, nil},
+	}}
+	err := m.Merge(&m2.CSMatrix, spopt.RejectDuplicates)
+	want := DuplicateEntryError{Row: 1, Column: 0}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("m.Merge(m2) error = %v, want %v", err, want)
+	}
+}
+
+func TestNewCSRMatrixFromEntries_Duplicates(t *testing.T) {
+	entries := []CooEntry{
+		{1, 0, 2},
+		{0, 1, 3},
+		{1, 0, 5},
+		{0, 0, 7},
+		{1, 0, 1},
+		{0, 1, -3},
+	}
+	tests := []struct {
+		name    string
+		opts    []spopt.Option
+		want    [][]Entry
+		wantErr error
+	}{
+		{
+			name: "LastWins",
+			want: [][]Entry{{{0, 7}, {1, -3}}, {{0, 1}}},
+		},
+		{
+			name: "FirstWins",
+			opts: []spopt.Option{spopt.FirstWins},
+			want: [][]Entry{{{0, 7}, {1, 3}}, {{0, 2}}},
+		},
+		{
+			name: "Sum",
+			opts: []spopt.Option{spopt.SumDuplicates},
+			want: [][]Entry{{{0, 7}}, {{0, 8}}},
+		},
+		{
+			name: "SumIncludeZero",
+			opts: []spopt.Option{spopt.SumDuplicates, spopt.IncludeZero},
+			want: [][]Entry{{{0, 7}, {1, 0}}, {{0, 8}}},
+		},
+		{
+			name: "Max",
+			opts: []spopt.Option{spopt.MaxDuplicates},
+			want: [][]Entry{{{0, 7}, {1, 3}}, {{0, 5}}},
+		},
+		{
+			name:    "Reject",
+			opts:    []spopt.Option{spopt.RejectDuplicates},
+			wantErr: DuplicateEntryError{Row: 0, Column: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]spopt.Option{spopt.AllowNegative}, tt.opts...)
+			got, err := NewCSRMatrixFromEntries(context.Background(),
+				entries, opts...)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("NewCSRMatrixFromEntries() error = %v, want %v",
+					err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("NewCSRMatrixFromEntries() = %#v, want %#v",
+					got.Entries, tt.want)
+			}
+		})
+	}
+}
+
 func TestNewCSRMatrix(t *testing.T) {
 	type args struct {
 		rows, cols int
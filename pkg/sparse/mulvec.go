@@ -0,0 +1,155 @@
+package sparse
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// MulVecOpts contains options for the MulVec method.
+type MulVecOpts struct {
+	numWorkers int
+}
+
+// MulVecOpt is one MulVec option.
+type MulVecOpt func(*MulVecOpts)
+
+// WithNumWorkers tells MulVec to use (at most) n parallel workers.
+//
+// The default (or n <= 0) is runtime.GOMAXPROCS(0).
+func WithNumWorkers(n int) MulVecOpt {
+	return func(o *MulVecOpts) { o.numWorkers = n }
+}
+
+// chunksPerWorker is the number of row chunks per worker.
+// Workers claim chunks dynamically, so having more chunks than workers
+// evens out the load if row lengths are skewed.
+const chunksPerWorker = 4
+
+// minChunkSize is the minimum number of rows in a chunk,
+// below which the scheduling overhead would dominate.
+const minChunkSize = 256
+
+// denseBufs pools dense float64 buffers used as MulVec scratch space.
+var denseBufs = sync.Pool{New: func() any { return new([]float64) }}
+
+// getDenseBuf returns a zeroed dense buffer of the given length from the pool.
+// Return it with putDenseBuf after use.
+func getDenseBuf(n int) *[]float64 {
+	buf := denseBufs.Get().(*[]float64)
+	if cap(*buf) < n {
+		*buf = make([]float64, n)
+	} else {
+		*buf = (*buf)[:n]
+		clear(*buf)
+	}
+	return buf
+}
+
+func putDenseBuf(buf *[]float64) { denseBufs.Put(buf) }
+
+// MulVec stores m multiplied by v1 into the receiver.
+//
+// m is typically a *Matrix, a *CompactMatrix, or a *BlockFile.
+//
+// MulVec splits the rows of m into contiguous chunks,
+// which parallel workers claim and compute into a dense output buffer;
+// the output is then compacted into the sparse receiver.
+// v1 and the receiver may be the same vector.
+func (v *Vector) MulVec(
+	ctx context.Context, m Operator, v1 *Vector, opts ...MulVecOpt,
+) error {
+	o := MulVecOpts{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	dim, err := m.Dim()
+	if err != nil {
+		return err
+	}
+	if dim != v1.Dim {
+		return ErrDimensionMismatch
+	}
+
+	// Scatter v1 into a dense buffer (x), so that each row product
+	// is a single pass over the row's entries.
+	// This also decouples v1 from the receiver, should they be the same.
+	xBuf := getDenseBuf(dim)
+	defer putDenseBuf(xBuf)
+	x := *xBuf
+	for _, e := range v1.Entries {
+		x[e.Index] = e.Value
+	}
+	yBuf := getDenseBuf(dim)
+	defer putDenseBuf(yBuf)
+	y := *yBuf
+
+	numWorkers := o.numWorkers
+	if numWorkers <= 0 {
+		numWorkers = runtime.GOMAXPROCS(0)
+	}
+	chunkSize := max((dim+numWorkers*chunksPerWorker-1)/
+		(numWorkers*chunksPerWorker), minChunkSize)
+	numChunks := (dim + chunkSize - 1) / chunkSize
+	numWorkers = min(numWorkers, numChunks)
+
+	var nextChunk atomic.Int64
+	work := func() error {
+		for {
+			chunk := int(nextChunk.Add(1)) - 1
+			if chunk >= numChunks {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			begin := chunk * chunkSize
+			err := m.MulRows(y, x, begin, min(begin+chunkSize, dim))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if numWorkers <= 1 {
+		err = work()
+	} else {
+		errs := make([]error, numWorkers)
+		var wg sync.WaitGroup
+		wg.Add(numWorkers)
+		for workerIndex := 0; workerIndex < numWorkers; workerIndex++ {
+			go func(workerIndex int) {
+				defer wg.Done()
+				errs[workerIndex] = work()
+			}(workerIndex)
+		}
+		wg.Wait()
+		for _, err = range errs {
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Gather the dense output (y) into sparse entries.
+	nnz := 0
+	for _, value := range y {
+		if value != 0 {
+			nnz++
+		}
+	}
+	entries := make([]Entry, 0, nnz)
+	for index, value := range y {
+		if value != 0 {
+			entries = append(entries, Entry{Index: index, Value: value})
+		}
+	}
+	v.Dim = dim
+	v.Entries = entries
+	return nil
+}
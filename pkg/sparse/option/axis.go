@@ -3,22 +3,24 @@ package spopt
 import "k3l.io/go-eigentrust/pkg/peer"
 
 type Axis struct {
-	Name    string
-	PeerMap *peer.Map
-	Alloc   bool // allocate indices to accommodate ids not in PeerMap
-	Dim     int  // minimum (if grow) or fixed (if not grow) dimension
-	Grow    bool // whether dimension can increase to match incoming indices
+	Name     string
+	Position int // 0-based field position; if negative, located by Name
+	PeerMap  *peer.Map
+	Alloc    bool // allocate indices to accommodate ids not in PeerMap
+	Dim      int  // minimum (if grow) or fixed (if not grow) dimension
+	Grow     bool // whether dimension can increase to match incoming indices
 }
 
 // Reset resets all axis options to their defaults.
 //
-// - Axis name is "i".
+// - Axis name is "i", with no fixed field position.
 // - No PeerMap: Peer identifiers are parsed as integer literals.
 // - No minimum Dim, axis starts from zero dimension.
 // - Dim grows automatically to accommodate new indices.
 func (o *Axis) Reset() {
 	*o = Axis{}
 	AxisName("i")(o)
+	AxisPosition(-1)(o)
 	LiteralAxisIndices(o)
 	MinAxisDim(0)(o)
 }
@@ -28,6 +30,13 @@ func AxisName(name string) OptionForSet[Axis] {
 	return func(o *Axis) { o.Name = name }
 }
 
+// AxisPosition specifies the 0-based field position of the axis index
+// in delimited-text input, overriding the axis name.
+// Negative position locates the field by name (or default position).
+func AxisPosition(position int) OptionForSet[Axis] {
+	return func(o *Axis) { o.Position = position }
+}
+
 // LiteralAxisIndices causes peer identifiers to be parsed as integer indices.
 func LiteralAxisIndices(o *Axis) { o.PeerMap, o.Alloc = nil, false }
 
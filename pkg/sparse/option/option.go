@@ -17,6 +17,20 @@ func IndexNamed(name string) Option       { return func(o *Set) { AxisName(name)
 func RowIndexNamed(name string) Option    { return func(o *Set) { AxisName(name)(o.Row) } }
 func ColumnIndexNamed(name string) Option { return func(o *Set) { AxisName(name)(o.Column) } }
 func ValueNamed(name string) Option       { return func(o *Set) { ValueName(name)(o.Value) } }
+func TimeNamed(name string) Option        { return func(o *Set) { ValueTimeName(name)(o.Value) } }
+
+func IndexAt(position int) Option {
+	return func(o *Set) { AxisPosition(position)(o.Row) }
+}
+func RowIndexAt(position int) Option {
+	return func(o *Set) { AxisPosition(position)(o.Row) }
+}
+func ColumnIndexAt(position int) Option {
+	return func(o *Set) { AxisPosition(position)(o.Column) }
+}
+func ValueAt(position int) Option {
+	return func(o *Set) { ValuePositionSetTo(position)(o.Value) }
+}
 
 func LiteralIndices(o *Set)       { LiteralRowIndices(o); LiteralColumnIndices(o) }
 func LiteralRowIndices(o *Set)    { LiteralAxisIndices(o.Row) }
@@ -58,5 +72,33 @@ func AllowNegativeSetTo(allow bool) Option {
 func AllowNegative(o *Set)    { AllowNegativeValue(o.Value) }
 func DisallowNegative(o *Set) { DisallowNegativeValue(o.Value) }
 
+func DuplicatesSetTo(policy DuplicatePolicy) Option {
+	return func(o *Set) { DuplicatesValueSetTo(policy)(o.Value) }
+}
+func LastWins(o *Set)         { DuplicatesValueSetTo(DuplicateLastWins)(o.Value) }
+func FirstWins(o *Set)        { DuplicatesValueSetTo(DuplicateFirstWins)(o.Value) }
+func SumDuplicates(o *Set)    { DuplicatesValueSetTo(DuplicateSum)(o.Value) }
+func MaxDuplicates(o *Set)    { DuplicatesValueSetTo(DuplicateMax)(o.Value) }
+func RejectDuplicates(o *Set) { DuplicatesValueSetTo(DuplicateError)(o.Value) }
+
 func RowMajor(o *Set)    { o.ColumnMajor = false }
 func ColumnMajor(o *Set) { o.ColumnMajor = true }
+
+func CompactSetTo(compact bool) Option { return func(o *Set) { o.Compact = compact } }
+func Compact(o *Set)                   { o.Compact = true }
+
+func Delimiter(delimiter rune) Option {
+	return func(o *Set) { TextDelimiter(delimiter)(o.Text) }
+}
+func CSV(o *Set) { TextDelimiter(',')(o.Text) }
+func TSV(o *Set) { TextDelimiter('\t')(o.Text) }
+
+func CommentSetTo(comment rune) Option {
+	return func(o *Set) { TextCommentSetTo(comment)(o.Text) }
+}
+
+func HeaderSetTo(header bool) Option {
+	return func(o *Set) { TextHeaderSetTo(header)(o.Text) }
+}
+func Header(o *Set)   { TextHeaderSetTo(true)(o.Text) }
+func NoHeader(o *Set) { TextHeaderSetTo(false)(o.Text) }
@@ -10,7 +10,12 @@ type Set struct {
 	Row         *Axis // also for vectors
 	Column      *Axis
 	Value       *Value
+	Text        *Text
 	ColumnMajor bool
+
+	// Compact selects compact storage (32-bit indices and float32 values),
+	// for operations that support it.  See sparse.CompactMatrix.
+	Compact bool
 }
 
 // Reset resets all options to their defaults.
@@ -20,11 +25,17 @@ type Set struct {
 // - Dimensions have no minimum, and can grow to accommodate incoming indices.
 // - Negative entries are not allowed.
 // - Explicit zero entries are dropped (not included).
+// - Entry times are not read.
+// - Among duplicate entries at the same location, the last one wins.
+// - Full (int index and float64 value) storage is used, not compact.
+// - Delimited text is comma-separated, has a header, and has no comments.
+// - Fields are located by name, or by position (i, j, v) without a header.
 func (o *Set) Reset() {
-	*o = Set{Row: &Axis{}, Column: &Axis{}, Value: &Value{}}
+	*o = Set{Row: &Axis{}, Column: &Axis{}, Value: &Value{}, Text: &Text{}}
 	resetAndApply(o.Row)
 	resetAndApply(o.Column, AxisName("j"))
 	resetAndApply(o.Value)
+	resetAndApply(o.Text)
 }
 
 // RowColFromMajMin converts major-/minor-axis indices into row/column indices,
@@ -0,0 +1,43 @@
+package spopt
+
+// Text is the set of options that apply to delimited-text input,
+// such as CSV and TSV.
+type Text struct {
+	// Delimiter separates fields, e.g. ',' (CSV) or '\t' (TSV).
+	Delimiter rune
+
+	// Comment, if not 0, starts a comment line
+	// when found at the beginning of a line.
+	Comment rune
+
+	// Header tells whether the first record is a header naming the fields.
+	// Without a header, fields are located by position.
+	Header bool
+}
+
+// Reset resets all text options to their defaults.
+//
+// - Fields are comma-separated.
+// - No comment lines.
+// - The first record is a header.
+func (o *Text) Reset() {
+	*o = Text{}
+	TextDelimiter(',')(o)
+	TextCommentSetTo(0)(o)
+	TextHeaderSetTo(true)(o)
+}
+
+// TextDelimiter sets the field delimiter.
+func TextDelimiter(delimiter rune) OptionForSet[Text] {
+	return func(o *Text) { o.Delimiter = delimiter }
+}
+
+// TextCommentSetTo sets the comment character; 0 disables comments.
+func TextCommentSetTo(comment rune) OptionForSet[Text] {
+	return func(o *Text) { o.Comment = comment }
+}
+
+// TextHeaderSetTo sets whether the first record is a header.
+func TextHeaderSetTo(header bool) OptionForSet[Text] {
+	return func(o *Text) { o.Header = header }
+}
@@ -3,21 +3,43 @@ package spopt
 // Value is the set of options that apply to entry values.
 type Value struct {
 	Name          string
+	Position      int // 0-based field position; if negative, located by Name
 	AllowNegative bool
 	IncludeZero   bool
+	Duplicates    DuplicatePolicy
+
+	// TimeName names the optional entry time (Unix seconds) field,
+	// read alongside the value; empty means no time field.
+	TimeName string
 }
 
 func (o *Value) Reset() {
 	*o = Value{}
 	ValueName("v")(o)
+	ValuePositionSetTo(-1)(o)
 	DisallowNegativeValue(o)
 	ExcludeZeroValue(o)
+	DuplicatesValueSetTo(DuplicateLastWins)(o)
+	ValueTimeName("")(o)
 }
 
 func ValueName(name string) OptionForSet[Value] {
 	return func(o *Value) { o.Name = name }
 }
 
+// ValuePositionSetTo specifies the 0-based field position of the value
+// in delimited-text input, overriding the value name.
+// Negative position locates the field by name (or default position).
+func ValuePositionSetTo(position int) OptionForSet[Value] {
+	return func(o *Value) { o.Position = position }
+}
+
+// ValueTimeName names the optional entry time field;
+// empty name means no time field.
+func ValueTimeName(name string) OptionForSet[Value] {
+	return func(o *Value) { o.TimeName = name }
+}
+
 func AllowNegativeValueSetTo(allow bool) OptionForSet[Value] {
 	return func(o *Value) { o.AllowNegative = allow }
 }
@@ -26,7 +48,69 @@ func IncludeZeroValueSetTo(include bool) OptionForSet[Value] {
 	return func(o *Value) { o.IncludeZero = include }
 }
 
+// DuplicatesValueSetTo sets the policy for entries at the same location.
+func DuplicatesValueSetTo(policy DuplicatePolicy) OptionForSet[Value] {
+	return func(o *Value) { o.Duplicates = policy }
+}
+
 func AllowNegativeValue(o *Value)    { o.AllowNegative = true }
 func DisallowNegativeValue(o *Value) { o.AllowNegative = false }
 func IncludeZeroValue(o *Value)      { o.IncludeZero = true }
 func ExcludeZeroValue(o *Value)      { o.IncludeZero = false }
+
+// DuplicatePolicy decides what happens to multiple entries
+// found at the same location (vector index or matrix row/column).
+type DuplicatePolicy int
+
+const (
+	// DuplicateLastWins keeps the last entry seen, dropping earlier ones.
+	DuplicateLastWins DuplicatePolicy = iota
+
+	// DuplicateFirstWins keeps the first entry seen, dropping later ones.
+	DuplicateFirstWins
+
+	// DuplicateSum replaces duplicate entries with their sum.
+	DuplicateSum
+
+	// DuplicateMax replaces duplicate entries with the largest one.
+	DuplicateMax
+
+	// DuplicateError treats duplicate entries as an error.
+	DuplicateError
+)
+
+// Combine combines the value of a duplicate entry (v2) seen after another
+// entry (v1) at the same location and returns the resulting value.
+//
+// ok is false if the policy disallows duplicates (DuplicateError).
+func (p DuplicatePolicy) Combine(v1, v2 float64) (v float64, ok bool) {
+	switch p {
+	case DuplicateFirstWins:
+		return v1, true
+	case DuplicateSum:
+		return v1 + v2, true
+	case DuplicateMax:
+		return max(v1, v2), true
+	case DuplicateError:
+		return 0, false
+	default:
+		return v2, true
+	}
+}
+
+func (p DuplicatePolicy) String() string {
+	switch p {
+	case DuplicateLastWins:
+		return "last"
+	case DuplicateFirstWins:
+		return "first"
+	case DuplicateSum:
+		return "sum"
+	case DuplicateMax:
+		return "max"
+	case DuplicateError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
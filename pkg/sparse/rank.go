@@ -0,0 +1,306 @@
+package sparse
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Ranking.
+//
+// Elements rank in descending order of value;
+// ties rank in ascending order of index.
+// Implicit (unstored) elements have zero value.
+
+// ranksAbove returns whether e1 ranks above (before) e2.
+func ranksAbove(e1, e2 Entry) bool {
+	return e1.Value > e2.Value || (e1.Value == e2.Value && e1.Index < e2.Index)
+}
+
+// compareRank compares e1 and e2 by rank, for use with slices.SortFunc.
+func compareRank(e1, e2 Entry) int {
+	switch {
+	case ranksAbove(e1, e2):
+		return -1
+	case ranksAbove(e2, e1):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// bottomHeap is a min-heap of entries by rank,
+// i.e. the lowest-ranking entry sits at the root.
+type bottomHeap []Entry
+
+func (h bottomHeap) Len() int           { return len(h) }
+func (h bottomHeap) Less(i, j int) bool { return ranksAbove(h[j], h[i]) }
+func (h bottomHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *bottomHeap) Push(x any)        { *h = append(*h, x.(Entry)) }
+func (h *bottomHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// TopKSelector selects the k top-ranking entries from a stream of entries,
+// using O(k) memory and O(log k) time per entry.
+//
+// The zero value is not usable; create one with NewTopKSelector.
+type TopKSelector struct {
+	k int
+	h bottomHeap
+}
+
+// NewTopKSelector returns a new selector of k top-ranking entries.
+func NewTopKSelector(k int) *TopKSelector {
+	return &TopKSelector{k: k, h: make(bottomHeap, 0, max(k, 0))}
+}
+
+// Add offers the given entry.
+func (s *TopKSelector) Add(e Entry) {
+	switch {
+	case s.k <= 0:
+	case len(s.h) < s.k:
+		heap.Push(&s.h, e)
+	case ranksAbove(e, s.h[0]):
+		s.h[0] = e
+		heap.Fix(&s.h, 0)
+	}
+}
+
+// Entries returns the top-ranking entries offered so far, in ranking order.
+func (s *TopKSelector) Entries() []Entry {
+	entries := slices.Clone(s.h)
+	slices.SortFunc(entries, compareRank)
+	return entries
+}
+
+// TopK returns the k top-ranking (stored) entries of the receiver,
+// in ranking order.
+//
+// If k is not positive or not less than the number of stored entries,
+// TopK returns all stored entries, sorted.
+func (v *Vector) TopK(k int) []Entry {
+	if k <= 0 || k >= len(v.Entries) {
+		entries := slices.Clone(v.Entries)
+		slices.SortFunc(entries, compareRank)
+		return entries
+	}
+	s := NewTopKSelector(k)
+	for _, e := range v.Entries {
+		s.Add(e)
+	}
+	return s.Entries()
+}
+
+// valueAt returns the value of the element at the given index,
+// along with the number of stored entries before it.
+func (v *Vector) valueAt(index int) (value float64, pos int) {
+	pos = sort.Search(len(v.Entries), func(i int) bool {
+		return v.Entries[i].Index >= index
+	})
+	if pos < len(v.Entries) && v.Entries[pos].Index == index {
+		value = v.Entries[pos].Value
+	}
+	return
+}
+
+// RankOf returns the 0-based rank of the element at the given index
+// among all elements of the receiver, including implicit zeros.
+func (v *Vector) RankOf(index int) int {
+	value, pos := v.valueAt(index)
+	e := Entry{Index: index, Value: value}
+	rank := 0
+	for _, e2 := range v.Entries {
+		if ranksAbove(e2, e) {
+			rank++
+		}
+	}
+	zeros := v.Dim - len(v.Entries)
+	switch {
+	case value < 0:
+		rank += zeros
+	case value == 0:
+		// Implicit zeros before index rank above.
+		rank += index - pos
+	}
+	return rank
+}
+
+// PercentileRank returns the percentile rank, between 0 and 100,
+// of the element at the given index among all elements of the receiver,
+// including implicit zeros.
+//
+// It is the percentage of elements with a lower value,
+// counting elements with the same value (including itself) as half.
+func (v *Vector) PercentileRank(index int) float64 {
+	if v.Dim == 0 {
+		return math.NaN()
+	}
+	value, _ := v.valueAt(index)
+	below, same := 0, 0
+	for _, e := range v.Entries {
+		switch {
+		case e.Value < value:
+			below++
+		case e.Value == value:
+			same++
+		}
+	}
+	zeros := v.Dim - len(v.Entries)
+	switch {
+	case 0 < value:
+		below += zeros
+	case 0 == value:
+		same += zeros
+	}
+	return 100 * (float64(below) + float64(same)/2) / float64(v.Dim)
+}
+
+// dense returns the receiver's elements in a dense slice.
+func (v *Vector) dense() []float64 {
+	x := make([]float64, v.Dim)
+	for _, e := range v.Entries {
+		x[e.Index] = e.Value
+	}
+	return x
+}
+
+// KendallTau returns the Kendall rank correlation coefficient (tau-b)
+// between v1 and v2, over all elements including implicit zeros.
+//
+// It returns NaN if either vector has all elements equal.
+// It runs in O(n log n) time for dimension n (Knight's algorithm).
+func KendallTau(v1, v2 *Vector) (float64, error) {
+	if v1.Dim != v2.Dim {
+		return 0, ErrDimensionMismatch
+	}
+	n := v1.Dim
+	x, y := v1.dense(), v2.dense()
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	// Sort by (x, y), counting pairs tied in x (n1) and in both (n3).
+	slices.SortFunc(perm, func(i, j int) int {
+		if c := cmpFloat(x[i], x[j]); c != 0 {
+			return c
+		}
+		return cmpFloat(y[i], y[j])
+	})
+	n1 := tiedPairs(n, func(i, j int) bool { return x[perm[i]] == x[perm[j]] })
+	n3 := tiedPairs(n, func(i, j int) bool {
+		return x[perm[i]] == x[perm[j]] && y[perm[i]] == y[perm[j]]
+	})
+	// Merge-sort by y; each swap is a discordant pair.
+	ys := make([]float64, n)
+	for i, p := range perm {
+		ys[i] = y[p]
+	}
+	swaps := mergeSortCountingSwaps(ys, make([]float64, n))
+	n2 := tiedPairs(n, func(i, j int) bool { return ys[i] == ys[j] })
+	n0 := n * (n - 1) / 2
+	numerator := float64(n0 - n1 - n2 + n3 - 2*swaps)
+	return numerator / math.Sqrt(float64(n0-n1)*float64(n0-n2)), nil
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// tiedPairs counts tied pairs among n sorted elements,
+// where tied(i, i+1) tells whether adjacent elements are tied.
+func tiedPairs(n int, tied func(i, j int) bool) int {
+	pairs, run := 0, 1
+	for i := 1; i <= n; i++ {
+		if i < n && tied(i-1, i) {
+			run++
+			continue
+		}
+		pairs += run * (run - 1) / 2
+		run = 1
+	}
+	return pairs
+}
+
+// mergeSortCountingSwaps sorts a in place (using buf as scratch)
+// and returns the number of inversions, i.e. pairs out of order.
+func mergeSortCountingSwaps(a, buf []float64) int {
+	if len(a) < 2 {
+		return 0
+	}
+	mid := len(a) / 2
+	swaps := mergeSortCountingSwaps(a[:mid], buf[:mid]) +
+		mergeSortCountingSwaps(a[mid:], buf[mid:])
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(a) {
+		if a[j] < a[i] {
+			swaps += mid - i
+			buf[k] = a[j]
+			j++
+		} else {
+			buf[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(buf[k:], a[i:mid])
+	copy(buf[k:], a[j:])
+	copy(a, buf)
+	return swaps
+}
+
+// Spearman returns the Spearman rank correlation coefficient
+// between v1 and v2, over all elements including implicit zeros.
+// Tied elements get the average of their ranks.
+//
+// It returns NaN if either vector has all elements equal.
+func Spearman(v1, v2 *Vector) (float64, error) {
+	if v1.Dim != v2.Dim {
+		return 0, ErrDimensionMismatch
+	}
+	r1, r2 := fractionalRanks(v1.dense()), fractionalRanks(v2.dense())
+	// Pearson correlation of the ranks; both have the same mean.
+	mean := float64(len(r1)-1) / 2
+	var cov, var1, var2 KBNSummer
+	for i := range r1 {
+		d1, d2 := r1[i]-mean, r2[i]-mean
+		cov.Add(d1 * d2)
+		var1.Add(d1 * d1)
+		var2.Add(d2 * d2)
+	}
+	return cov.Sum() / math.Sqrt(var1.Sum()*var2.Sum()), nil
+}
+
+// fractionalRanks returns the 0-based ranks of x in ascending order,
+// with tied elements getting the average of their ranks.
+func fractionalRanks(x []float64) []float64 {
+	perm := make([]int, len(x))
+	for i := range perm {
+		perm[i] = i
+	}
+	slices.SortFunc(perm, func(i, j int) int { return cmpFloat(x[i], x[j]) })
+	ranks := make([]float64, len(x))
+	for begin := 0; begin < len(perm); {
+		end := begin + 1
+		for end < len(perm) && x[perm[end]] == x[perm[begin]] {
+			end++
+		}
+		rank := float64(begin+end-1) / 2
+		for _, i := range perm[begin:end] {
+			ranks[i] = rank
+		}
+		begin = end
+	}
+	return ranks
+}
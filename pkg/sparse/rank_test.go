@@ -0,0 +1,203 @@
+package sparse
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestVector_TopK(t *testing.T) {
+	v := NewVector(10, []Entry{
+		{0, 0.5}, {2, 3}, {3, -1}, {5, 3}, {6, 2}, {9, 0.25},
+	})
+	tests := []struct {
+		name string
+		k    int
+		want []Entry
+	}{
+		{"Zero", 0, []Entry{{2, 3}, {5, 3}, {6, 2}, {0, 0.5}, {9, 0.25}, {3, -1}}},
+		{"One", 1, []Entry{{2, 3}}},
+		{"Tie", 2, []Entry{{2, 3}, {5, 3}}},
+		{"Three", 3, []Entry{{2, 3}, {5, 3}, {6, 2}}},
+		{"All", 6, []Entry{{2, 3}, {5, 3}, {6, 2}, {0, 0.5}, {9, 0.25}, {3, -1}}},
+		{"More", 100, []Entry{{2, 3}, {5, 3}, {6, 2}, {0, 0.5}, {9, 0.25}, {3, -1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := v.TopK(tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopKSelector(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	entries := make([]Entry, 10000)
+	for i := range entries {
+		entries[i] = Entry{Index: i, Value: float64(rng.Intn(100))}
+	}
+	s := NewTopKSelector(50)
+	for _, i := range rng.Perm(len(entries)) {
+		s.Add(entries[i])
+	}
+	want := slices.Clone(entries)
+	slices.SortFunc(want, compareRank)
+	if got := s.Entries(); !reflect.DeepEqual(got, want[:50]) {
+		t.Errorf("Entries() = %v, want %v", got, want[:50])
+	}
+}
+
+func TestVector_RankOf(t *testing.T) {
+	// Dense: 0.5, 0, 3, -1, 0, 3, 2, 0
+	v := NewVector(8, []Entry{{0, 0.5}, {2, 3}, {3, -1}, {5, 3}, {6, 2}})
+	// Ranking: 2, 5, 6, 0, 1, 4, 7, 3
+	want := []int{3, 4, 0, 7, 5, 1, 2, 6}
+	for index, rank := range want {
+		if got := v.RankOf(index); got != rank {
+			t.Errorf("RankOf(%d) = %v, want %v", index, got, rank)
+		}
+	}
+}
+
+func TestVector_PercentileRank(t *testing.T) {
+	// Dense: 0.5, 0, 3, -1, 0, 3, 2, 0
+	v := NewVector(8, []Entry{{0, 0.5}, {2, 3}, {3, -1}, {5, 3}, {6, 2}})
+	want := []float64{
+		100 * 4.5 / 8, 100 * 2.5 / 8, 100 * 7.0 / 8, 100 * 0.5 / 8,
+		100 * 2.5 / 8, 100 * 7.0 / 8, 100 * 5.5 / 8, 100 * 2.5 / 8,
+	}
+	for index, percentile := range want {
+		if got := v.PercentileRank(index); got != percentile {
+			t.Errorf("PercentileRank(%d) = %v, want %v",
+				index, got, percentile)
+		}
+	}
+}
+
+func TestKendallTau(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 *Vector
+		want   float64
+	}{
+		{
+			"Identical",
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 3}, {3, 4}}),
+			NewVector(4, []Entry{{0, 10}, {1, 20}, {2, 30}, {3, 40}}),
+			1,
+		},
+		{
+			"Reversed",
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 3}, {3, 4}}),
+			NewVector(4, []Entry{{0, 4}, {1, 3}, {2, 2}, {3, 1}}),
+			-1,
+		},
+		{
+			// concordant 4, discordant 1, x ties 1, y ties 0 of 6 pairs
+			"Ties",
+			NewVector(4, []Entry{{0, 1}, {1, 1}, {2, 2}, {3, 3}}),
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 4}, {3, 3}}),
+			3 / math.Sqrt(5*6),
+		},
+		{
+			"ImplicitZeros",
+			NewVector(3, []Entry{{2, 1}}),
+			NewVector(3, []Entry{{0, 1}}),
+			-0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KendallTau(tt.v1, tt.v2)
+			if err != nil {
+				t.Fatalf("KendallTau() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("KendallTau() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKendallTau_BruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	const n = 200
+	x, y := make([]float64, n), make([]float64, n)
+	var e1, e2 []Entry
+	for i := 0; i < n; i++ {
+		x[i], y[i] = float64(rng.Intn(10)), float64(rng.Intn(10))
+		e1 = append(e1, Entry{i, x[i]})
+		e2 = append(e2, Entry{i, y[i]})
+	}
+	var concordant, discordant, tiesX, tiesY float64
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			dx, dy := x[i]-x[j], y[i]-y[j]
+			switch {
+			case dx == 0 && dy == 0:
+			case dx == 0:
+				tiesX++
+			case dy == 0:
+				tiesY++
+			case dx*dy > 0:
+				concordant++
+			default:
+				discordant++
+			}
+		}
+	}
+	want := (concordant - discordant) /
+		math.Sqrt((concordant+discordant+tiesX)*(concordant+discordant+tiesY))
+	got, err := KendallTau(NewVector(n, e1), NewVector(n, e2))
+	if err != nil {
+		t.Fatalf("KendallTau() error = %v", err)
+	}
+	if math.Abs(got-want) > 1e-12 {
+		t.Errorf("KendallTau() = %v, want %v", got, want)
+	}
+}
+
+func TestSpearman(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 *Vector
+		want   float64
+	}{
+		{
+			"Monotonic",
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 3}, {3, 4}}),
+			NewVector(4, []Entry{{0, 1}, {1, 4}, {2, 9}, {3, 16}}),
+			1,
+		},
+		{
+			"Reversed",
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 3}, {3, 4}}),
+			NewVector(4, []Entry{{0, 4}, {1, 3}, {2, 2}, {3, 1}}),
+			-1,
+		},
+		{
+			// ranks (0.5, 0.5, 2, 3) vs (0, 1, 3, 2)
+			"Ties",
+			NewVector(4, []Entry{{0, 1}, {1, 1}, {2, 2}, {3, 3}}),
+			NewVector(4, []Entry{{0, 1}, {1, 2}, {2, 4}, {3, 3}}),
+			3.5 / math.Sqrt(4.5*5),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Spearman(tt.v1, tt.v2)
+			if err != nil {
+				t.Fatalf("Spearman() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("Spearman() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := Spearman(NewVector(2, nil), NewVector(3, nil)); err != ErrDimensionMismatch {
+		t.Errorf("Spearman() error = %v, want %v", err, ErrDimensionMismatch)
+	}
+}
@@ -0,0 +1,131 @@
+package sparse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// checkRemap checks that the given index mapping is injective
+// into [0, dim), with -1 denoting dropped indices.
+func checkRemap(remap []int, dim int) error {
+	seen := make([]bool, dim)
+	for from, to := range remap {
+		switch {
+		case to == -1:
+		case to < -1 || to >= dim:
+			return fmt.Errorf("index %d remapped out of range: %d (dim %d)",
+				from, to, dim)
+		case seen[to]:
+			return DuplicateIndexError{Index: to}
+		default:
+			seen[to] = true
+		}
+	}
+	return nil
+}
+
+// remapIndex returns the new index for the given one,
+// or -1 if dropped (including indices beyond remap).
+func remapIndex(remap []int, index int) int {
+	if index < len(remap) {
+		return remap[index]
+	}
+	return -1
+}
+
+// Remap renumbers the receiver's entries using the given old-to-new index
+// mapping, such as one returned by peer.Map.Compact,
+// and sets the receiver's dimension to dim.
+// Entries whose indices are mapped to -1 (or are beyond remap) are dropped.
+func (v *Vector) Remap(remap []int, dim int) error {
+	if err := checkRemap(remap, dim); err != nil {
+		return err
+	}
+	entries := make([]Entry, 0, len(v.Entries))
+	for _, e := range v.Entries {
+		if index := remapIndex(remap, e.Index); index != -1 {
+			entries = append(entries, Entry{Index: index, Value: e.Value})
+		}
+	}
+	v.Entries = SortEntriesByIndex(entries)
+	v.Dim = dim
+	return nil
+}
+
+// MarkUsed sets used[i] to true for every index i with an entry.
+// used must be long enough.
+func (v *Vector) MarkUsed(used []bool) {
+	for _, e := range v.Entries {
+		used[e.Index] = true
+	}
+}
+
+// Remap renumbers the receiver's entries using the given old-to-new index
+// mappings for the major and minor axes,
+// and sets the receiver's dimensions to majorDim and minorDim.
+// Entries whose indices are mapped to -1 (or are beyond remap) are dropped.
+func (m *CSMatrix) Remap(
+	ctx context.Context,
+	majorRemap []int, majorDim int, minorRemap []int, minorDim int,
+) error {
+	if err := checkRemap(majorRemap, majorDim); err != nil {
+		return fmt.Errorf("major axis: %w", err)
+	}
+	if err := checkRemap(minorRemap, minorDim); err != nil {
+		return fmt.Errorf("minor axis: %w", err)
+	}
+	entries := make([][]Entry, majorDim)
+	for major, span := range m.Entries {
+		if err := checkCtx(ctx); err != nil {
+			return err
+		}
+		newMajor := remapIndex(majorRemap, major)
+		if newMajor == -1 {
+			continue
+		}
+		var newSpan []Entry
+		for _, e := range span {
+			if minor := remapIndex(minorRemap, e.Index); minor != -1 {
+				newSpan = append(newSpan, Entry{Index: minor, Value: e.Value})
+			}
+		}
+		slices.SortFunc(newSpan, func(a, b Entry) int { return a.Index - b.Index })
+		entries[newMajor] = newSpan
+	}
+	m.setEntries(majorDim, minorDim, entries)
+	return nil
+}
+
+// MarkUsed sets used[i] to true for every major or minor index i
+// with an entry, i.e. every peer that trusts or is trusted
+// if the receiver is a local trust matrix.
+// used must be long enough for both axes.
+func (m *CSMatrix) MarkUsed(used []bool) {
+	for major, span := range m.Entries {
+		if len(span) != 0 {
+			used[major] = true
+		}
+		for _, e := range span {
+			used[e.Index] = true
+		}
+	}
+}
+
+// Remap renumbers both rows and columns of the receiver
+// using the given old-to-new index mapping,
+// such as one returned by peer.Map.Compact,
+// and sets the receiver's dimension to dim x dim.
+// Entries whose row or column is mapped to -1 (or beyond remap) are dropped.
+func (m *CSRMatrix) Remap(ctx context.Context, remap []int, dim int) error {
+	return m.CSMatrix.Remap(ctx, remap, dim, remap, dim)
+}
+
+// Remap renumbers both rows and columns of the receiver
+// using the given old-to-new index mapping,
+// such as one returned by peer.Map.Compact,
+// and sets the receiver's dimension to dim x dim.
+// Entries whose row or column is mapped to -1 (or beyond remap) are dropped.
+func (m *CSCMatrix) Remap(ctx context.Context, remap []int, dim int) error {
+	return m.CSMatrix.Remap(ctx, remap, dim, remap, dim)
+}
@@ -0,0 +1,107 @@
+package sparse
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestVector_Remap(t *testing.T) {
+	tests := []struct {
+		name    string
+		remap   []int
+		dim     int
+		want    []Entry
+		wantErr bool
+	}{
+		{
+			name:  "Compact",
+			remap: []int{0, -1, 1, 2},
+			dim:   3,
+			want:  []Entry{{0, 1}, {2, 3}},
+		},
+		{
+			name:  "Permute",
+			remap: []int{3, 2, 1, 0},
+			dim:   4,
+			want:  []Entry{{0, 3}, {3, 1}},
+		},
+		{
+			name:  "Short",
+			remap: []int{0},
+			dim:   1,
+			want:  []Entry{{0, 1}},
+		},
+		{
+			name:    "Duplicate",
+			remap:   []int{0, 0, 1, 1},
+			dim:     2,
+			wantErr: true,
+		},
+		{
+			name:    "OutOfRange",
+			remap:   []int{0, 1, 2, 3},
+			dim:     3,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVector(4, []Entry{{0, 1}, {3, 3}})
+			err := v.Remap(tt.remap, tt.dim)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Remap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if v.Dim != tt.dim || !reflect.DeepEqual(v.Entries, tt.want) {
+				t.Errorf("Remap() = %v %v, want %v %v",
+					v.Dim, v.Entries, tt.dim, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSRMatrix_Remap(t *testing.T) {
+	m := denseCSR(4,
+		[]float64{0, 1, 0, 2},
+		[]float64{0, 0, 0, 0},
+		[]float64{3, 0, 0, 4},
+		[]float64{0, 5, 0, 0},
+	)
+	used := make([]bool, 4)
+	m.MarkUsed(used)
+	if want := []bool{true, true, true, true}; !reflect.DeepEqual(used, want) {
+		t.Errorf("MarkUsed() = %v, want %v", used, want)
+	}
+	// Drop peer 1, reverse the rest.
+	err := m.Remap(context.Background(), []int{2, -1, 1, 0}, 3)
+	if err != nil {
+		t.Fatalf("Remap() error = %v", err)
+	}
+	want := denseCSR(3,
+		[]float64{0, 0, 0},
+		[]float64{0, 0, 0},
+		[]float64{0, 0, 0},
+	)
+	want.Entries[0] = nil
+	want.Entries[1] = []Entry{{0, 4}, {2, 3}}
+	want.Entries[2] = []Entry{{0, 2}}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("Remap() = %v, want %v", m, want)
+	}
+	err = m.Remap(context.Background(), []int{0, 0, 1}, 2)
+	if !errors.As(err, &DuplicateIndexError{}) {
+		t.Errorf("Remap() error = %v, want DuplicateIndexError", err)
+	}
+}
+
+func TestVector_MarkUsed(t *testing.T) {
+	used := make([]bool, 4)
+	NewVector(4, []Entry{{1, 1}, {3, 1}}).MarkUsed(used)
+	if want := []bool{false, true, false, true}; !reflect.DeepEqual(used, want) {
+		t.Errorf("MarkUsed() = %v, want %v", used, want)
+	}
+}
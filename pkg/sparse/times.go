@@ -0,0 +1,197 @@
+package sparse
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+	"k3l.io/go-eigentrust/pkg/util"
+)
+
+// parseEntryTime parses an entry time, in Unix seconds.
+func parseEntryTime(literal string) (float64, error) {
+	t, err := strconv.ParseFloat(literal, 64)
+	switch {
+	case err != nil:
+		return 0, err
+	case math.IsNaN(t) || math.IsInf(t, 0):
+		return 0, NonFiniteValueError{t}
+	case t < 0:
+		return 0, NegativeValueError{t}
+	}
+	return t, nil
+}
+
+// entryTime is the time of a matrix entry read from input,
+// along with the entry itself.
+type entryTime struct {
+	CooEntry
+	Time float64
+}
+
+// newCSMatrixWithTimes creates a new compressed sparse matrix
+// with the entries sent by send,
+// which also appends the time of each entry into the given slice.
+//
+// Among duplicate entries, the time kept is that of the entry
+// whose value is kept (see keptEntryTimes).
+func newCSMatrixWithTimes(
+	ctx context.Context,
+	send func(ctx context.Context, ch chan<- CooEntry, times *[]entryTime) error,
+	opts ...spopt.Option,
+) (m, times *CSMatrix, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(chan CooEntry)
+	sendErr := make(chan error, 1)
+	var timeEntries []entryTime
+	go func() {
+		defer close(ch)
+		defer close(sendErr)
+		sendErr <- send(ctx, ch, &timeEntries)
+	}()
+	m, err = NewCSMatrixFromEntryCh(ctx, ch, opts...)
+	if err == nil {
+		err = util.ErrFromCh(ctx, sendErr)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	hasTime := func(e entryTime) bool { return e.Time != 0 }
+	if !slices.ContainsFunc(timeEntries, hasTime) {
+		return m, nil, nil
+	}
+	policy := spopt.New(opts...).Value.Duplicates
+	// Entries without time get zero (none),
+	// the same way as inline trust matrix entries.
+	opts = append(opts, spopt.IncludeZero, spopt.LastWins)
+	times, err = NewCSMatrixFromEntries(ctx,
+		keptEntryTimes(timeEntries, policy), opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	times.SetMajorDim(m.MajorDim)
+	times.SetMinorDim(m.MinorDim)
+	return m, times, nil
+}
+
+// keptEntryTimes returns the times of the given entries as entries,
+// such that under DuplicateLastWins, the time that wins at each location
+// is that of the entry whose value the given duplicate policy keeps:
+// the first entry under DuplicateFirstWins,
+// the (first) largest entry under DuplicateMax,
+// and the last entry otherwise, e.g. under DuplicateSum.
+func keptEntryTimes(
+	entries []entryTime, policy spopt.DuplicatePolicy,
+) []CooEntry {
+	timeEntry := func(e entryTime) CooEntry {
+		return CooEntry{Row: e.Row, Column: e.Column, Value: e.Time}
+	}
+	switch policy {
+	case spopt.DuplicateFirstWins, spopt.DuplicateMax:
+	default:
+		return util.Map(entries, timeEntry)
+	}
+	order := make([]int, len(entries))
+	for k := range order {
+		order[k] = k
+	}
+	location := func(k int) [2]int {
+		return [2]int{entries[k].Row, entries[k].Column}
+	}
+	slices.SortStableFunc(order, func(k1, k2 int) int {
+		l1, l2 := location(k1), location(k2)
+		if l1[0] != l2[0] {
+			return cmp.Compare(l1[0], l2[0])
+		}
+		return cmp.Compare(l1[1], l2[1])
+	})
+	times := make([]CooEntry, 0, len(entries))
+	for len(order) != 0 {
+		n := 1
+		for n < len(order) && location(order[n]) == location(order[0]) {
+			n++
+		}
+		kept := order[0]
+		if policy == spopt.DuplicateMax {
+			for _, k := range order[1:n] {
+				if entries[k].Value > entries[kept].Value {
+					kept = k
+				}
+			}
+		}
+		times = append(times, timeEntry(entries[kept]))
+		order = order[n:]
+	}
+	return times
+}
+
+// NewCSMatrixWithTimesFromCSV is NewCSMatrixFromCSV
+// that also reads the time (Unix seconds) of each entry
+// from the time field (spopt.TimeNamed, default "t") if the header has it,
+// and returns the entry times as another matrix, nil if no entry has time.
+// An empty time field means no time (zero).
+// Among duplicate entries, the time is that of the entry whose value
+// the duplicate policy keeps (the last one under spopt.SumDuplicates).
+func NewCSMatrixWithTimesFromCSV(
+	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+) (m, times *CSMatrix, err error) {
+	opts = append([]spopt.Option{spopt.TimeNamed("t")}, opts...)
+	return newCSMatrixWithTimes(ctx, func(
+		ctx context.Context, ch chan<- CooEntry, times *[]entryTime,
+	) error {
+		return sendCooEntriesFromCSV(ctx, r, ch, times, opts...)
+	}, opts...)
+}
+
+// NewCSMatrixWithTimesFromJSONL is NewCSMatrixFromJSONL
+// that also reads the time (Unix seconds) of each entry
+// from the time member (spopt.TimeNamed, default "t"),
+// and returns the entry times as another matrix, nil if no entry has time.
+// A missing, empty, or null time member means no time (zero).
+// Among duplicate entries, the time is that of the entry whose value
+// the duplicate policy keeps (the last one under spopt.SumDuplicates).
+func NewCSMatrixWithTimesFromJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) (m, times *CSMatrix, err error) {
+	opts = append([]spopt.Option{spopt.TimeNamed("t")}, opts...)
+	return newCSMatrixWithTimes(ctx, func(
+		ctx context.Context, ch chan<- CooEntry, times *[]entryTime,
+	) error {
+		return sendCooEntriesFromJSONL(ctx, r, ch, times, opts...)
+	}, opts...)
+}
+
+func cs2csrWithTimes(
+	m, times *CSMatrix, err error,
+) (*CSRMatrix, *CSRMatrix, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+	if times == nil {
+		return &CSRMatrix{CSMatrix: *m}, nil, nil
+	}
+	return &CSRMatrix{CSMatrix: *m}, &CSRMatrix{CSMatrix: *times}, nil
+}
+
+// NewCSRMatrixWithTimesFromCSV is NewCSMatrixWithTimesFromCSV
+// for compressed sparse row matrices.
+func NewCSRMatrixWithTimesFromCSV(
+	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
+) (m, times *CSRMatrix, err error) {
+	opts = append(opts, spopt.RowMajor)
+	return cs2csrWithTimes(NewCSMatrixWithTimesFromCSV(ctx, r, opts...))
+}
+
+// NewCSRMatrixWithTimesFromJSONL is NewCSMatrixWithTimesFromJSONL
+// for compressed sparse row matrices.
+func NewCSRMatrixWithTimesFromJSONL(
+	ctx context.Context, r io.Reader, opts ...spopt.Option,
+) (m, times *CSRMatrix, err error) {
+	opts = append(opts, spopt.RowMajor)
+	return cs2csrWithTimes(NewCSMatrixWithTimesFromJSONL(ctx, r, opts...))
+}
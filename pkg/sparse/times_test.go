@@ -0,0 +1,123 @@
+package sparse
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+func TestNewCSRMatrixWithTimes(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		jsonl     string
+		wantTimes [][]Entry // nil if no times
+		wantErr   error
+	}{
+		{
+			name: "Times",
+			csv:  "i,j,v,t\n0,1,1,100\n1,0,2,\n1,2,3,300\n",
+			jsonl: `{"i":0,"j":1,"v":1,"t":100}` + "\n" +
+				`{"i":1,"j":0,"v":2,"t":null}` + "\n" +
+				`{"i":1,"j":2,"v":3,"t":"300"}` + "\n",
+			wantTimes: [][]Entry{
+				{{1, 100}},
+				{{0, 0}, {2, 300}},
+			},
+		},
+		{
+			name: "NoTimes",
+			csv:  "i,j,v\n0,1,1\n1,0,2\n1,2,3\n",
+			jsonl: `{"i":0,"j":1,"v":1}` + "\n" +
+				`{"i":1,"j":0,"v":2}` + "\n" +
+				`{"i":1,"j":2,"v":3}` + "\n",
+		},
+		{
+			name:    "NegativeTime",
+			csv:     "i,j,v,t\n0,1,1,-1\n",
+			jsonl:   `{"i":0,"j":1,"v":1,"t":-1}` + "\n",
+			wantErr: NegativeValueError{-1},
+		},
+	}
+	wantEntries := [][]Entry{{{1, 1}}, {{0, 2}, {2, 3}}}
+	for _, test := range tests {
+		check := func(t *testing.T, m, times *CSRMatrix, err error) {
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("err=%v, want %v", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(m.Entries, wantEntries) {
+				t.Errorf("entries=%v, want %v", m.Entries, wantEntries)
+			}
+			if test.wantTimes == nil {
+				if times != nil {
+					t.Errorf("times=%v, want nil", times.Entries)
+				}
+				return
+			}
+			if times == nil {
+				t.Fatalf("times=nil, want %v", test.wantTimes)
+			}
+			rows, cols := times.Dims()
+			if rows != 2 || cols != 3 {
+				t.Errorf("times dims=%dx%d, want 2x3", rows, cols)
+			}
+			if !reflect.DeepEqual(times.Entries, test.wantTimes) {
+				t.Errorf("times=%v, want %v", times.Entries, test.wantTimes)
+			}
+		}
+		t.Run(test.name+"/CSV", func(t *testing.T) {
+			m, times, err := NewCSRMatrixWithTimesFromCSV(
+				context.Background(),
+				NewCSVReader(strings.NewReader(test.csv)))
+			check(t, m, times, err)
+		})
+		t.Run(test.name+"/JSONL", func(t *testing.T) {
+			m, times, err := NewCSRMatrixWithTimesFromJSONL(
+				context.Background(), strings.NewReader(test.jsonl))
+			check(t, m, times, err)
+		})
+	}
+}
+
+func TestNewCSRMatrixWithTimes_Duplicates(t *testing.T) {
+	input := "i,j,v,t\n0,1,2,100\n0,1,3,200\n0,1,1,300\n1,0,1,400\n"
+	tests := []struct {
+		name      string
+		opt       spopt.Option
+		wantValue float64
+		wantTime  float64
+	}{
+		{"LastWins", spopt.LastWins, 1, 300},
+		{"FirstWins", spopt.FirstWins, 2, 100},
+		{"Max", spopt.MaxDuplicates, 3, 200},
+		{"Sum", spopt.SumDuplicates, 6, 300},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, times, err := NewCSRMatrixWithTimesFromCSV(
+				context.Background(),
+				NewCSVReader(strings.NewReader(input)), test.opt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := [][]Entry{{{1, test.wantValue}}, {{0, 1}}}
+			if !reflect.DeepEqual(m.Entries, want) {
+				t.Errorf("entries=%v, want %v", m.Entries, want)
+			}
+			wantTimes := [][]Entry{{{1, test.wantTime}}, {{0, 400}}}
+			if !reflect.DeepEqual(times.Entries, wantTimes) {
+				t.Errorf("times=%v, want %v", times.Entries, wantTimes)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"slices"
 	"sort"
 	"strconv"
-	"sync"
 
 	"k3l.io/go-eigentrust/pkg/peer"
 	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
@@ -91,15 +90,20 @@ EntryLoop:
 			v.Entries = append(v.Entries, e)
 		}
 	}
-	sort.Sort(EntriesByIndex(v.Entries))
-	v.Entries = util.ShrinkWrap(v.Entries)
+	sort.Stable(EntriesByIndex(v.Entries))
+	entries, err := dedupSpan(v.Entries, o.Value)
+	if err != nil {
+		return nil, err
+	}
+	v.Entries = util.ShrinkWrap(entries)
 	return v, nil
 }
 
 // NewVectorFromCSV creates a new compressed sparse row matrix
 // with the given dimension and entries.
 //
-// The first CSV row is treated as the header row.
+// The first CSV row is treated as the header row, unless spopt.NoHeader.
+// Delimiter and comment options apply to readers made by NewCSVReader.
 func NewVectorFromCSV(
 	ctx context.Context, r util.CSVReader, opts ...spopt.Option,
 ) (*Vector, error) {
@@ -288,82 +292,6 @@ OverallLoop:
 	return summer.Sum()
 }
 
-// MulVec stores m multiplied by v1 into the receiver.
-func (v *Vector) MulVec(
-	ctx context.Context, m *Matrix, v1 *Vector,
-) error {
-	dim, err := m.Dim()
-	if err != nil {
-		return err
-	}
-	if dim != v1.Dim {
-		return ErrDimensionMismatch
-	}
-	// Distribute row jobs onto workers, who publish to entries.
-	// Workers feed entries chan; they exit upon jobs are exhausted.
-	// entries chan is closed when all workers exit (no one left to feed it).
-	jobs := make(chan int, dim)
-	go func() {
-		defer close(jobs)
-		for row := 0; row < dim; row++ {
-			select {
-			case <-ctx.Done():
-				return // closes jobs, which in turn terminates workers
-			case jobs <- row:
-			}
-		}
-	}()
-	numWorkers := 32
-	var wg sync.WaitGroup
-	wg.Add(numWorkers)
-	entries := make(chan Entry, dim)
-	for workerIndex := 0; workerIndex < numWorkers; workerIndex++ {
-		go func(workerIndex int) {
-			defer wg.Done()
-			row, ok := 0, false
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case row, ok = <-jobs:
-					if !ok {
-						return
-					}
-				}
-				product := VecDot(m.RowVector(row), v1)
-				select {
-				case <-ctx.Done():
-					return
-				case entries <- Entry{Index: row, Value: product}:
-				}
-			}
-		}(workerIndex)
-	}
-	go func() {
-		wg.Wait()
-		close(entries)
-	}()
-	var sortedEntries []Entry
-Loop:
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case e, ok := <-entries:
-			if !ok {
-				break Loop
-			}
-			if e.Value != 0 {
-				sortedEntries = append(sortedEntries, e)
-			}
-		}
-	}
-	sort.Sort(EntriesByIndex(sortedEntries))
-	v.Dim = dim
-	v.Entries = sortedEntries
-	return nil
-}
-
 // Norm2 returns the Frobenius norm (sqrt of sum of elements).
 func (v *Vector) Norm2() float64 {
 	var summer KBNSummer
@@ -376,13 +304,22 @@ func (v *Vector) Norm2() float64 {
 
 // Merge merges the given vector (v2) into the receiver.
 //
-// If both v and v2 contain an entry at the same location, v2's entry wins.
+// If both v and v2 contain an entry at the same location,
+// the duplicate policy in opts decides the outcome;
+// by default, v2's entry wins.
 //
 // v2 is reset after merge.
-func (v *Vector) Merge(v2 *Vector) {
-	v.SetDim(max(v.Dim, v2.Dim)) // also resizes v.Entries
-	v.Entries = mergeSpan(v.Entries, v2.Entries)
+// Upon error, v is left intact and v2 is not reset.
+func (v *Vector) Merge(v2 *Vector, opts ...spopt.Option) error {
+	o := spopt.New(opts...)
+	entries, err := mergeSpan(v.Entries, v2.Entries, o.Value.Duplicates)
+	if err != nil {
+		return err
+	}
+	v.Dim = max(v.Dim, v2.Dim)
+	v.Entries = entries
 	v2.Reset()
+	return nil
 }
 
 // Reset resets the receiver to be empty (0x0).
@@ -0,0 +1,189 @@
+package sparse
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	spopt "k3l.io/go-eigentrust/pkg/sparse/option"
+)
+
+func TestNewVectorFromEntries_Duplicates(t *testing.T) {
+	entries := []Entry{{2, 1}, {0, 4}, {2, 6}, {0, -4}, {2, 3}}
+	tests := []struct {
+		name    string
+		opts    []spopt.Option
+		want    []Entry
+		wantErr error
+	}{
+		{
+			name: "LastWins",
+			want: []Entry{{0, -4}, {2, 3}},
+		},
+		{
+			name: "FirstWins",
+			opts: []spopt.Option{spopt.FirstWins},
+			want: []Entry{{0, 4}, {2, 1}},
+		},
+		{
+			name: "Sum",
+			opts: []spopt.Option{spopt.SumDuplicates},
+			want: []Entry{{2, 10}},
+		},
+		{
+			name: "Max",
+			opts: []spopt.Option{spopt.MaxDuplicates},
+			want: []Entry{{0, 4}, {2, 6}},
+		},
+		{
+			name:    "Reject",
+			opts:    []spopt.Option{spopt.RejectDuplicates},
+			wantErr: DuplicateIndexError{Index: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]spopt.Option{spopt.AllowNegative}, tt.opts...)
+			got, err := NewVectorFromEntries(context.Background(),
+				entries, opts...)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("NewVectorFromEntries() error = %v, want %v",
+					err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Entries, tt.want) {
+				t.Errorf("NewVectorFromEntries() = %#v, want %#v",
+					got.Entries, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector_Merge(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       *Vector
+		v2      *Vector
+		opts    []spopt.Option
+		want    *Vector
+		wantErr error
+	}{
+		{
+			name: "Empty",
+			v:    &Vector{},
+			v2:   &Vector{},
+			want: &Vector{},
+		},
+		{
+			name: "LastWins",
+			v:    &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 2}}},
+			v2:   &Vector{Dim: 4, Entries: []Entry{{2, 5}, {3, 6}}},
+			want: &Vector{Dim: 4, Entries: []Entry{{0, 1}, {2, 5}, {3, 6}}},
+		},
+		{
+			name: "ZeroDeletes",
+			v:    &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 2}}},
+			v2:   &Vector{Dim: 3, Entries: []Entry{{0, 0}}},
+			want: &Vector{Dim: 3, Entries: []Entry{{2, 2}}},
+		},
+		{
+			name: "Sum",
+			v:    &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 2}}},
+			v2:   &Vector{Dim: 3, Entries: []Entry{{2, 5}}},
+			opts: []spopt.Option{spopt.SumDuplicates},
+			want: &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 7}}},
+		},
+		{
+			name:    "Reject",
+			v:       &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 2}}},
+			v2:      &Vector{Dim: 3, Entries: []Entry{{2, 5}}},
+			opts:    []spopt.Option{spopt.RejectDuplicates},
+			want:    &Vector{Dim: 3, Entries: []Entry{{0, 1}, {2, 2}}},
+			wantErr: DuplicateIndexError{Index: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Merge(tt.v2, tt.opts...)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Fatalf("v.Merge(v2) error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.v, tt.want) {
+				t.Errorf("v.Merge(v2) = %#v, want %#v", tt.v, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector_MulVec(t *testing.T) {
+	// |1 2 0|   |1|   | 5|
+	// |0 0 0| x |2| = | 0|
+	// |3 0 4|   |3|   |15|
+	m := &Matrix{CSMatrix: CSMatrix{
+		MajorDim: 3,
+		MinorDim: 3,
+		Entries:  [][]Entry{{{0, 1}, {1, 2}}, nil, {{0, 3}, {2, 4}}},
+	}}
+	want := &Vector{Dim: 3, Entries: []Entry{{0, 5}, {2, 15}}}
+	for _, numWorkers := range []int{0, 1, 3} {
+		v1 := &Vector{Dim: 3, Entries: []Entry{{0, 1}, {1, 2}, {2, 3}}}
+		v := &Vector{}
+		err := v.MulVec(context.Background(), m, v1,
+			WithNumWorkers(numWorkers))
+		if err != nil {
+			t.Fatalf("MulVec() error = %v", err)
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("MulVec() = %#v, want %#v", v, want)
+		}
+		// in-place
+		if err = v1.MulVec(context.Background(), m, v1,
+			WithNumWorkers(numWorkers)); err != nil {
+			t.Fatalf("v1.MulVec(m, v1) error = %v", err)
+		}
+		if !reflect.DeepEqual(v1, want) {
+			t.Errorf("v1.MulVec(m, v1) = %#v, want %#v", v1, want)
+		}
+	}
+	if err := (&Vector{}).MulVec(context.Background(), m,
+		&Vector{Dim: 2}); err != ErrDimensionMismatch {
+		t.Errorf("MulVec() error = %v, want %v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestVector_MulVec_Large(t *testing.T) {
+	// A cyclic shift matrix, spanning many chunks:
+	// row i has (i+1)%n = 1, so (m x v1)[i] = v1[(i+1)%n].
+	const n = 10000
+	m := &Matrix{CSMatrix: CSMatrix{MajorDim: n, MinorDim: n}}
+	m.Entries = make([][]Entry, n)
+	v1 := &Vector{Dim: n}
+	want := &Vector{Dim: n}
+	for i := 0; i < n; i++ {
+		m.Entries[i] = []Entry{{(i + 1) % n, 1}}
+		if i%3 == 0 && i != 0 {
+			v1.Entries = append(v1.Entries, Entry{i, float64(i)})
+		}
+		if j := (i + 1) % n; j%3 == 0 && j != 0 {
+			want.Entries = append(want.Entries, Entry{i, float64(j)})
+		}
+	}
+	for _, numWorkers := range []int{1, 4, 64} {
+		v := &Vector{}
+		err := v.MulVec(context.Background(), m, v1,
+			WithNumWorkers(numWorkers))
+		if err != nil {
+			t.Fatalf("MulVec() error = %v", err)
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("MulVec() with %d workers mismatch", numWorkers)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := (&Vector{}).MulVec(ctx, m, v1); err != context.Canceled {
+		t.Errorf("MulVec() error = %v, want %v", err, context.Canceled)
+	}
+}
@@ -14,7 +14,7 @@ func GrowCap[T any](s []T, target int) []T {
 	for c < target {
 		c = c*11/10 + 10
 	}
-	return slices.Grow(s, c-target)
+	return slices.Grow(s, c-len(s))
 }
 
 // ShrinkWrap shrink-wraps the slice, i.e. leaves no excess capacity.